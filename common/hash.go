@@ -108,3 +108,19 @@ func SHA512_256iOne(in *big.Int) *big.Int {
 	}
 	return new(big.Int).SetBytes(state.Sum(nil))
 }
+
+// SHA512_256iTagged is SHA512_256i with the input domain-separated by `tag`, e.g. a protocol session ID.
+// An empty tag yields the same digest as SHA512_256i so untagged callers are unaffected.
+func SHA512_256iTagged(tag []byte, in ...*big.Int) *big.Int {
+	return SHA512_256i(tagged(tag, in)...)
+}
+
+// tagged prepends the digest of `tag` to `in`. the digest has a fixed length so its integer form is unambiguous.
+func tagged(tag []byte, in []*big.Int) []*big.Int {
+	if len(tag) == 0 {
+		return in
+	}
+	out := make([]*big.Int, 0, len(in)+1)
+	out = append(out, new(big.Int).SetBytes(SHA512_256(tag)))
+	return append(out, in...)
+}
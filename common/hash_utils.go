@@ -43,3 +43,9 @@ func HashToN(N *big.Int, in ...*big.Int) *big.Int {
 	// thus it is safe to use Mod
 	return LiterallyJustMod(N, dest)
 }
+
+// HashToNTagged is HashToN with the input domain-separated by `tag`, e.g. a protocol session ID.
+// An empty tag yields the same value as HashToN.
+func HashToNTagged(tag []byte, N *big.Int, in ...*big.Int) *big.Int {
+	return HashToN(N, tagged(tag, in)...)
+}
@@ -7,8 +7,9 @@
 package common
 
 import (
-	"crypto/rand"
+	cryptorand "crypto/rand"
 	"fmt"
+	"io"
 	"math/big"
 
 	"github.com/pkg/errors"
@@ -20,6 +21,12 @@ const (
 
 // MustGetRandomInt panics if it is unable to gather entropy from `rand.Reader` or when `bits` is <= 0
 func MustGetRandomInt(bits int) *big.Int {
+	return MustGetRandomIntWithRand(cryptorand.Reader, bits)
+}
+
+// MustGetRandomIntWithRand is MustGetRandomInt with the randomness drawn from `rand`.
+// `rand` is normally `crypto/rand.Reader`, or the randomness source of the party's Parameters.
+func MustGetRandomIntWithRand(rand io.Reader, bits int) *big.Int {
 	if bits <= 0 || mustGetRandomIntMaxBits < bits {
 		panic(fmt.Errorf("MustGetRandomInt: bits should be positive, non-zero and less than %d", mustGetRandomIntMaxBits))
 	}
@@ -28,7 +35,7 @@ func MustGetRandomInt(bits int) *big.Int {
 	max = max.Exp(two, big.NewInt(int64(bits)), nil).Sub(max, one)
 
 	// Generate cryptographically strong pseudo-random int between 0 - max
-	n, err := rand.Int(rand.Reader, max)
+	n, err := cryptorand.Int(rand, max)
 	if err != nil {
 		panic(errors.Wrap(err, "rand.Int failure in MustGetRandomInt!"))
 	}
@@ -36,12 +43,17 @@ func MustGetRandomInt(bits int) *big.Int {
 }
 
 func GetRandomPositiveInt(lessThan *big.Int) *big.Int {
+	return GetRandomPositiveIntWithRand(cryptorand.Reader, lessThan)
+}
+
+// GetRandomPositiveIntWithRand is GetRandomPositiveInt with the randomness drawn from `rand`.
+func GetRandomPositiveIntWithRand(rand io.Reader, lessThan *big.Int) *big.Int {
 	if lessThan == nil || zero.Cmp(lessThan) != -1 {
 		return nil
 	}
 	var try *big.Int
 	for {
-		try = MustGetRandomInt(lessThan.BitLen())
+		try = MustGetRandomIntWithRand(rand, lessThan.BitLen())
 		if try.Cmp(lessThan) < 0 && try.Cmp(zero) >= 0 {
 			break
 		}
@@ -51,25 +63,35 @@ func GetRandomPositiveInt(lessThan *big.Int) *big.Int {
 
 // Sample an integer in range (-limit, limit)
 func GetRandomInt(limit *big.Int) *big.Int {
+	return GetRandomIntWithRand(cryptorand.Reader, limit)
+}
+
+// GetRandomIntWithRand is GetRandomInt with the randomness drawn from `rand`.
+func GetRandomIntWithRand(rand io.Reader, limit *big.Int) *big.Int {
 	limitMinus1 := new(big.Int).Sub(limit, big.NewInt(1))
 	limitDoubleMinus1 := new(big.Int).Add(limit, limitMinus1)
 	// get an integer in [0, 2*limit-1) and subtract limit-1
 	// to get an integer in [-limit+1, limit-1]
-	i := GetRandomPositiveInt(limitDoubleMinus1)
+	i := GetRandomPositiveIntWithRand(rand, limitDoubleMinus1)
 	i = i.Sub(i, limitMinus1)
 	return i
 }
 
 func GetRandomPrimeInt(bits int) *big.Int {
+	return GetRandomPrimeIntWithRand(cryptorand.Reader, bits)
+}
+
+// GetRandomPrimeIntWithRand is GetRandomPrimeInt with the randomness drawn from `rand`.
+func GetRandomPrimeIntWithRand(rand io.Reader, bits int) *big.Int {
 	if bits <= 0 {
 		return nil
 	}
-	try, err := rand.Prime(rand.Reader, bits)
+	try, err := cryptorand.Prime(rand, bits)
 	if err != nil ||
 		try.Cmp(zero) == 0 {
 		// fallback to older method
 		for {
-			try = MustGetRandomInt(bits)
+			try = MustGetRandomIntWithRand(rand, bits)
 			if probablyPrime(try) {
 				break
 			}
@@ -81,12 +103,17 @@ func GetRandomPrimeInt(bits int) *big.Int {
 // Generate a random element in the group of all the elements in Z/nZ that
 // has a multiplicative inverse.
 func GetRandomPositiveRelativelyPrimeInt(n *big.Int) *big.Int {
+	return GetRandomPositiveRelativelyPrimeIntWithRand(cryptorand.Reader, n)
+}
+
+// GetRandomPositiveRelativelyPrimeIntWithRand is GetRandomPositiveRelativelyPrimeInt with the randomness drawn from `rand`.
+func GetRandomPositiveRelativelyPrimeIntWithRand(rand io.Reader, n *big.Int) *big.Int {
 	if n == nil || zero.Cmp(n) != -1 {
 		return nil
 	}
 	var try *big.Int
 	for {
-		try = MustGetRandomInt(n.BitLen())
+		try = MustGetRandomIntWithRand(rand, n.BitLen())
 		if IsNumberInMultiplicativeGroup(n, try) {
 			break
 		}
@@ -108,22 +135,37 @@ func IsNumberInMultiplicativeGroup(n, v *big.Int) bool {
 //
 // https://github.com/didiercrunch/paillier/blob/d03e8850a8e4c53d04e8016a2ce8762af3278b71/utils.go#L39
 func GetRandomGeneratorOfTheQuadraticResidue(n *big.Int) *big.Int {
-	f := GetRandomPositiveRelativelyPrimeInt(n)
+	return GetRandomGeneratorOfTheQuadraticResidueWithRand(cryptorand.Reader, n)
+}
+
+// GetRandomGeneratorOfTheQuadraticResidueWithRand is GetRandomGeneratorOfTheQuadraticResidue with the randomness drawn from `rand`.
+func GetRandomGeneratorOfTheQuadraticResidueWithRand(rand io.Reader, n *big.Int) *big.Int {
+	f := GetRandomPositiveRelativelyPrimeIntWithRand(rand, n)
 	fSq := new(big.Int).Mul(f, f)
 	return fSq.Mod(fSq, n)
 }
 
 // Sample an integer in range (-2^power, 2^power)
 func GetRandomIntIn2PowerRange(power uint) *big.Int {
+	return GetRandomIntIn2PowerRangeWithRand(cryptorand.Reader, power)
+}
+
+// GetRandomIntIn2PowerRangeWithRand is GetRandomIntIn2PowerRange with the randomness drawn from `rand`.
+func GetRandomIntIn2PowerRangeWithRand(rand io.Reader, power uint) *big.Int {
 	limit := big.NewInt(1)
 	limit.Lsh(limit, power)
-	return GetRandomInt(limit)
+	return GetRandomIntWithRand(rand, limit)
 }
 
 // Sample an integer in range (-2^power * multiplier, 2^power * multiplier)
 func GetRandomIntIn2PowerMulRange(power uint, multiplier *big.Int) *big.Int {
+	return GetRandomIntIn2PowerMulRangeWithRand(cryptorand.Reader, power, multiplier)
+}
+
+// GetRandomIntIn2PowerMulRangeWithRand is GetRandomIntIn2PowerMulRange with the randomness drawn from `rand`.
+func GetRandomIntIn2PowerMulRangeWithRand(rand io.Reader, power uint, multiplier *big.Int) *big.Int {
 	limit := big.NewInt(1)
 	limit.Lsh(limit, power)
 	limit.Mul(limit, multiplier)
-	return GetRandomInt(limit)
+	return GetRandomIntWithRand(rand, limit)
 }
@@ -11,6 +11,7 @@ import (
 	"crypto/sha512"
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"hash"
 	"math/big"
 
@@ -208,8 +209,7 @@ func DeriveChildKey(index uint32, pk *ExtendedKey, curve elliptic.Curve) (*big.I
 
 	cryptoPk, err := crypto.NewECPoint(curve, pk.X, pk.Y)
 	if err != nil {
-		common.Logger.Error("error getting pubkey from extendedkey")
-		return nil, nil, err
+		return nil, nil, fmt.Errorf("the public key of the extended key is invalid: %w", err)
 	}
 
 	pkPublicKeyBytes := serializeCompressed(pk.X, pk.Y)
@@ -228,21 +228,16 @@ func DeriveChildKey(index uint32, pk *ExtendedKey, curve elliptic.Curve) (*big.I
 
 	if ilNum.Cmp(curve.Params().N) >= 0 || ilNum.Sign() == 0 {
 		// falling outside of the valid range for curve private keys
-		err = errors.New("invalid derived key")
-		common.Logger.Error("error deriving child key")
-		return nil, nil, err
+		return nil, nil, errors.New("invalid derived key")
 	}
 
 	deltaG := crypto.ScalarBaseMult(curve, ilNum)
 	if deltaG.X().Sign() == 0 || deltaG.Y().Sign() == 0 {
-		err = errors.New("invalid child")
-		common.Logger.Error("error invalid child")
-		return nil, nil, err
+		return nil, nil, errors.New("invalid child")
 	}
 	childCryptoPk, err := cryptoPk.Add(deltaG)
 	if err != nil {
-		common.Logger.Error("error adding delta G to parent key")
-		return nil, nil, err
+		return nil, nil, fmt.Errorf("adding delta G to the parent key: %w", err)
 	}
 
 	childPk := &ExtendedKey{
@@ -0,0 +1,120 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package ckd
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+)
+
+// The functions below implement the public (non-hardened) child key derivation of BIP32-Ed25519
+// as used by Cardano: https://input-output-hk.github.io/adrestia/static/Ed25519_BIP.pdf .
+// The child public key is A' = A + 8*ZL*B, so a threshold key can follow the derivation by adding
+// the returned delta to every share, exactly as with the secp256k1 derivation above.
+
+const (
+	ed25519TagPubKey    byte = 0x02
+	ed25519TagChainCode byte = 0x03
+
+	// only the first 28 bytes of ZL are used, keeping 8*ZL below the group order
+	ed25519ZLBytes = 28
+)
+
+// DeriveEd25519ChildKeyFromHierarchy derives the key at the given path and returns the sum of the deltas mod the curve order.
+func DeriveEd25519ChildKeyFromHierarchy(indicesHierarchy []uint32, pk *ExtendedKey) (*big.Int, *ExtendedKey, error) {
+	var k = pk
+	var err error
+	var childKey *ExtendedKey
+	mod_ := common.ModInt(edwards.Edwards().Params().N)
+	ilNum := big.NewInt(0)
+	for index := range indicesHierarchy {
+		ilNumOld := ilNum
+		ilNum, childKey, err = DeriveEd25519ChildKey(indicesHierarchy[index], k)
+		if err != nil {
+			return nil, nil, err
+		}
+		k = childKey
+		ilNum = mod_.Add(ilNum, ilNumOld)
+	}
+	return ilNum, k, nil
+}
+
+// DeriveEd25519ChildKey derives a non-hardened child of an Ed25519 extended public key. It returns the scalar 8*ZL
+// that was added to the parent key along with the derived child key.
+func DeriveEd25519ChildKey(index uint32, pk *ExtendedKey) (*big.Int, *ExtendedKey, error) {
+	if index >= HardenedKeyStart {
+		return nil, nil, errors.New("the index must be non-hardened")
+	}
+	if pk.Depth == maxDepth {
+		return nil, nil, errors.New("cannot derive key beyond max depth")
+	}
+	if len(pk.ChainCode) != 32 {
+		return nil, nil, errors.New("the chain code must be 32 bytes")
+	}
+
+	ec := edwards.Edwards()
+	cryptoPk, err := crypto.NewECPoint(ec, pk.X, pk.Y)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting pubkey from extendedkey: %w", err)
+	}
+	pkPublicKeyBytes := edwards.NewPublicKey(pk.X, pk.Y).Serialize()
+
+	data := make([]byte, 1+edwards.PubKeyBytesLen+4)
+	copy(data[1:], pkPublicKeyBytes)
+	binary.LittleEndian.PutUint32(data[1+edwards.PubKeyBytesLen:], index)
+
+	// Z = HMAC-SHA512(Key = chainCode, Data = 0x02 || A || index)
+	data[0] = ed25519TagPubKey
+	hmac512 := hmac.New(sha512.New, pk.ChainCode)
+	hmac512.Write(data)
+	z := hmac512.Sum(nil)
+
+	// c' = HMAC-SHA512(Key = chainCode, Data = 0x03 || A || index)[32:]
+	data[0] = ed25519TagChainCode
+	hmac512 = hmac.New(sha512.New, pk.ChainCode)
+	hmac512.Write(data)
+	childChainCode := hmac512.Sum(nil)[32:]
+
+	zl := reverseBytes(z[:ed25519ZLBytes])
+	ilNum := new(big.Int).Lsh(new(big.Int).SetBytes(zl), 3)
+	if ilNum.Sign() == 0 {
+		return nil, nil, errors.New("error deriving child key: invalid derived key")
+	}
+
+	deltaG := crypto.ScalarBaseMult(ec, ilNum)
+	childCryptoPk, err := cryptoPk.Add(deltaG)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error adding delta G to parent key: %w", err)
+	}
+
+	childPk := &ExtendedKey{
+		PublicKey:  *childCryptoPk.ToECDSAPubKey(),
+		Depth:      pk.Depth + 1,
+		ChildIndex: index,
+		ChainCode:  childChainCode,
+		ParentFP:   hash160(pkPublicKeyBytes)[:4],
+		Version:    pk.Version,
+	}
+	return ilNum, childPk, nil
+}
+
+func reverseBytes(in []byte) []byte {
+	out := make([]byte, len(in))
+	for i, b := range in {
+		out[len(in)-1-i] = b
+	}
+	return out
+}
@@ -0,0 +1,100 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package ckd_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/bnb-chain/tss-lib/crypto/ckd"
+)
+
+func TestEd25519PublicDerivation(t *testing.T) {
+	ec := edwards.Edwards()
+	priv, err := edwards.GeneratePrivateKey()
+	assert.NoError(t, err)
+	pub := priv.PubKey()
+	master := &ExtendedKey{
+		PublicKey: ecdsa.PublicKey{Curve: ec, X: pub.X, Y: pub.Y},
+		ChainCode: make([]byte, 32),
+		ParentFP:  []byte{0x00, 0x00, 0x00, 0x00},
+	}
+
+	path := []uint32{0, 1, 2147483647}
+	delta, child, err := DeriveEd25519ChildKeyFromHierarchy(path, master)
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(len(path)), child.Depth)
+
+	// the child key is the parent key shifted by the summed delta
+	dx, dy := ec.ScalarBaseMult(delta.Bytes())
+	wantX, wantY := ec.Add(pub.X, pub.Y, dx, dy)
+	assert.Zero(t, wantX.Cmp(child.X))
+	assert.Zero(t, wantY.Cmp(child.Y))
+
+	// derivation is deterministic
+	_, again, err := DeriveEd25519ChildKeyFromHierarchy(path, master)
+	assert.NoError(t, err)
+	assert.Equal(t, child.ChainCode, again.ChainCode)
+	assert.Zero(t, child.X.Cmp(again.X))
+
+	_, _, err = DeriveEd25519ChildKey(HardenedKeyStart, master)
+	assert.Error(t, err, "hardened derivation is not possible from a public key")
+}
+
+// The expected keys were computed with a standalone implementation of the public derivation in the BIP32-Ed25519 paper:
+// Z = HMAC-SHA512(c, 0x02 || A || index) and c' = HMAC-SHA512(c, 0x03 || A || index)[32:] with a little-endian index,
+// A' = A + 8*ZL*B with ZL the first 28 bytes of Z read as a little-endian integer. It also checked that the private child
+// scalar kL + 8*ZL of the root key below gives A'.
+func TestEd25519PublicDerivationVectors(t *testing.T) {
+	// the root key is that of the all-zero Ed25519 seed, whose scalar kL also has the third highest bit clear as BIP32-Ed25519 requires
+	rootPub := ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize)).Public().(ed25519.PublicKey)
+	assert.Equal(t, "3b6a27bcceb6a42d62a3a8d02a6f0d73653215771de243a63ac048a18b59da29", hex.EncodeToString(rootPub))
+	pub, err := edwards.ParsePubKey(rootPub)
+	if !assert.NoError(t, err) {
+		return
+	}
+	chainCode, _ := hex.DecodeString("bf99352eb2cecf2b7b576dc38f26ed9dc394f33c335c4dc80055e6a445a0e078")
+	master := &ExtendedKey{
+		PublicKey: ecdsa.PublicKey{Curve: edwards.Edwards(), X: pub.X, Y: pub.Y},
+		ChainCode: chainCode,
+		ParentFP:  []byte{0x00, 0x00, 0x00, 0x00},
+	}
+
+	vectors := []struct {
+		path                       []uint32
+		childPub, childCode, delta string
+	}{
+		{
+			path:      []uint32{0},
+			childPub:  "fb039ee19d6ff17761b185f8ba425081164ec62a7487ec26a93231ab656c9996",
+			childCode: "d7a62effff8cbdc57c3885f9e00bfc86ae31968efd2e6c4c6eea04635a4fd299",
+			delta:     "5292939f74a5666ba1ca95a0e3e8645afd81dbdf25db70f77c17c0830",
+		},
+		{
+			path:      []uint32{0, 1, 2147483647},
+			childPub:  "9e530a3766d63616c94e17a38f9a606255413fab25445d713938915e8b2d8535",
+			childCode: "aaa3b631bb55e5d08c7db783987ce475c5aa5971108142c4393aa81de4a4a5de",
+			delta:     "d8d9a33139aa7498a8c35f17ffcb4d22029c0518ed753459614a86f60",
+		},
+	}
+	for _, v := range vectors {
+		delta, child, err := DeriveEd25519ChildKeyFromHierarchy(v.path, master)
+		if !assert.NoError(t, err, v.path) {
+			continue
+		}
+		assert.Equal(t, v.childPub, hex.EncodeToString(edwards.NewPublicKey(child.X, child.Y).Serialize()), v.path)
+		assert.Equal(t, v.childCode, hex.EncodeToString(child.ChainCode), v.path)
+		want, _ := new(big.Int).SetString(v.delta, 16)
+		assert.Zero(t, want.Cmp(delta), v.path)
+	}
+}
@@ -10,6 +10,8 @@
 package commitments
 
 import (
+	"crypto/rand"
+	"io"
 	"math/big"
 
 	"github.com/bnb-chain/tss-lib/common"
@@ -44,7 +46,12 @@ func NewHashCommitmentWithRandomness(r *big.Int, secrets ...*big.Int) *HashCommi
 }
 
 func NewHashCommitment(secrets ...*big.Int) *HashCommitDecommit {
-	r := common.MustGetRandomInt(HashLength) // r
+	return NewHashCommitmentWithRand(rand.Reader, secrets...)
+}
+
+// NewHashCommitmentWithRand is NewHashCommitment with the randomness drawn from `rand`.
+func NewHashCommitmentWithRand(rand io.Reader, secrets ...*big.Int) *HashCommitDecommit {
+	r := common.MustGetRandomIntWithRand(rand, HashLength) // r
 	return NewHashCommitmentWithRandomness(r, secrets...)
 }
 
@@ -12,7 +12,9 @@
 package dlnproof
 
 import (
+	"crypto/rand"
 	"fmt"
+	"io"
 	"math/big"
 
 	"github.com/bnb-chain/tss-lib/common"
@@ -31,17 +33,22 @@ var (
 	one = big.NewInt(1)
 )
 
-func NewDLNProof(h1, h2, x, p, q, N *big.Int) *Proof {
+func NewDLNProof(session []byte, h1, h2, x, p, q, N *big.Int) *Proof {
+	return NewDLNProofWithRand(rand.Reader, session, h1, h2, x, p, q, N)
+}
+
+// NewDLNProofWithRand is NewDLNProof with the randomness drawn from `rand`.
+func NewDLNProofWithRand(rand io.Reader, session []byte, h1, h2, x, p, q, N *big.Int) *Proof {
 	pMulQ := new(big.Int).Mul(p, q)
 	modN, modPQ := common.ModInt(N), common.ModInt(pMulQ)
 	a := make([]*big.Int, Iterations)
 	alpha := [Iterations]*big.Int{}
 	for i := range alpha {
-		a[i] = common.GetRandomPositiveInt(pMulQ)
+		a[i] = common.GetRandomPositiveIntWithRand(rand, pMulQ)
 		alpha[i] = modN.Exp(h1, a[i])
 	}
 	msg := append([]*big.Int{h1, h2, N}, alpha[:]...)
-	c := common.SHA512_256i(msg...)
+	c := common.SHA512_256iTagged(session, msg...)
 	t := [Iterations]*big.Int{}
 	cIBI := new(big.Int)
 	for i := range t {
@@ -52,7 +59,7 @@ func NewDLNProof(h1, h2, x, p, q, N *big.Int) *Proof {
 	return &Proof{alpha, t}
 }
 
-func (p *Proof) Verify(h1, h2, N *big.Int) bool {
+func (p *Proof) Verify(session []byte, h1, h2, N *big.Int) bool {
 	if p == nil {
 		return false
 	}
@@ -87,7 +94,7 @@ func (p *Proof) Verify(h1, h2, N *big.Int) bool {
 		}
 	}
 	msg := append([]*big.Int{h1, h2, N}, p.Alpha[:]...)
-	c := common.SHA512_256i(msg...)
+	c := common.SHA512_256iTagged(session, msg...)
 	cIBI := new(big.Int)
 	for i := 0; i < Iterations; i++ {
 		if p.Alpha[i] == nil || p.T[i] == nil {
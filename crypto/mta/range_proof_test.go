@@ -41,9 +41,34 @@ func TestProveRangeAlice(t *testing.T) {
 	primes := [2]*big.Int{common.GetRandomPrimeInt(testSafePrimeBits), common.GetRandomPrimeInt(testSafePrimeBits)}
 	NTildei, h1i, h2i, err := crypto.GenerateNTildei(primes)
 	assert.NoError(t, err)
-	proof, err := ProveRangeAlice(tss.EC(), pk, c, NTildei, h1i, h2i, m, r)
+	proof, err := ProveRangeAlice(nil, tss.EC(), pk, c, NTildei, h1i, h2i, m, r)
 	assert.NoError(t, err)
 
-	ok := proof.Verify(tss.EC(), pk, NTildei, h1i, h2i, c)
+	ok := proof.Verify(nil, tss.EC(), pk, NTildei, h1i, h2i, c)
 	assert.True(t, ok, "proof must verify")
 }
+
+func TestProveRangeAliceWrongSession(t *testing.T) {
+	q := tss.EC().Params().N
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	sk, pk, err := paillier.GenerateKeyPair(ctx, testPaillierKeyLength)
+	assert.NoError(t, err)
+
+	m := common.GetRandomPositiveInt(q)
+	c, r, err := sk.EncryptAndReturnRandomness(m)
+	assert.NoError(t, err)
+
+	primes := [2]*big.Int{common.GetRandomPrimeInt(testSafePrimeBits), common.GetRandomPrimeInt(testSafePrimeBits)}
+	NTildei, h1i, h2i, err := crypto.GenerateNTildei(primes)
+	assert.NoError(t, err)
+	proof, err := ProveRangeAlice([]byte("session-1"), tss.EC(), pk, c, NTildei, h1i, h2i, m, r)
+	assert.NoError(t, err)
+
+	ok := proof.Verify([]byte("session-1"), tss.EC(), pk, NTildei, h1i, h2i, c)
+	assert.True(t, ok, "proof must verify")
+	ok = proof.Verify([]byte("session-2"), tss.EC(), pk, NTildei, h1i, h2i, c)
+	assert.False(t, ok, "proof must not verify in another session")
+}
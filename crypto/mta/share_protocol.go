@@ -8,7 +8,9 @@ package mta
 
 import (
 	"crypto/elliptic"
+	"crypto/rand"
 	"errors"
+	"io"
 	"math/big"
 
 	"github.com/bnb-chain/tss-lib/common"
@@ -17,31 +19,77 @@ import (
 )
 
 func AliceInit(
+	session []byte,
 	ec elliptic.Curve,
 	pkA *paillier.PublicKey,
 	a, NTildeB, h1B, h2B *big.Int,
 ) (cA *big.Int, pf *RangeProofAlice, err error) {
-	cA, rA, err := pkA.EncryptAndReturnRandomness(a)
+	return AliceInitWithRand(rand.Reader, session, ec, pkA, a, NTildeB, h1B, h2B)
+}
+
+// AliceInitWithRand is AliceInit with the randomness drawn from `rand`.
+func AliceInitWithRand(
+	rand io.Reader,
+	session []byte,
+	ec elliptic.Curve,
+	pkA *paillier.PublicKey,
+	a, NTildeB, h1B, h2B *big.Int,
+) (cA *big.Int, pf *RangeProofAlice, err error) {
+	cA, rA, err := pkA.EncryptAndReturnRandomnessWithRand(rand, a)
 	if err != nil {
 		return nil, nil, err
 	}
-	pf, err = ProveRangeAlice(ec, pkA, cA, NTildeB, h1B, h2B, a, rA)
+	pf, err = ProveRangeAliceWithRand(rand, session, ec, pkA, cA, NTildeB, h1B, h2B, a, rA)
 	return cA, pf, err
 }
 
 func BobMid(
+	session []byte,
+	ec elliptic.Curve,
+	pkA *paillier.PublicKey,
+	pf *RangeProofAlice,
+	b, cA, NTildeA, h1A, h2A, NTildeB, h1B, h2B *big.Int,
+) (beta, cB, betaPrm *big.Int, piB *ProofBob, err error) {
+	return BobMidWithRand(rand.Reader, session, ec, pkA, pf, b, cA, NTildeA, h1A, h2A, NTildeB, h1B, h2B)
+}
+
+// BobMidWithRand is BobMid with the randomness drawn from `rand`.
+func BobMidWithRand(
+	rand io.Reader,
+	session []byte,
 	ec elliptic.Curve,
 	pkA *paillier.PublicKey,
 	pf *RangeProofAlice,
 	b, cA, NTildeA, h1A, h2A, NTildeB, h1B, h2B *big.Int,
 ) (beta, cB, betaPrm *big.Int, piB *ProofBob, err error) {
-	if !pf.Verify(ec, pkA, NTildeB, h1B, h2B, cA) {
+	if !pf.Verify(session, ec, pkA, NTildeB, h1B, h2B, cA) {
 		err = errors.New("RangeProofAlice.Verify() returned false")
 		return
 	}
+	return BobRespondWithRand(rand, session, ec, pkA, b, cA, NTildeA, h1A, h2A)
+}
+
+// BobRespond is BobMid without the verification of Alice's range proof, which the caller must have verified
+func BobRespond(
+	session []byte,
+	ec elliptic.Curve,
+	pkA *paillier.PublicKey,
+	b, cA, NTildeA, h1A, h2A *big.Int,
+) (beta, cB, betaPrm *big.Int, piB *ProofBob, err error) {
+	return BobRespondWithRand(rand.Reader, session, ec, pkA, b, cA, NTildeA, h1A, h2A)
+}
+
+// BobRespondWithRand is BobRespond with the randomness drawn from `rand`.
+func BobRespondWithRand(
+	rand io.Reader,
+	session []byte,
+	ec elliptic.Curve,
+	pkA *paillier.PublicKey,
+	b, cA, NTildeA, h1A, h2A *big.Int,
+) (beta, cB, betaPrm *big.Int, piB *ProofBob, err error) {
 	q := ec.Params().N
-	betaPrm = common.GetRandomPositiveInt(pkA.N)
-	cBetaPrm, cRand, err := pkA.EncryptAndReturnRandomness(betaPrm)
+	betaPrm = common.GetRandomPositiveIntWithRand(rand, pkA.N)
+	cBetaPrm, cRand, err := pkA.EncryptAndReturnRandomnessWithRand(rand, betaPrm)
 	if err != nil {
 		return
 	}
@@ -54,24 +102,61 @@ func BobMid(
 		return
 	}
 	beta = common.ModInt(q).Sub(zero, betaPrm)
-	piB, err = ProveBob(ec, pkA, NTildeA, h1A, h2A, cA, cB, b, betaPrm, cRand)
+	piB, err = ProveBobWithRand(rand, session, ec, pkA, NTildeA, h1A, h2A, cA, cB, b, betaPrm, cRand)
 	return
 }
 
 func BobMidWC(
+	session []byte,
 	ec elliptic.Curve,
 	pkA *paillier.PublicKey,
 	pf *RangeProofAlice,
 	b, cA, NTildeA, h1A, h2A, NTildeB, h1B, h2B *big.Int,
 	B *crypto.ECPoint,
 ) (beta, cB, betaPrm *big.Int, piB *ProofBobWC, err error) {
-	if !pf.Verify(ec, pkA, NTildeB, h1B, h2B, cA) {
+	return BobMidWCWithRand(rand.Reader, session, ec, pkA, pf, b, cA, NTildeA, h1A, h2A, NTildeB, h1B, h2B, B)
+}
+
+// BobMidWCWithRand is BobMidWC with the randomness drawn from `rand`.
+func BobMidWCWithRand(
+	rand io.Reader,
+	session []byte,
+	ec elliptic.Curve,
+	pkA *paillier.PublicKey,
+	pf *RangeProofAlice,
+	b, cA, NTildeA, h1A, h2A, NTildeB, h1B, h2B *big.Int,
+	B *crypto.ECPoint,
+) (beta, cB, betaPrm *big.Int, piB *ProofBobWC, err error) {
+	if !pf.Verify(session, ec, pkA, NTildeB, h1B, h2B, cA) {
 		err = errors.New("RangeProofAlice.Verify() returned false")
 		return
 	}
+	return BobRespondWCWithRand(rand, session, ec, pkA, b, cA, NTildeA, h1A, h2A, B)
+}
+
+// BobRespondWC is BobMidWC without the verification of Alice's range proof, which the caller must have verified
+func BobRespondWC(
+	session []byte,
+	ec elliptic.Curve,
+	pkA *paillier.PublicKey,
+	b, cA, NTildeA, h1A, h2A *big.Int,
+	B *crypto.ECPoint,
+) (beta, cB, betaPrm *big.Int, piB *ProofBobWC, err error) {
+	return BobRespondWCWithRand(rand.Reader, session, ec, pkA, b, cA, NTildeA, h1A, h2A, B)
+}
+
+// BobRespondWCWithRand is BobRespondWC with the randomness drawn from `rand`.
+func BobRespondWCWithRand(
+	rand io.Reader,
+	session []byte,
+	ec elliptic.Curve,
+	pkA *paillier.PublicKey,
+	b, cA, NTildeA, h1A, h2A *big.Int,
+	B *crypto.ECPoint,
+) (beta, cB, betaPrm *big.Int, piB *ProofBobWC, err error) {
 	q := ec.Params().N
-	betaPrm = common.GetRandomPositiveInt(pkA.N)
-	cBetaPrm, cRand, err := pkA.EncryptAndReturnRandomness(betaPrm)
+	betaPrm = common.GetRandomPositiveIntWithRand(rand, pkA.N)
+	cBetaPrm, cRand, err := pkA.EncryptAndReturnRandomnessWithRand(rand, betaPrm)
 	if err != nil {
 		return
 	}
@@ -84,18 +169,19 @@ func BobMidWC(
 		return
 	}
 	beta = common.ModInt(q).Sub(zero, betaPrm)
-	piB, err = ProveBobWC(ec, pkA, NTildeA, h1A, h2A, cA, cB, b, betaPrm, cRand, B)
+	piB, err = ProveBobWCWithRand(rand, session, ec, pkA, NTildeA, h1A, h2A, cA, cB, b, betaPrm, cRand, B)
 	return
 }
 
 func AliceEnd(
+	session []byte,
 	ec elliptic.Curve,
 	pkA *paillier.PublicKey,
 	pf *ProofBob,
 	h1A, h2A, cA, cB, NTildeA *big.Int,
 	sk *paillier.PrivateKey,
 ) (*big.Int, error) {
-	if !pf.Verify(ec, pkA, NTildeA, h1A, h2A, cA, cB) {
+	if !pf.Verify(session, ec, pkA, NTildeA, h1A, h2A, cA, cB) {
 		return nil, errors.New("ProofBob.Verify() returned false")
 	}
 	alphaPrm, err := sk.Decrypt(cB)
@@ -107,6 +193,7 @@ func AliceEnd(
 }
 
 func AliceEndWC(
+	session []byte,
 	ec elliptic.Curve,
 	pkA *paillier.PublicKey,
 	pf *ProofBobWC,
@@ -114,7 +201,7 @@ func AliceEndWC(
 	cA, cB, NTildeA, h1A, h2A *big.Int,
 	sk *paillier.PrivateKey,
 ) (*big.Int, error) {
-	if !pf.Verify(ec, pkA, NTildeA, h1A, h2A, cA, cB, B) {
+	if !pf.Verify(session, ec, pkA, NTildeA, h1A, h2A, cA, cB, B) {
 		return nil, errors.New("ProofBobWC.Verify() returned false")
 	}
 	alphaPrm, err := sk.Decrypt(cB)
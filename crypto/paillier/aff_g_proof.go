@@ -0,0 +1,149 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package paillier
+
+import (
+	"crypto/elliptic"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	crypto2 "github.com/bnb-chain/tss-lib/crypto"
+)
+
+const (
+	AffGProofBytesParts = 14
+)
+
+type (
+	// AffGProof proves that D = C^x * (1 + N0)^y * rho^N0 mod N0^2 is an affine operation on C with x in ±2^ℓ and
+	// y in ±2^ℓ', where x is the discrete log of X and y is encrypted in Y under N1 (CGGMP21 Fig. 15, Πaff-g)
+	AffGProof struct {
+		S, T, A *big.Int
+		Bx      *crypto2.ECPoint
+		By, E   *big.Int
+		F       *big.Int
+		Z1, Z2  *big.Int
+		Z3, Z4  *big.Int
+		W, Wy   *big.Int
+	}
+)
+
+// ProveAffG proves the affine operation D on C under the key of the verifier `pk0`, where Y = (1 + N1)^y * rhoY^N1 mod N1^2
+// under the key of the prover `pk1` and X = g^x, to a verifier with the ring-Pedersen parameters (NHat, s, t)
+func ProveAffG(rand io.Reader, session []byte, ec elliptic.Curve, pk0, pk1 *PublicKey, NHat, s, t, C, D, Y *big.Int, X *crypto2.ECPoint, x, y, rho, rhoY *big.Int) (*AffGProof, error) {
+	if pk0 == nil || pk1 == nil || X == nil || common.AnyIsNil(NHat, s, t, C, D, Y, x, y, rho, rhoY) {
+		return nil, errors.New("ProveAffG() received a nil argument")
+	}
+	N0, N1 := pk0.N, pk1.N
+	q := ec.Params().N
+
+	alpha := common.GetRandomIntIn2PowerRangeWithRand(rand, PARAM_L+PARAM_E)
+	beta := common.GetRandomIntIn2PowerRangeWithRand(rand, PARAM_LPrime+PARAM_E)
+	r := common.GetRandomPositiveRelativelyPrimeIntWithRand(rand, N0)
+	rY := common.GetRandomPositiveRelativelyPrimeIntWithRand(rand, N1)
+	gamma := common.GetRandomIntIn2PowerMulRangeWithRand(rand, PARAM_L+PARAM_E, NHat)
+	m := common.GetRandomIntIn2PowerMulRangeWithRand(rand, PARAM_L, NHat)
+	delta := common.GetRandomIntIn2PowerMulRangeWithRand(rand, PARAM_L+PARAM_E, NHat)
+	mu := common.GetRandomIntIn2PowerMulRangeWithRand(rand, PARAM_L, NHat)
+
+	modN0Square, modNHat := common.ModInt(pk0.NSquare()), common.ModInt(NHat)
+	A := modN0Square.Mul(modN0Square.Exp(C, alpha), pk0.EncryptWithRandomness(beta, r))
+	Bx := scalarMult(ec, nil, alpha)
+	By := pk1.EncryptWithRandomness(beta, rY)
+	E := modNHat.ExpMulExp(s, alpha, t, gamma)
+	S := modNHat.ExpMulExp(s, x, t, m)
+	F := modNHat.ExpMulExp(s, beta, t, delta)
+	T := modNHat.ExpMulExp(s, y, t, mu)
+
+	e := affGChallenge(session, q, N0, N1, NHat, s, t, C, D, Y, X, S, T, A, Bx, By, E, F)
+
+	z1 := common.AddMul(alpha, e, x)
+	z2 := common.AddMul(beta, e, y)
+	z3 := common.AddMul(gamma, e, m)
+	z4 := common.AddMul(delta, e, mu)
+	w := common.ModInt(N0).MulExp(r, rho, e)
+	wY := common.ModInt(N1).MulExp(rY, rhoY, e)
+	return &AffGProof{S: S, T: T, A: A, Bx: Bx, By: By, E: E, F: F, Z1: z1, Z2: z2, Z3: z3, Z4: z4, W: w, Wy: wY}, nil
+}
+
+func AffGProofFromBytes(ec elliptic.Curve, bzs [][]byte) (*AffGProof, error) {
+	if !common.NonEmptyMultiBytes(bzs, AffGProofBytesParts) {
+		return nil, fmt.Errorf("expected %d byte parts to construct AffGProof", AffGProofBytesParts)
+	}
+	is := signedFromBytes(bzs)
+	Bx, err := crypto2.NewECPoint(ec, is[3], is[4])
+	if err != nil {
+		return nil, err
+	}
+	return &AffGProof{
+		S: is[0], T: is[1], A: is[2],
+		Bx: Bx,
+		By: is[5], E: is[6], F: is[7],
+		Z1: is[8], Z2: is[9], Z3: is[10], Z4: is[11],
+		W: is[12], Wy: is[13],
+	}, nil
+}
+
+func (pf *AffGProof) Verify(session []byte, ec elliptic.Curve, pk0, pk1 *PublicKey, NHat, s, t, C, D, Y *big.Int, X *crypto2.ECPoint) bool {
+	if pf == nil || !pf.ValidateBasic() || pk0 == nil || pk1 == nil || X == nil || common.AnyIsNil(NHat, s, t, C, D, Y) {
+		return false
+	}
+	N0, N1 := pk0.N, pk1.N
+	N0Square, N1Square := pk0.NSquare(), pk1.NSquare()
+	q := ec.Params().N
+
+	if !inRange(pf.Z1, PARAM_L+PARAM_E, nil) || !inRange(pf.Z2, PARAM_LPrime+PARAM_E, nil) {
+		return false
+	}
+	if !inGroup(NHat, s, t, pf.S, pf.T, pf.E, pf.F) ||
+		!inGroup(N0Square, C, D, pf.A) || !inGroup(N1Square, Y, pf.By) ||
+		!inGroup(N0, pf.W) || !inGroup(N1, pf.Wy) {
+		return false
+	}
+
+	e := affGChallenge(session, q, N0, N1, NHat, s, t, C, D, Y, X, pf.S, pf.T, pf.A, pf.Bx, pf.By, pf.E, pf.F)
+
+	modN0Square := common.ModInt(N0Square)
+	left := modN0Square.Mul(modN0Square.Exp(C, pf.Z1), pk0.EncryptWithRandomness(pf.Z2, pf.W))
+	if left.Cmp(modN0Square.MulExp(pf.A, D, e)) != 0 {
+		return false
+	}
+	if !pointsEqual(ec, nil, pf.Z1, pf.Bx, X, e) {
+		return false
+	}
+	if pk1.EncryptWithRandomness(pf.Z2, pf.Wy).Cmp(common.ModInt(N1Square).MulExp(pf.By, Y, e)) != 0 {
+		return false
+	}
+	modNHat := common.ModInt(NHat)
+	if modNHat.ExpMulExp(s, pf.Z1, t, pf.Z3).Cmp(modNHat.MulExp(pf.E, pf.S, e)) != 0 {
+		return false
+	}
+	return modNHat.ExpMulExp(s, pf.Z2, t, pf.Z4).Cmp(modNHat.MulExp(pf.F, pf.T, e)) == 0
+}
+
+func (pf *AffGProof) ValidateBasic() bool {
+	return pf.Bx != nil && pf.Bx.ValidateBasic() &&
+		!common.AnyIsNil(pf.S, pf.T, pf.A, pf.By, pf.E, pf.F, pf.Z1, pf.Z2, pf.Z3, pf.Z4, pf.W, pf.Wy)
+}
+
+func (pf *AffGProof) Bytes() [AffGProofBytesParts][]byte {
+	var out [AffGProofBytesParts][]byte
+	copy(out[:], signedToBytes(pf.S, pf.T, pf.A, pf.Bx.X(), pf.Bx.Y(), pf.By, pf.E, pf.F, pf.Z1, pf.Z2, pf.Z3, pf.Z4, pf.W, pf.Wy))
+	return out
+}
+
+func affGChallenge(session []byte, q, N0, N1, NHat, s, t, C, D, Y *big.Int, X *crypto2.ECPoint, S, T, A *big.Int, Bx *crypto2.ECPoint, By, E, F *big.Int) *big.Int {
+	in := []*big.Int{N0, N1, NHat, s, t, C, D, Y}
+	in = append(in, pointToInts(X)...)
+	in = append(in, S, T, A)
+	in = append(in, pointToInts(Bx)...)
+	in = append(in, By, E, F)
+	return zkChallenge(session, q, in...)
+}
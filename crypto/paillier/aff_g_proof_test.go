@@ -0,0 +1,57 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package paillier
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func TestAffGProofVerify(t *testing.T) {
+	zkSetUp(t)
+	ec := tss.EC()
+	q := ec.Params().N
+	pk0, pk1 := publicKey, &otherPrivateKey.PublicKey
+
+	k := common.GetRandomPositiveInt(q)
+	C, err := pk0.Encrypt(k)
+	assert.NoError(t, err)
+
+	x := common.GetRandomPositiveInt(q)
+	y := common.GetRandomIntIn2PowerRange(PARAM_LPrime)
+	rho := common.GetRandomPositiveRelativelyPrimeInt(pk0.N)
+	rhoY := common.GetRandomPositiveRelativelyPrimeInt(pk1.N)
+	modN0Square := common.ModInt(pk0.NSquare())
+	D := modN0Square.Mul(modN0Square.Exp(C, x), pk0.EncryptWithRandomness(y, rho))
+	Y := pk1.EncryptWithRandomness(y, rhoY)
+	X := crypto.ScalarBaseMult(ec, x)
+
+	proof, err := ProveAffG(rand.Reader, []byte("session"), ec, pk0, pk1, auxPrime.N, s, tt, C, D, Y, X, x, y, rho, rhoY)
+	assert.NoError(t, err)
+	assert.True(t, proof.Verify([]byte("session"), ec, pk0, pk1, auxPrime.N, s, tt, C, D, Y, X), "proof must verify")
+
+	bzs := proof.Bytes()
+	proof2, err := AffGProofFromBytes(ec, bzs[:])
+	assert.NoError(t, err)
+	assert.True(t, proof2.Verify([]byte("session"), ec, pk0, pk1, auxPrime.N, s, tt, C, D, Y, X), "proof must verify after a round trip")
+
+	// the plaintext of D decrypts to k * x + y
+	dec, err := privateKey.Decrypt(D)
+	assert.NoError(t, err)
+	expected := new(big.Int).Mod(common.AddMul(y, k, x), pk0.N)
+	assert.Equal(t, 0, expected.Cmp(dec))
+
+	X2 := crypto.ScalarBaseMult(ec, new(big.Int).Add(x, big.NewInt(1)))
+	assert.False(t, proof.Verify([]byte("session"), ec, pk0, pk1, auxPrime.N, s, tt, C, D, Y, X2), "proof must not verify for another X")
+}
@@ -0,0 +1,101 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package paillier
+
+import (
+	"crypto/elliptic"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+)
+
+const (
+	DecProofBytesParts = 7
+)
+
+type (
+	// DecProof proves that the plaintext of the ciphertext C is congruent to x modulo q (CGGMP21 Fig. 30, Πdec)
+	DecProof struct {
+		S, T, A *big.Int
+		Gamma   *big.Int
+		Z1, Z2  *big.Int
+		W       *big.Int
+	}
+)
+
+// ProveDec proves that C = (1 + N0)^y * rho^N0 mod N0^2 and x = y mod q, to a verifier with the ring-Pedersen parameters
+// (NHat, s, t). The plaintext y may take any value in ±N0, so the masks are widened by N0 over those of the paper.
+func ProveDec(rand io.Reader, session []byte, ec elliptic.Curve, pk *PublicKey, NHat, s, t, C, x, y, rho *big.Int) (*DecProof, error) {
+	if pk == nil || common.AnyIsNil(NHat, s, t, C, x, y, rho) {
+		return nil, errors.New("ProveDec() received a nil argument")
+	}
+	N0 := pk.N
+	q := ec.Params().N
+
+	alpha := common.GetRandomIntIn2PowerMulRangeWithRand(rand, PARAM_L+PARAM_E, N0)
+	mu := common.GetRandomIntIn2PowerMulRangeWithRand(rand, PARAM_L, NHat)
+	nu := common.GetRandomIntIn2PowerMulRangeWithRand(rand, PARAM_L+PARAM_E, new(big.Int).Mul(NHat, N0))
+	r := common.GetRandomPositiveRelativelyPrimeIntWithRand(rand, N0)
+
+	modNHat := common.ModInt(NHat)
+	S := modNHat.ExpMulExp(s, y, t, mu)
+	T := modNHat.ExpMulExp(s, alpha, t, nu)
+	A := pk.EncryptWithRandomness(alpha, r)
+	gamma := new(big.Int).Mod(alpha, q)
+
+	e := zkChallenge(session, q, N0, NHat, s, t, C, x, S, T, A, gamma)
+
+	z1 := common.AddMul(alpha, e, y)
+	z2 := common.AddMul(nu, e, mu)
+	w := common.ModInt(N0).MulExp(r, rho, e)
+	return &DecProof{S: S, T: T, A: A, Gamma: gamma, Z1: z1, Z2: z2, W: w}, nil
+}
+
+func DecProofFromBytes(bzs [][]byte) (*DecProof, error) {
+	if !common.NonEmptyMultiBytes(bzs, DecProofBytesParts) {
+		return nil, fmt.Errorf("expected %d byte parts to construct DecProof", DecProofBytesParts)
+	}
+	is := signedFromBytes(bzs)
+	return &DecProof{S: is[0], T: is[1], A: is[2], Gamma: is[3], Z1: is[4], Z2: is[5], W: is[6]}, nil
+}
+
+func (pf *DecProof) Verify(session []byte, ec elliptic.Curve, pk *PublicKey, NHat, s, t, C, x *big.Int) bool {
+	if pf == nil || !pf.ValidateBasic() || pk == nil || common.AnyIsNil(NHat, s, t, C, x) {
+		return false
+	}
+	N0, N0Square := pk.N, pk.NSquare()
+	q := ec.Params().N
+
+	if !inGroup(NHat, s, t, pf.S, pf.T) || !inGroup(N0Square, C, pf.A) || !inGroup(N0, pf.W) {
+		return false
+	}
+
+	e := zkChallenge(session, q, N0, NHat, s, t, C, x, pf.S, pf.T, pf.A, pf.Gamma)
+
+	if pk.EncryptWithRandomness(pf.Z1, pf.W).Cmp(common.ModInt(N0Square).MulExp(pf.A, C, e)) != 0 {
+		return false
+	}
+	modQ := common.ModInt(q)
+	if new(big.Int).Mod(pf.Z1, q).Cmp(modQ.Add(pf.Gamma, modQ.Mul(e, x))) != 0 {
+		return false
+	}
+	modNHat := common.ModInt(NHat)
+	return modNHat.ExpMulExp(s, pf.Z1, t, pf.Z2).Cmp(modNHat.MulExp(pf.T, pf.S, e)) == 0
+}
+
+func (pf *DecProof) ValidateBasic() bool {
+	return !common.AnyIsNil(pf.S, pf.T, pf.A, pf.Gamma, pf.Z1, pf.Z2, pf.W)
+}
+
+func (pf *DecProof) Bytes() [DecProofBytesParts][]byte {
+	var out [DecProofBytesParts][]byte
+	copy(out[:], signedToBytes(pf.S, pf.T, pf.A, pf.Gamma, pf.Z1, pf.Z2, pf.W))
+	return out
+}
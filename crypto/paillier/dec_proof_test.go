@@ -0,0 +1,41 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package paillier
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func TestDecProofVerify(t *testing.T) {
+	zkSetUp(t)
+	ec := tss.EC()
+	q := ec.Params().N
+	// a plaintext much larger than q, as in the sums checked by CGGMP21
+	y := common.GetRandomIntIn2PowerRange(PARAM_LPrime + PARAM_E)
+	rho := common.GetRandomPositiveRelativelyPrimeInt(publicKey.N)
+	C := publicKey.EncryptWithRandomness(y, rho)
+	x := new(big.Int).Mod(y, q)
+
+	proof, err := ProveDec(rand.Reader, []byte("session"), ec, publicKey, auxPrime.N, s, tt, C, x, y, rho)
+	assert.NoError(t, err)
+	assert.True(t, proof.Verify([]byte("session"), ec, publicKey, auxPrime.N, s, tt, C, x), "proof must verify")
+
+	bzs := proof.Bytes()
+	proof2, err := DecProofFromBytes(bzs[:])
+	assert.NoError(t, err)
+	assert.True(t, proof2.Verify([]byte("session"), ec, publicKey, auxPrime.N, s, tt, C, x), "proof must verify after a round trip")
+
+	x2 := common.ModInt(q).Add(x, big.NewInt(1))
+	assert.False(t, proof.Verify([]byte("session"), ec, publicKey, auxPrime.N, s, tt, C, x2), "proof must not verify for another x")
+}
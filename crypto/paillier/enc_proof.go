@@ -0,0 +1,97 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package paillier
+
+import (
+	"crypto/elliptic"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+)
+
+const (
+	EncProofBytesParts = 6
+)
+
+type (
+	// EncProof proves that the ciphertext K encrypts a plaintext in ±2^ℓ (CGGMP21 Fig. 14, Πenc)
+	EncProof struct {
+		S, A, C    *big.Int
+		Z1, Z2, Z3 *big.Int
+	}
+)
+
+// ProveEnc proves that K = (1 + N0)^k * rho^N0 mod N0^2 for a k in ±2^ℓ, to a verifier with the ring-Pedersen parameters (NHat, s, t)
+func ProveEnc(rand io.Reader, session []byte, ec elliptic.Curve, pk *PublicKey, NHat, s, t, K, k, rho *big.Int) (*EncProof, error) {
+	if pk == nil || common.AnyIsNil(NHat, s, t, K, k, rho) {
+		return nil, errors.New("ProveEnc() received a nil argument")
+	}
+	N0 := pk.N
+	q := ec.Params().N
+
+	alpha := common.GetRandomIntIn2PowerRangeWithRand(rand, PARAM_L+PARAM_E)
+	mu := common.GetRandomIntIn2PowerMulRangeWithRand(rand, PARAM_L, NHat)
+	r := common.GetRandomPositiveRelativelyPrimeIntWithRand(rand, N0)
+	gamma := common.GetRandomIntIn2PowerMulRangeWithRand(rand, PARAM_L+PARAM_E, NHat)
+
+	modNHat := common.ModInt(NHat)
+	S := modNHat.ExpMulExp(s, k, t, mu)
+	A := pk.EncryptWithRandomness(alpha, r)
+	C := modNHat.ExpMulExp(s, alpha, t, gamma)
+
+	e := zkChallenge(session, q, N0, NHat, s, t, K, S, A, C)
+
+	z1 := common.AddMul(alpha, e, k)
+	z2 := common.ModInt(N0).MulExp(r, rho, e)
+	z3 := common.AddMul(gamma, e, mu)
+	return &EncProof{S: S, A: A, C: C, Z1: z1, Z2: z2, Z3: z3}, nil
+}
+
+func EncProofFromBytes(bzs [][]byte) (*EncProof, error) {
+	if !common.NonEmptyMultiBytes(bzs, EncProofBytesParts) {
+		return nil, fmt.Errorf("expected %d byte parts to construct EncProof", EncProofBytesParts)
+	}
+	is := signedFromBytes(bzs)
+	return &EncProof{S: is[0], A: is[1], C: is[2], Z1: is[3], Z2: is[4], Z3: is[5]}, nil
+}
+
+func (pf *EncProof) Verify(session []byte, ec elliptic.Curve, pk *PublicKey, NHat, s, t, K *big.Int) bool {
+	if pf == nil || !pf.ValidateBasic() || pk == nil || common.AnyIsNil(NHat, s, t, K) {
+		return false
+	}
+	N0, N0Square := pk.N, pk.NSquare()
+	q := ec.Params().N
+
+	if !inRange(pf.Z1, PARAM_L+PARAM_E, nil) {
+		return false
+	}
+	if !inGroup(NHat, s, t, pf.S, pf.C) || !inGroup(N0Square, K, pf.A) || !inGroup(N0, pf.Z2) {
+		return false
+	}
+
+	e := zkChallenge(session, q, N0, NHat, s, t, K, pf.S, pf.A, pf.C)
+
+	modN0Square := common.ModInt(N0Square)
+	if pk.EncryptWithRandomness(pf.Z1, pf.Z2).Cmp(modN0Square.MulExp(pf.A, K, e)) != 0 {
+		return false
+	}
+	modNHat := common.ModInt(NHat)
+	return modNHat.ExpMulExp(s, pf.Z1, t, pf.Z3).Cmp(modNHat.MulExp(pf.C, pf.S, e)) == 0
+}
+
+func (pf *EncProof) ValidateBasic() bool {
+	return !common.AnyIsNil(pf.S, pf.A, pf.C, pf.Z1, pf.Z2, pf.Z3)
+}
+
+func (pf *EncProof) Bytes() [EncProofBytesParts][]byte {
+	var out [EncProofBytesParts][]byte
+	copy(out[:], signedToBytes(pf.S, pf.A, pf.C, pf.Z1, pf.Z2, pf.Z3))
+	return out
+}
@@ -0,0 +1,49 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package paillier
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func TestEncProofVerify(t *testing.T) {
+	zkSetUp(t)
+	ec := tss.EC()
+	k := common.GetRandomPositiveInt(ec.Params().N)
+	K, rho, err := publicKey.EncryptAndReturnRandomness(k)
+	assert.NoError(t, err)
+
+	proof, err := ProveEnc(rand.Reader, []byte("session"), ec, publicKey, auxPrime.N, s, tt, K, k, rho)
+	assert.NoError(t, err)
+	assert.True(t, proof.Verify([]byte("session"), ec, publicKey, auxPrime.N, s, tt, K), "proof must verify")
+
+	bzs := proof.Bytes()
+	proof2, err := EncProofFromBytes(bzs[:])
+	assert.NoError(t, err)
+	assert.True(t, proof2.Verify([]byte("session"), ec, publicKey, auxPrime.N, s, tt, K), "proof must verify after a round trip")
+
+	assert.False(t, proof.Verify([]byte("other"), ec, publicKey, auxPrime.N, s, tt, K), "proof must not verify in another session")
+}
+
+func TestEncProofVerifyFail(t *testing.T) {
+	zkSetUp(t)
+	ec := tss.EC()
+	// the plaintext is far outside ±2^ℓ
+	k := common.GetRandomPositiveInt(publicKey.N)
+	K, rho, err := publicKey.EncryptAndReturnRandomness(k)
+	assert.NoError(t, err)
+
+	proof, err := ProveEnc(rand.Reader, nil, ec, publicKey, auxPrime.N, s, tt, K, k, rho)
+	assert.NoError(t, err)
+	assert.False(t, proof.Verify(nil, ec, publicKey, auxPrime.N, s, tt, K), "proof of a large plaintext must not verify")
+}
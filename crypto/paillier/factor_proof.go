@@ -1,7 +1,9 @@
 package paillier
 
 import (
+	"crypto/rand"
 	"fmt"
+	"io"
 	"math/big"
 
 	"github.com/bnb-chain/tss-lib/common"
@@ -34,21 +36,26 @@ type (
 // Canetti, R., Gennaro, R., Goldfeder, S., Makriyannis, N., Peled, U.:
 // UC Non-Interactive, Proactive, Threshold ECDSA with Identifiable Aborts.
 // In: Cryptology ePrint Archive 2021/060
-func (privateKey *PrivateKey) FactorProof(N, s, t *big.Int) *FactorProof {
+func (privateKey *PrivateKey) FactorProof(session []byte, N, s, t *big.Int) *FactorProof {
+	return privateKey.FactorProofWithRand(rand.Reader, session, N, s, t)
+}
+
+// FactorProofWithRand is FactorProof with the randomness drawn from `rand`.
+func (privateKey *PrivateKey) FactorProofWithRand(rand io.Reader, session []byte, N, s, t *big.Int) *FactorProof {
 	N0 := privateKey.PublicKey.N
 	p, q := privateKey.GetPQ()
 
-	a := common.GetRandomIntIn2PowerMulRange(PARAM_L+PARAM_E, new(big.Int).Sqrt(N0))
-	b := common.GetRandomIntIn2PowerMulRange(PARAM_L+PARAM_E, new(big.Int).Sqrt(N0))
+	a := common.GetRandomIntIn2PowerMulRangeWithRand(rand, PARAM_L+PARAM_E, new(big.Int).Sqrt(N0))
+	b := common.GetRandomIntIn2PowerMulRangeWithRand(rand, PARAM_L+PARAM_E, new(big.Int).Sqrt(N0))
 
-	mu := common.GetRandomIntIn2PowerMulRange(PARAM_L, N)
-	v := common.GetRandomIntIn2PowerMulRange(PARAM_L, N)
+	mu := common.GetRandomIntIn2PowerMulRangeWithRand(rand, PARAM_L, N)
+	v := common.GetRandomIntIn2PowerMulRangeWithRand(rand, PARAM_L, N)
 
-	sigma := common.GetRandomIntIn2PowerMulRange(PARAM_L, new(big.Int).Mul(N0, N))
-	r := common.GetRandomIntIn2PowerMulRange(PARAM_L+PARAM_E, new(big.Int).Mul(N0, N))
+	sigma := common.GetRandomIntIn2PowerMulRangeWithRand(rand, PARAM_L, new(big.Int).Mul(N0, N))
+	r := common.GetRandomIntIn2PowerMulRangeWithRand(rand, PARAM_L+PARAM_E, new(big.Int).Mul(N0, N))
 
-	x := common.GetRandomIntIn2PowerMulRange(PARAM_L+PARAM_E, N)
-	y := common.GetRandomIntIn2PowerMulRange(PARAM_L+PARAM_E, N)
+	x := common.GetRandomIntIn2PowerMulRangeWithRand(rand, PARAM_L+PARAM_E, N)
+	y := common.GetRandomIntIn2PowerMulRangeWithRand(rand, PARAM_L+PARAM_E, N)
 
 	modN := common.ModInt(N)
 
@@ -67,7 +74,7 @@ func (privateKey *PrivateKey) FactorProof(N, s, t *big.Int) *FactorProof {
 	// the last message with respect to e and communicates the entire transcript as the proof. Later, the Verifier
 	// accepts the proof if it is a valid transcript of the underlying Σ-protocol and e is well-formed (verified by
 	// querying the oracle as the Prover should have).
-	e := FactorChallenge(N, s, t, N0, P, Q, A, B, T, sigma)
+	e := FactorChallenge(session, N, s, t, N0, P, Q, A, B, T, sigma)
 
 	sigmaH := new(big.Int)
 	sigmaH.Mul(v, p)
@@ -82,7 +89,7 @@ func (privateKey *PrivateKey) FactorProof(N, s, t *big.Int) *FactorProof {
 	return &FactorProof{P, Q, A, B, T, sigma, z1, z2, w1, w2, vv}
 }
 
-func (pf FactorProof) FactorVerify(pkN, N, s, t *big.Int) (bool, error) {
+func (pf FactorProof) FactorVerify(session []byte, pkN, N, s, t *big.Int) (bool, error) {
 	if common.AnyIsNil(pkN, N, s, t) {
 		return false, fmt.Errorf("fac proof verify: nil bigint present in args")
 	}
@@ -90,7 +97,7 @@ func (pf FactorProof) FactorVerify(pkN, N, s, t *big.Int) (bool, error) {
 		return false, fmt.Errorf("fac proof verify: nil bigint present in proof")
 	}
 
-	e := FactorChallenge(N, s, t, pkN, pf.P, pf.Q, pf.A, pf.B, pf.T, pf.Sigma)
+	e := FactorChallenge(session, N, s, t, pkN, pf.P, pf.Q, pf.A, pf.B, pf.T, pf.Sigma)
 
 	modN := common.ModInt(N)
 
@@ -131,7 +138,7 @@ func (pf FactorProof) FactorVerify(pkN, N, s, t *big.Int) (bool, error) {
 	return true, nil
 }
 
-func FactorChallenge(N, s, t, pkN, P, Q, A, B, T, sigma *big.Int) *big.Int {
+func FactorChallenge(session []byte, N, s, t, pkN, P, Q, A, B, T, sigma *big.Int) *big.Int {
 	q := big.NewInt(1)
 	q = q.Lsh(q, 256)                             // q = 2^256
 	qMinus1 := new(big.Int).Sub(q, big.NewInt(1)) // q-1
@@ -143,7 +150,7 @@ func FactorChallenge(N, s, t, pkN, P, Q, A, B, T, sigma *big.Int) *big.Int {
 	// and q acts as a security parameter only.
 	//
 	// Calculate +-q by taking HashToN(2*q-1, ...) - q + 1
-	h := common.HashToN(qDoubleMinus1, N, s, t, pkN, P, Q, A, B, T, sigma)
+	h := common.HashToNTagged(session, qDoubleMinus1, N, s, t, pkN, P, Q, A, B, T, sigma)
 	h.Sub(h, qMinus1) // h - (q-1) = h - q + 1
 
 	return h
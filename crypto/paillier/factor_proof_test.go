@@ -85,8 +85,8 @@ func GenerateBadKeyPair(ctx context.Context, modulusBitLen int) (privateKey *Pri
 
 func TestFactorProofVerify(t *testing.T) {
 	facSetUp(t)
-	proof := privateKey.FactorProof(auxPrime.N, s, tt)
-	res, err := proof.FactorVerify(publicKey.N, auxPrime.N, s, tt)
+	proof := privateKey.FactorProof(nil, auxPrime.N, s, tt)
+	res, err := proof.FactorVerify(nil, publicKey.N, auxPrime.N, s, tt)
 	assert.NoError(t, err)
 	assert.True(t, res, "proof verify result must be true")
 }
@@ -94,33 +94,33 @@ func TestFactorProofVerify(t *testing.T) {
 func TestFactorProofVerifyFail1(t *testing.T) {
 	facSetUp(t)
 	badN := new(big.Int).Mul(publicKey.N, big.NewInt(3))
-	proof := privateKey.FactorProof(auxPrime.N, s, tt)
-	res, err := proof.FactorVerify(badN, auxPrime.N, s, tt)
+	proof := privateKey.FactorProof(nil, auxPrime.N, s, tt)
+	res, err := proof.FactorVerify(nil, badN, auxPrime.N, s, tt)
 	assert.Error(t, err)
 	assert.False(t, res, "proof verify result must be false")
 }
 
 func TestFactorProofVerifyFail2(t *testing.T) {
 	facSetUp(t)
-	proof := privateKey.FactorProof(auxPrime.N, s, tt)
+	proof := privateKey.FactorProof(nil, auxPrime.N, s, tt)
 	proof.V = nil
-	res, err := proof.FactorVerify(publicKey.N, auxPrime.N, s, tt)
+	res, err := proof.FactorVerify(nil, publicKey.N, auxPrime.N, s, tt)
 	assert.Error(t, err)
 	assert.False(t, res, "proof verify result must be false")
 }
 
 func TestFactorProofVerifyFail3(t *testing.T) {
 	facSetUp(t)
-	proof := privateKey.FactorProof(auxPrime.N, s, tt)
-	res, err := proof.FactorVerify(publicKey.N, auxPrime.N, s, nil)
+	proof := privateKey.FactorProof(nil, auxPrime.N, s, tt)
+	res, err := proof.FactorVerify(nil, publicKey.N, auxPrime.N, s, nil)
 	assert.Error(t, err)
 	assert.False(t, res, "proof verify result must be false")
 }
 
 func TestFactorProofVerifyFailBadFactors(t *testing.T) {
 	facSetUp(t)
-	proof := badPrivateKey.FactorProof(auxPrime.N, s, tt)
-	res, err := proof.FactorVerify(badPublicKey.N, auxPrime.N, s, tt)
+	proof := badPrivateKey.FactorProof(nil, auxPrime.N, s, tt)
+	res, err := proof.FactorVerify(nil, badPublicKey.N, auxPrime.N, s, tt)
 	assert.Error(t, err)
 	assert.False(t, res, "proof verify result must be false")
 }
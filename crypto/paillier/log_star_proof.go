@@ -0,0 +1,122 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package paillier
+
+import (
+	"crypto/elliptic"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	crypto2 "github.com/bnb-chain/tss-lib/crypto"
+)
+
+const (
+	LogStarProofBytesParts = 8
+)
+
+type (
+	// LogStarProof proves that the ciphertext C encrypts the discrete log in ±2^ℓ of X to the base g (CGGMP21 Fig. 25, Πlog*)
+	LogStarProof struct {
+		S, A       *big.Int
+		Y          *crypto2.ECPoint
+		D          *big.Int
+		Z1, Z2, Z3 *big.Int
+	}
+)
+
+// ProveLogStar proves that C = (1 + N0)^x * rho^N0 mod N0^2 and X = g^x, to a verifier with the ring-Pedersen parameters
+// (NHat, s, t). A nil `g` stands for the generator of the curve.
+func ProveLogStar(rand io.Reader, session []byte, ec elliptic.Curve, pk *PublicKey, NHat, s, t, C *big.Int, X, g *crypto2.ECPoint, x, rho *big.Int) (*LogStarProof, error) {
+	if pk == nil || X == nil || common.AnyIsNil(NHat, s, t, C, x, rho) {
+		return nil, errors.New("ProveLogStar() received a nil argument")
+	}
+	N0 := pk.N
+	q := ec.Params().N
+
+	alpha := common.GetRandomIntIn2PowerRangeWithRand(rand, PARAM_L+PARAM_E)
+	mu := common.GetRandomIntIn2PowerMulRangeWithRand(rand, PARAM_L, NHat)
+	r := common.GetRandomPositiveRelativelyPrimeIntWithRand(rand, N0)
+	gamma := common.GetRandomIntIn2PowerMulRangeWithRand(rand, PARAM_L+PARAM_E, NHat)
+
+	modNHat := common.ModInt(NHat)
+	S := modNHat.ExpMulExp(s, x, t, mu)
+	A := pk.EncryptWithRandomness(alpha, r)
+	Y := scalarMult(ec, g, alpha)
+	D := modNHat.ExpMulExp(s, alpha, t, gamma)
+
+	e := logStarChallenge(session, q, N0, NHat, s, t, C, X, g, S, A, Y, D)
+
+	z1 := common.AddMul(alpha, e, x)
+	z2 := common.ModInt(N0).MulExp(r, rho, e)
+	z3 := common.AddMul(gamma, e, mu)
+	return &LogStarProof{S: S, A: A, Y: Y, D: D, Z1: z1, Z2: z2, Z3: z3}, nil
+}
+
+func LogStarProofFromBytes(ec elliptic.Curve, bzs [][]byte) (*LogStarProof, error) {
+	if !common.NonEmptyMultiBytes(bzs, LogStarProofBytesParts) {
+		return nil, fmt.Errorf("expected %d byte parts to construct LogStarProof", LogStarProofBytesParts)
+	}
+	is := signedFromBytes(bzs)
+	Y, err := crypto2.NewECPoint(ec, is[2], is[3])
+	if err != nil {
+		return nil, err
+	}
+	return &LogStarProof{S: is[0], A: is[1], Y: Y, D: is[4], Z1: is[5], Z2: is[6], Z3: is[7]}, nil
+}
+
+func (pf *LogStarProof) Verify(session []byte, ec elliptic.Curve, pk *PublicKey, NHat, s, t, C *big.Int, X, g *crypto2.ECPoint) bool {
+	if pf == nil || !pf.ValidateBasic() || pk == nil || X == nil || common.AnyIsNil(NHat, s, t, C) {
+		return false
+	}
+	N0, N0Square := pk.N, pk.NSquare()
+	q := ec.Params().N
+
+	if !inRange(pf.Z1, PARAM_L+PARAM_E, nil) {
+		return false
+	}
+	if !inGroup(NHat, s, t, pf.S, pf.D) || !inGroup(N0Square, C, pf.A) || !inGroup(N0, pf.Z2) {
+		return false
+	}
+
+	e := logStarChallenge(session, q, N0, NHat, s, t, C, X, g, pf.S, pf.A, pf.Y, pf.D)
+
+	if pk.EncryptWithRandomness(pf.Z1, pf.Z2).Cmp(common.ModInt(N0Square).MulExp(pf.A, C, e)) != 0 {
+		return false
+	}
+	if !pointsEqual(ec, g, pf.Z1, pf.Y, X, e) {
+		return false
+	}
+	modNHat := common.ModInt(NHat)
+	return modNHat.ExpMulExp(s, pf.Z1, t, pf.Z3).Cmp(modNHat.MulExp(pf.D, pf.S, e)) == 0
+}
+
+func (pf *LogStarProof) ValidateBasic() bool {
+	return pf.Y != nil && pf.Y.ValidateBasic() && !common.AnyIsNil(pf.S, pf.A, pf.D, pf.Z1, pf.Z2, pf.Z3)
+}
+
+func (pf *LogStarProof) Bytes() [LogStarProofBytesParts][]byte {
+	var out [LogStarProofBytesParts][]byte
+	copy(out[:], signedToBytes(pf.S, pf.A, pf.Y.X(), pf.Y.Y(), pf.D, pf.Z1, pf.Z2, pf.Z3))
+	return out
+}
+
+func logStarChallenge(session []byte, q, N0, NHat, s, t, C *big.Int, X, g *crypto2.ECPoint, S, A *big.Int, Y *crypto2.ECPoint, D *big.Int) *big.Int {
+	if g == nil {
+		ecParams := X.Curve().Params()
+		g = crypto2.NewECPointNoCurveCheck(X.Curve(), ecParams.Gx, ecParams.Gy)
+	}
+	in := []*big.Int{N0, NHat, s, t, C}
+	in = append(in, pointToInts(X)...)
+	in = append(in, pointToInts(g)...)
+	in = append(in, S, A)
+	in = append(in, pointToInts(Y)...)
+	in = append(in, D)
+	return zkChallenge(session, q, in...)
+}
@@ -0,0 +1,48 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package paillier
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func TestLogStarProofVerify(t *testing.T) {
+	zkSetUp(t)
+	ec := tss.EC()
+	q := ec.Params().N
+	x := common.GetRandomPositiveInt(q)
+	C, rho, err := publicKey.EncryptAndReturnRandomness(x)
+	assert.NoError(t, err)
+
+	// against the curve generator and against another base point
+	g := crypto.ScalarBaseMult(ec, common.GetRandomPositiveInt(q))
+	for _, base := range []*crypto.ECPoint{nil, g} {
+		X := crypto.ScalarBaseMult(ec, x)
+		if base != nil {
+			X = base.ScalarMult(x)
+		}
+		proof, err := ProveLogStar(rand.Reader, []byte("session"), ec, publicKey, auxPrime.N, s, tt, C, X, base, x, rho)
+		assert.NoError(t, err)
+		assert.True(t, proof.Verify([]byte("session"), ec, publicKey, auxPrime.N, s, tt, C, X, base), "proof must verify")
+
+		bzs := proof.Bytes()
+		proof2, err := LogStarProofFromBytes(ec, bzs[:])
+		assert.NoError(t, err)
+		assert.True(t, proof2.Verify([]byte("session"), ec, publicKey, auxPrime.N, s, tt, C, X, base), "proof must verify after a round trip")
+
+		X2, _ := X.Add(crypto.ScalarBaseMult(ec, big.NewInt(1)))
+		assert.False(t, proof.Verify([]byte("session"), ec, publicKey, auxPrime.N, s, tt, C, X2, base), "proof must not verify for another X")
+	}
+}
@@ -1,7 +1,9 @@
 package paillier
 
 import (
+	"crypto/rand"
 	"fmt"
+	"io"
 	"math/big"
 
 	"github.com/bnb-chain/tss-lib/common"
@@ -25,17 +27,22 @@ type (
 // Canetti, R., Gennaro, R., Goldfeder, S., Makriyannis, N., Peled, U.:
 // UC Non-Interactive, Proactive, Threshold ECDSA with Identifiable Aborts.
 // In: Cryptology ePrint Archive 2021/060
-func (privateKey *PrivateKey) ModProof() *ModProof {
+func (privateKey *PrivateKey) ModProof(session []byte) *ModProof {
+	return privateKey.ModProofWithRand(rand.Reader, session)
+}
+
+// ModProofWithRand is ModProof with the randomness drawn from `rand`.
+func (privateKey *PrivateKey) ModProofWithRand(rand io.Reader, session []byte) *ModProof {
 	N := privateKey.PublicKey.N
 	phiN := privateKey.PhiN
 	p, q := privateKey.GetPQ()
 
-	w := common.GetRandomPositiveInt(N)
+	w := common.GetRandomPositiveIntWithRand(rand, N)
 	for big.Jacobi(w, N) != -1 {
-		w = common.GetRandomPositiveInt(N)
+		w = common.GetRandomPositiveIntWithRand(rand, N)
 	}
 
-	y := ModChallenge(N, w)
+	y := ModChallenge(session, N, w)
 
 	var x [PARAM_M]*big.Int
 	var a [PARAM_M]bool
@@ -67,7 +74,7 @@ func (privateKey *PrivateKey) ModProof() *ModProof {
 // – N is an odd composite number.
 // – z_i^N = y_i for every i ∈ [m]
 // – x_i^4 = (-1)^a_i * w^b_i * y_i mod N and a_i, b_i ∈ {0, 1} for every i ∈ [m].
-func (pf ModProof) ModVerify(N *big.Int) (bool, error) {
+func (pf ModProof) ModVerify(session []byte, N *big.Int) (bool, error) {
 	if common.AnyIsNil(pf.W) || common.AnyIsNil(pf.X[:]...) || common.AnyIsNil(pf.Z[:]...) {
 		return false, fmt.Errorf("mod proof verify: nil inputs in proof")
 	}
@@ -91,7 +98,7 @@ func (pf ModProof) ModVerify(N *big.Int) (bool, error) {
 		return false, fmt.Errorf("mod proof verify: w %d exceeds N %d", pf.W, N)
 	}
 
-	y := ModChallenge(N, pf.W)
+	y := ModChallenge(session, N, pf.W)
 
 	for i, yi := range y {
 		if !common.Lt(pf.X[i], N) {
@@ -124,12 +131,12 @@ func (pf ModProof) ModVerify(N *big.Int) (bool, error) {
 	return true, nil
 }
 
-// Standard Fiat-Shamir transform
-func ModChallenge(N, w *big.Int) [PARAM_M]*big.Int {
+// Standard Fiat-Shamir transform, bound to the protocol session
+func ModChallenge(session []byte, N, w *big.Int) [PARAM_M]*big.Int {
 	var y [PARAM_M]*big.Int
 
 	for i := range y {
-		y[i] = common.HashToN(N, w, big.NewInt(int64(i)))
+		y[i] = common.HashToNTagged(session, N, w, big.NewInt(int64(i)))
 	}
 
 	return y
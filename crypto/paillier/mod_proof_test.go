@@ -24,22 +24,33 @@ func modSetUp(t *testing.T) {
 
 func TestModProofVerify(t *testing.T) {
 	modSetUp(t)
-	proof := privateKey.ModProof()
-	res, err := proof.ModVerify(publicKey.N)
+	proof := privateKey.ModProof(nil)
+	res, err := proof.ModVerify(nil, publicKey.N)
 	assert.NoError(t, err)
 	assert.True(t, res, "proof verify result must be true")
 }
 
 func TestModProofVerifyFail(t *testing.T) {
 	modSetUp(t)
-	proof := privateKey.ModProof()
+	proof := privateKey.ModProof(nil)
 	last := proof.Z[PARAM_M-1]
 	last.Sub(last, big.NewInt(1))
-	res, err := proof.ModVerify(publicKey.N)
+	res, err := proof.ModVerify(nil, publicKey.N)
 	assert.Error(t, err)
 	assert.False(t, res, "proof verify result must be false")
 }
 
+func TestModProofVerifyWrongSession(t *testing.T) {
+	modSetUp(t)
+	proof := privateKey.ModProof([]byte("session-1"))
+	res, err := proof.ModVerify([]byte("session-1"), publicKey.N)
+	assert.NoError(t, err)
+	assert.True(t, res, "proof verify result must be true")
+	res, err = proof.ModVerify([]byte("session-2"), publicKey.N)
+	assert.Error(t, err)
+	assert.False(t, res, "proof verify result must be false in another session")
+}
+
 func TestModProofVerify_ForgedProof(t *testing.T) {
 	p := big.NewInt(17) // NOT a safe prime and NOT congruent to 3 (mod 4) because 17 mod 4 = 1
 	q := big.NewInt(7)  // safe prime because 2*3+1 and congruent to 3 (mod 4) because 7 mod 4 = 3
@@ -53,7 +64,7 @@ func TestModProofVerify_ForgedProof(t *testing.T) {
 	// Use w = 0 deliberately.
 	w := big.NewInt(0)
 	// Construct the mod challenge as usual.
-	y := ModChallenge(N, w)
+	y := ModChallenge(nil, N, w)
 
 	var x [PARAM_M]*big.Int
 	var a [PARAM_M]bool
@@ -78,7 +89,7 @@ func TestModProofVerify_ForgedProof(t *testing.T) {
 		Z: z,
 	}
 
-	res, err := forgedMoodProof.ModVerify(N)
+	res, err := forgedMoodProof.ModVerify(nil, N)
 	assert.Error(t, err)
 	assert.False(t, res, "proof verify result must be false")
 }
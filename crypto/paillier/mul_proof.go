@@ -0,0 +1,92 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package paillier
+
+import (
+	"crypto/elliptic"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+)
+
+const (
+	MulProofBytesParts = 5
+)
+
+type (
+	// MulProof proves that the ciphertext C encrypts the product of the plaintexts of X and Y (CGGMP21 Fig. 29, Πmul)
+	MulProof struct {
+		A, B    *big.Int
+		Z, U, V *big.Int
+	}
+)
+
+// ProveMul proves that X = (1 + N)^x * rhoX^N mod N^2 and C = Y^x * rho^N mod N^2 under the key of the prover
+func ProveMul(rand io.Reader, session []byte, ec elliptic.Curve, pk *PublicKey, X, Y, C, x, rho, rhoX *big.Int) (*MulProof, error) {
+	if pk == nil || common.AnyIsNil(X, Y, C, x, rho, rhoX) {
+		return nil, errors.New("ProveMul() received a nil argument")
+	}
+	N := pk.N
+	q := ec.Params().N
+
+	alpha := common.GetRandomPositiveRelativelyPrimeIntWithRand(rand, N)
+	r := common.GetRandomPositiveRelativelyPrimeIntWithRand(rand, N)
+	s := common.GetRandomPositiveRelativelyPrimeIntWithRand(rand, N)
+
+	modNSquare := common.ModInt(pk.NSquare())
+	A := modNSquare.ExpMulExp(Y, alpha, r, N)
+	B := pk.EncryptWithRandomness(alpha, s)
+
+	e := zkChallenge(session, q, N, X, Y, C, A, B)
+
+	modN := common.ModInt(N)
+	z := common.AddMul(alpha, e, x)
+	u := modN.MulExp(r, rho, e)
+	v := modN.MulExp(s, rhoX, e)
+	return &MulProof{A: A, B: B, Z: z, U: u, V: v}, nil
+}
+
+func MulProofFromBytes(bzs [][]byte) (*MulProof, error) {
+	if !common.NonEmptyMultiBytes(bzs, MulProofBytesParts) {
+		return nil, fmt.Errorf("expected %d byte parts to construct MulProof", MulProofBytesParts)
+	}
+	is := signedFromBytes(bzs)
+	return &MulProof{A: is[0], B: is[1], Z: is[2], U: is[3], V: is[4]}, nil
+}
+
+func (pf *MulProof) Verify(session []byte, ec elliptic.Curve, pk *PublicKey, X, Y, C *big.Int) bool {
+	if pf == nil || !pf.ValidateBasic() || pk == nil || common.AnyIsNil(X, Y, C) {
+		return false
+	}
+	N, NSquare := pk.N, pk.NSquare()
+	q := ec.Params().N
+
+	if !inGroup(NSquare, X, Y, C, pf.A, pf.B) || !inGroup(N, pf.U, pf.V) {
+		return false
+	}
+
+	e := zkChallenge(session, q, N, X, Y, C, pf.A, pf.B)
+
+	modNSquare := common.ModInt(NSquare)
+	if modNSquare.ExpMulExp(Y, pf.Z, pf.U, N).Cmp(modNSquare.MulExp(pf.A, C, e)) != 0 {
+		return false
+	}
+	return pk.EncryptWithRandomness(pf.Z, pf.V).Cmp(modNSquare.MulExp(pf.B, X, e)) == 0
+}
+
+func (pf *MulProof) ValidateBasic() bool {
+	return !common.AnyIsNil(pf.A, pf.B, pf.Z, pf.U, pf.V)
+}
+
+func (pf *MulProof) Bytes() [MulProofBytesParts][]byte {
+	var out [MulProofBytesParts][]byte
+	copy(out[:], signedToBytes(pf.A, pf.B, pf.Z, pf.U, pf.V))
+	return out
+}
@@ -0,0 +1,44 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package paillier
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func TestMulProofVerify(t *testing.T) {
+	setUp(t)
+	ec := tss.EC()
+	q := ec.Params().N
+	x := common.GetRandomPositiveInt(q)
+	X, rhoX, err := publicKey.EncryptAndReturnRandomness(x)
+	assert.NoError(t, err)
+	Y, err := publicKey.Encrypt(common.GetRandomPositiveInt(q))
+	assert.NoError(t, err)
+	rho := common.GetRandomPositiveRelativelyPrimeInt(publicKey.N)
+	C := common.ModInt(publicKey.NSquare()).ExpMulExp(Y, x, rho, publicKey.N)
+
+	proof, err := ProveMul(rand.Reader, []byte("session"), ec, publicKey, X, Y, C, x, rho, rhoX)
+	assert.NoError(t, err)
+	assert.True(t, proof.Verify([]byte("session"), ec, publicKey, X, Y, C), "proof must verify")
+
+	bzs := proof.Bytes()
+	proof2, err := MulProofFromBytes(bzs[:])
+	assert.NoError(t, err)
+	assert.True(t, proof2.Verify([]byte("session"), ec, publicKey, X, Y, C), "proof must verify after a round trip")
+
+	C2, err := publicKey.HomoAdd(C, publicKey.EncryptWithRandomness(big.NewInt(1), rho))
+	assert.NoError(t, err)
+	assert.False(t, proof.Verify([]byte("session"), ec, publicKey, X, Y, C2), "proof must not verify for another C")
+}
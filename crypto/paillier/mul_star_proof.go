@@ -0,0 +1,118 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package paillier
+
+import (
+	"crypto/elliptic"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	crypto2 "github.com/bnb-chain/tss-lib/crypto"
+)
+
+const (
+	MulStarProofBytesParts = 8
+)
+
+type (
+	// MulStarProof proves that D = C^x * rho^N0 mod N0^2 for the discrete log x in ±2^ℓ of X (CGGMP21 Fig. 31, Πmul*)
+	MulStarProof struct {
+		A      *big.Int
+		Bx     *crypto2.ECPoint
+		E, S   *big.Int
+		Z1, Z2 *big.Int
+		W      *big.Int
+	}
+)
+
+// ProveMulStar proves that D = C^x * rho^N0 mod N0^2 and X = g^x, to a verifier with the ring-Pedersen parameters (NHat, s, t)
+func ProveMulStar(rand io.Reader, session []byte, ec elliptic.Curve, pk *PublicKey, NHat, s, t, C, D *big.Int, X *crypto2.ECPoint, x, rho *big.Int) (*MulStarProof, error) {
+	if pk == nil || X == nil || common.AnyIsNil(NHat, s, t, C, D, x, rho) {
+		return nil, errors.New("ProveMulStar() received a nil argument")
+	}
+	N0 := pk.N
+	q := ec.Params().N
+
+	alpha := common.GetRandomIntIn2PowerRangeWithRand(rand, PARAM_L+PARAM_E)
+	r := common.GetRandomPositiveRelativelyPrimeIntWithRand(rand, N0)
+	gamma := common.GetRandomIntIn2PowerMulRangeWithRand(rand, PARAM_L+PARAM_E, NHat)
+	m := common.GetRandomIntIn2PowerMulRangeWithRand(rand, PARAM_L, NHat)
+
+	modNHat := common.ModInt(NHat)
+	A := common.ModInt(pk.NSquare()).ExpMulExp(C, alpha, r, N0)
+	Bx := scalarMult(ec, nil, alpha)
+	E := modNHat.ExpMulExp(s, alpha, t, gamma)
+	S := modNHat.ExpMulExp(s, x, t, m)
+
+	e := mulStarChallenge(session, q, N0, NHat, s, t, C, D, X, A, Bx, E, S)
+
+	z1 := common.AddMul(alpha, e, x)
+	z2 := common.AddMul(gamma, e, m)
+	w := common.ModInt(N0).MulExp(r, rho, e)
+	return &MulStarProof{A: A, Bx: Bx, E: E, S: S, Z1: z1, Z2: z2, W: w}, nil
+}
+
+func MulStarProofFromBytes(ec elliptic.Curve, bzs [][]byte) (*MulStarProof, error) {
+	if !common.NonEmptyMultiBytes(bzs, MulStarProofBytesParts) {
+		return nil, fmt.Errorf("expected %d byte parts to construct MulStarProof", MulStarProofBytesParts)
+	}
+	is := signedFromBytes(bzs)
+	Bx, err := crypto2.NewECPoint(ec, is[1], is[2])
+	if err != nil {
+		return nil, err
+	}
+	return &MulStarProof{A: is[0], Bx: Bx, E: is[3], S: is[4], Z1: is[5], Z2: is[6], W: is[7]}, nil
+}
+
+func (pf *MulStarProof) Verify(session []byte, ec elliptic.Curve, pk *PublicKey, NHat, s, t, C, D *big.Int, X *crypto2.ECPoint) bool {
+	if pf == nil || !pf.ValidateBasic() || pk == nil || X == nil || common.AnyIsNil(NHat, s, t, C, D) {
+		return false
+	}
+	N0, N0Square := pk.N, pk.NSquare()
+	q := ec.Params().N
+
+	if !inRange(pf.Z1, PARAM_L+PARAM_E, nil) {
+		return false
+	}
+	if !inGroup(NHat, s, t, pf.E, pf.S) || !inGroup(N0Square, C, D, pf.A) || !inGroup(N0, pf.W) {
+		return false
+	}
+
+	e := mulStarChallenge(session, q, N0, NHat, s, t, C, D, X, pf.A, pf.Bx, pf.E, pf.S)
+
+	modN0Square := common.ModInt(N0Square)
+	if modN0Square.ExpMulExp(C, pf.Z1, pf.W, N0).Cmp(modN0Square.MulExp(pf.A, D, e)) != 0 {
+		return false
+	}
+	if !pointsEqual(ec, nil, pf.Z1, pf.Bx, X, e) {
+		return false
+	}
+	modNHat := common.ModInt(NHat)
+	return modNHat.ExpMulExp(s, pf.Z1, t, pf.Z2).Cmp(modNHat.MulExp(pf.E, pf.S, e)) == 0
+}
+
+func (pf *MulStarProof) ValidateBasic() bool {
+	return pf.Bx != nil && pf.Bx.ValidateBasic() && !common.AnyIsNil(pf.A, pf.E, pf.S, pf.Z1, pf.Z2, pf.W)
+}
+
+func (pf *MulStarProof) Bytes() [MulStarProofBytesParts][]byte {
+	var out [MulStarProofBytesParts][]byte
+	copy(out[:], signedToBytes(pf.A, pf.Bx.X(), pf.Bx.Y(), pf.E, pf.S, pf.Z1, pf.Z2, pf.W))
+	return out
+}
+
+func mulStarChallenge(session []byte, q, N0, NHat, s, t, C, D *big.Int, X *crypto2.ECPoint, A *big.Int, Bx *crypto2.ECPoint, E, S *big.Int) *big.Int {
+	in := []*big.Int{N0, NHat, s, t, C, D}
+	in = append(in, pointToInts(X)...)
+	in = append(in, A)
+	in = append(in, pointToInts(Bx)...)
+	in = append(in, E, S)
+	return zkChallenge(session, q, in...)
+}
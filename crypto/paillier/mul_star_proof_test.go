@@ -0,0 +1,43 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package paillier
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func TestMulStarProofVerify(t *testing.T) {
+	zkSetUp(t)
+	ec := tss.EC()
+	q := ec.Params().N
+	C, err := publicKey.Encrypt(common.GetRandomPositiveInt(q))
+	assert.NoError(t, err)
+	x := common.GetRandomPositiveInt(q)
+	rho := common.GetRandomPositiveRelativelyPrimeInt(publicKey.N)
+	D := common.ModInt(publicKey.NSquare()).ExpMulExp(C, x, rho, publicKey.N)
+	X := crypto.ScalarBaseMult(ec, x)
+
+	proof, err := ProveMulStar(rand.Reader, []byte("session"), ec, publicKey, auxPrime.N, s, tt, C, D, X, x, rho)
+	assert.NoError(t, err)
+	assert.True(t, proof.Verify([]byte("session"), ec, publicKey, auxPrime.N, s, tt, C, D, X), "proof must verify")
+
+	bzs := proof.Bytes()
+	proof2, err := MulStarProofFromBytes(ec, bzs[:])
+	assert.NoError(t, err)
+	assert.True(t, proof2.Verify([]byte("session"), ec, publicKey, auxPrime.N, s, tt, C, D, X), "proof must verify after a round trip")
+
+	X2 := crypto.ScalarBaseMult(ec, new(big.Int).Add(x, big.NewInt(1)))
+	assert.False(t, proof.Verify([]byte("session"), ec, publicKey, auxPrime.N, s, tt, C, D, X2), "proof must not verify for another X")
+}
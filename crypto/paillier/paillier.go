@@ -17,8 +17,10 @@ package paillier
 
 import (
 	"context"
+	"crypto/rand"
 	"errors"
 	"fmt"
+	"io"
 	gmath "math"
 	"math/big"
 	"runtime"
@@ -66,6 +68,11 @@ func init() {
 
 // len is the length of the modulus (each prime = len / 2)
 func GenerateKeyPair(ctx context.Context, modulusBitLen int, optionalConcurrency ...int) (privateKey *PrivateKey, publicKey *PublicKey, err error) {
+	return GenerateKeyPairWithRand(ctx, rand.Reader, modulusBitLen, optionalConcurrency...)
+}
+
+// GenerateKeyPairWithRand is GenerateKeyPair with the randomness drawn from `rand`.
+func GenerateKeyPairWithRand(ctx context.Context, rand io.Reader, modulusBitLen int, optionalConcurrency ...int) (privateKey *PrivateKey, publicKey *PublicKey, err error) {
 	var concurrency int
 	if 0 < len(optionalConcurrency) {
 		if 1 < len(optionalConcurrency) {
@@ -81,7 +88,7 @@ func GenerateKeyPair(ctx context.Context, modulusBitLen int, optionalConcurrency
 	{
 		tmp := new(big.Int)
 		for {
-			sgps, err := common.GetRandomSafePrimesConcurrent(ctx, modulusBitLen/2, 2, concurrency)
+			sgps, err := common.GetRandomSafePrimesConcurrentWithRand(ctx, rand, modulusBitLen/2, 2, concurrency)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -110,10 +117,15 @@ func GenerateKeyPair(ctx context.Context, modulusBitLen int, optionalConcurrency
 // ----- //
 
 func (publicKey *PublicKey) EncryptAndReturnRandomness(m *big.Int) (c *big.Int, x *big.Int, err error) {
+	return publicKey.EncryptAndReturnRandomnessWithRand(rand.Reader, m)
+}
+
+// EncryptAndReturnRandomnessWithRand is EncryptAndReturnRandomness with the randomness drawn from `rand`.
+func (publicKey *PublicKey) EncryptAndReturnRandomnessWithRand(rand io.Reader, m *big.Int) (c *big.Int, x *big.Int, err error) {
 	if m.Cmp(zero) == -1 || m.Cmp(publicKey.N) != -1 { // m < 0 || m >= N ?
 		return nil, nil, ErrMessageTooLong
 	}
-	x = common.GetRandomPositiveRelativelyPrimeInt(publicKey.N)
+	x = common.GetRandomPositiveRelativelyPrimeIntWithRand(rand, publicKey.N)
 	N2 := publicKey.NSquare()
 	// 1. gamma^m mod N2
 	Gm := new(big.Int).Exp(publicKey.Gamma(), m, N2)
@@ -125,7 +137,12 @@ func (publicKey *PublicKey) EncryptAndReturnRandomness(m *big.Int) (c *big.Int,
 }
 
 func (publicKey *PublicKey) Encrypt(m *big.Int) (c *big.Int, err error) {
-	c, _, err = publicKey.EncryptAndReturnRandomness(m)
+	return publicKey.EncryptWithRand(rand.Reader, m)
+}
+
+// EncryptWithRand is Encrypt with the randomness drawn from `rand`.
+func (publicKey *PublicKey) EncryptWithRand(rand io.Reader, m *big.Int) (c *big.Int, err error) {
+	c, _, err = publicKey.EncryptAndReturnRandomnessWithRand(rand, m)
 	return
 }
 
@@ -195,10 +212,10 @@ func (privateKey *PrivateKey) Decrypt(c *big.Int) (m *big.Int, err error) {
 // In: In Proc. of the 5th ACM Conference on Computer and Communications Security (CCS-98. Citeseer (1998)
 //
 // This only implements the stage 1 proof that N is square-free from 3.1
-func (privateKey *PrivateKey) Proof(k *big.Int, ecdsaPub *crypto2.ECPoint) Proof {
+func (privateKey *PrivateKey) Proof(session []byte, k *big.Int, ecdsaPub *crypto2.ECPoint) Proof {
 	var pi Proof
 	iters := ProofIters
-	xs := GenerateXs(iters, k, privateKey.N, ecdsaPub)
+	xs := GenerateXs(session, iters, k, privateKey.N, ecdsaPub)
 	for i := 0; i < iters; i++ {
 		M := new(big.Int).ModInverse(privateKey.N, privateKey.PhiN)
 		pi[i] = new(big.Int).Exp(xs[i], M, privateKey.N)
@@ -206,7 +223,7 @@ func (privateKey *PrivateKey) Proof(k *big.Int, ecdsaPub *crypto2.ECPoint) Proof
 	return pi
 }
 
-func (pf Proof) Verify(pkN, k *big.Int, ecdsaPub *crypto2.ECPoint) (bool, error) {
+func (pf Proof) Verify(session []byte, pkN, k *big.Int, ecdsaPub *crypto2.ECPoint) (bool, error) {
 	iters := ProofIters
 	pch, xch := make(chan bool, 1), make(chan []*big.Int, 1) // buffered to allow early exit
 	prms := primes.Until(verifyPrimesUntil).List()           // uses cache primed in init()
@@ -221,7 +238,7 @@ func (pf Proof) Verify(pkN, k *big.Int, ecdsaPub *crypto2.ECPoint) (bool, error)
 		ch <- true
 	}(pch)
 	go func(ch chan<- []*big.Int) {
-		ch <- GenerateXs(iters, k, pkN, ecdsaPub)
+		ch <- GenerateXs(session, iters, k, pkN, ecdsaPub)
 	}(xch)
 	for j := 0; j < 2; j++ {
 		select {
@@ -252,8 +269,8 @@ func L(u, N *big.Int) *big.Int {
 	return new(big.Int).Div(t, N)
 }
 
-// GenerateXs generates the challenges used in Paillier key Proof
-func GenerateXs(m int, k, N *big.Int, ecdsaPub *crypto2.ECPoint) []*big.Int {
+// GenerateXs generates the challenges used in Paillier key Proof, bound to the protocol session when one is given
+func GenerateXs(session []byte, m int, k, N *big.Int, ecdsaPub *crypto2.ECPoint) []*big.Int {
 	var i, n int
 	ret := make([]*big.Int, m)
 	sX, sY := ecdsaPub.X(), ecdsaPub.Y()
@@ -271,7 +288,11 @@ func GenerateXs(m int, k, N *big.Int, ecdsaPub *crypto2.ECPoint) []*big.Int {
 		for j := 0; j < blocks; j++ {
 			go func(j int) {
 				jBz := []byte(strconv.Itoa(j))
-				hash := common.SHA512_256(ib, jBz, nb, kb, sXb, sYb, Nb)
+				in := [][]byte{ib, jBz, nb, kb, sXb, sYb, Nb}
+				if 0 < len(session) {
+					in = append([][]byte{session}, in...)
+				}
+				hash := common.SHA512_256(in...)
 				chs[j] <- hash
 			}(j)
 		}
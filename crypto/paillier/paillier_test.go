@@ -112,8 +112,8 @@ func TestProofVerify(t *testing.T) {
 	ki := common.MustGetRandomInt(256)                     // index
 	ui := common.GetRandomPositiveInt(tss.EC().Params().N) // ECDSA private
 	yX, yY := tss.EC().ScalarBaseMult(ui.Bytes())          // ECDSA public
-	proof := privateKey.Proof(ki, crypto.NewECPointNoCurveCheck(tss.EC(), yX, yY))
-	res, err := proof.Verify(publicKey.N, ki, crypto.NewECPointNoCurveCheck(tss.EC(), yX, yY))
+	proof := privateKey.Proof(nil, ki, crypto.NewECPointNoCurveCheck(tss.EC(), yX, yY))
+	res, err := proof.Verify(nil, publicKey.N, ki, crypto.NewECPointNoCurveCheck(tss.EC(), yX, yY))
 	assert.NoError(t, err)
 	assert.True(t, res, "proof verify result must be true")
 }
@@ -123,10 +123,10 @@ func TestProofVerifyFail(t *testing.T) {
 	ki := common.MustGetRandomInt(256)                     // index
 	ui := common.GetRandomPositiveInt(tss.EC().Params().N) // ECDSA private
 	yX, yY := tss.EC().ScalarBaseMult(ui.Bytes())          // ECDSA public
-	proof := privateKey.Proof(ki, crypto.NewECPointNoCurveCheck(tss.EC(), yX, yY))
+	proof := privateKey.Proof(nil, ki, crypto.NewECPointNoCurveCheck(tss.EC(), yX, yY))
 	last := proof[len(proof)-1]
 	last.Sub(last, big.NewInt(1))
-	res, err := proof.Verify(publicKey.N, ki, crypto.NewECPointNoCurveCheck(tss.EC(), yX, yY))
+	res, err := proof.Verify(nil, publicKey.N, ki, crypto.NewECPointNoCurveCheck(tss.EC(), yX, yY))
 	assert.NoError(t, err)
 	assert.False(t, res, "proof verify result must be true")
 }
@@ -147,7 +147,7 @@ func TestGenerateXs(t *testing.T) {
 	sY := common.MustGetRandomInt(256)
 	N := common.GetRandomPrimeInt(2048)
 
-	xs := GenerateXs(13, k, N, crypto.NewECPointNoCurveCheck(tss.EC(), sX, sY))
+	xs := GenerateXs(nil, 13, k, N, crypto.NewECPointNoCurveCheck(tss.EC(), sX, sY))
 	assert.Equal(t, 13, len(xs))
 	for _, xi := range xs {
 		assert.True(t, common.IsNumberInMultiplicativeGroup(N, xi))
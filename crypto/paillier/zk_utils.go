@@ -0,0 +1,108 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package paillier
+
+import (
+	"crypto/elliptic"
+	"errors"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	crypto2 "github.com/bnb-chain/tss-lib/crypto"
+)
+
+// PARAM_LPrime is the bit length ℓ' of the masks of the affine operations in the range proofs of CGGMP21, 5 * ℓ
+const PARAM_LPrime = 5 * PARAM_L
+
+// EncryptWithRandomness returns the encryption of `m` with the randomness `x`, (1 + N)^m * x^N mod N^2.
+// Unlike Encrypt, `m` may be negative or exceed N, as is needed by the proofs of CGGMP21.
+func (publicKey *PublicKey) EncryptWithRandomness(m, x *big.Int) *big.Int {
+	modN2 := common.ModInt(publicKey.NSquare())
+	return modN2.ExpMulExp(publicKey.Gamma(), m, x, publicKey.N)
+}
+
+// DecryptAndRecoverRandomness decrypts `c` and returns its plaintext along with the randomness x such that c = (1 + N)^m * x^N mod N^2
+func (privateKey *PrivateKey) DecryptAndRecoverRandomness(c *big.Int) (m, x *big.Int, err error) {
+	if m, err = privateKey.Decrypt(c); err != nil {
+		return nil, nil, err
+	}
+	N := privateKey.N
+	// x^N mod N = c * (1 + N)^-m mod N
+	xN := common.ModInt(privateKey.NSquare()).Mul(c, new(big.Int).Exp(privateKey.Gamma(), new(big.Int).Neg(m), privateKey.NSquare()))
+	xN.Mod(xN, N)
+	NInv := new(big.Int).ModInverse(N, privateKey.PhiN)
+	if NInv == nil {
+		return nil, nil, errors.New("the modulus is not coprime with its totient")
+	}
+	return m, new(big.Int).Exp(xN, NInv, N), nil
+}
+
+// ----- //
+
+// zkChallenge derives the challenge e in ±q of a proof from its statement and first message, bound to the session
+func zkChallenge(session []byte, q *big.Int, in ...*big.Int) *big.Int {
+	qMinus1 := new(big.Int).Sub(q, one)
+	e := common.HashToNTagged(session, new(big.Int).Add(q, qMinus1), in...)
+	return e.Sub(e, qMinus1)
+}
+
+// inRange reports whether |z| <= 2^power * multiplier
+func inRange(z *big.Int, power uint, multiplier *big.Int) bool {
+	limit := new(big.Int).Lsh(one, power)
+	if multiplier != nil {
+		limit.Mul(limit, multiplier)
+	}
+	return z.CmpAbs(limit) <= 0
+}
+
+// inGroup reports whether every value is in the multiplicative group of integers modulo n
+func inGroup(n *big.Int, values ...*big.Int) bool {
+	for _, v := range values {
+		if !common.IsNumberInMultiplicativeGroup(n, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// scalarMult returns g^k for a signed k, or G^k when g is nil
+func scalarMult(ec elliptic.Curve, g *crypto2.ECPoint, k *big.Int) *crypto2.ECPoint {
+	k = new(big.Int).Mod(k, ec.Params().N)
+	if g == nil {
+		return crypto2.ScalarBaseMult(ec, k)
+	}
+	return g.ScalarMult(k)
+}
+
+// pointsEqual reports whether g^z = A * X^e
+func pointsEqual(ec elliptic.Curve, g *crypto2.ECPoint, z *big.Int, A, X *crypto2.ECPoint, e *big.Int) bool {
+	right, err := A.Add(scalarMult(ec, X, e))
+	if err != nil {
+		return false
+	}
+	return scalarMult(ec, g, z).Equals(right)
+}
+
+func pointToInts(p *crypto2.ECPoint) []*big.Int {
+	return []*big.Int{p.X(), p.Y()}
+}
+
+func signedToBytes(is ...*big.Int) [][]byte {
+	bzs := make([][]byte, len(is))
+	for i, v := range is {
+		bzs[i] = common.MarshalSigned(v)
+	}
+	return bzs
+}
+
+func signedFromBytes(bzs [][]byte) []*big.Int {
+	is := make([]*big.Int, len(bzs))
+	for i, bz := range bzs {
+		is[i] = common.UnmarshalSigned(bz)
+	}
+	return is
+}
@@ -0,0 +1,60 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package paillier
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+)
+
+var (
+	otherPrivateKey *PrivateKey
+)
+
+// zkSetUp prepares a second Paillier key and the ring-Pedersen parameters (auxPrime.N, s, tt) of a verifier
+func zkSetUp(t *testing.T) {
+	facSetUp(t)
+	if otherPrivateKey != nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	var err error
+	otherPrivateKey, _, err = GenerateKeyPair(ctx, testPaillierKeyLength)
+	assert.NoError(t, err)
+}
+
+func TestEncryptWithRandomness(t *testing.T) {
+	setUp(t)
+	m := common.GetRandomPositiveInt(publicKey.N)
+	c, x, err := publicKey.EncryptAndReturnRandomness(m)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, c.Cmp(publicKey.EncryptWithRandomness(m, x)))
+
+	// negative plaintexts wrap modulo N
+	neg := new(big.Int).Sub(m, publicKey.N)
+	assert.Equal(t, 0, c.Cmp(publicKey.EncryptWithRandomness(neg, x)))
+}
+
+func TestDecryptAndRecoverRandomness(t *testing.T) {
+	setUp(t)
+	m := common.GetRandomPositiveInt(publicKey.N)
+	c, x, err := publicKey.EncryptAndReturnRandomness(m)
+	assert.NoError(t, err)
+
+	m2, x2, err := privateKey.DecryptAndRecoverRandomness(c)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, m.Cmp(m2))
+	assert.Equal(t, 0, x.Cmp(x2))
+}
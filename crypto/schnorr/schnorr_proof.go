@@ -7,7 +7,9 @@
 package schnorr
 
 import (
+	"crypto/rand"
 	"errors"
+	"io"
 	"math/big"
 
 	"github.com/bnb-chain/tss-lib/common"
@@ -27,7 +29,13 @@ type (
 )
 
 // NewZKProof constructs a new Schnorr ZK proof of knowledge of the discrete logarithm (GG18Spec Fig. 16)
-func NewZKProof(x *big.Int, X *crypto.ECPoint) (*ZKProof, error) {
+// The challenge is bound to `session` so that the proof cannot be replayed into another protocol run.
+func NewZKProof(session []byte, x *big.Int, X *crypto.ECPoint) (*ZKProof, error) {
+	return NewZKProofWithRand(rand.Reader, session, x, X)
+}
+
+// NewZKProofWithRand is NewZKProof with the randomness drawn from `rand`.
+func NewZKProofWithRand(rand io.Reader, session []byte, x *big.Int, X *crypto.ECPoint) (*ZKProof, error) {
 	if x == nil || X == nil || !X.ValidateBasic() {
 		return nil, errors.New("ZKProof constructor received nil or invalid value(s)")
 	}
@@ -36,10 +44,10 @@ func NewZKProof(x *big.Int, X *crypto.ECPoint) (*ZKProof, error) {
 	q := ecParams.N
 	g := crypto.NewECPointNoCurveCheck(ec, ecParams.Gx, ecParams.Gy) // already on the curve.
 
-	a := common.GetRandomPositiveInt(q)
+	a := common.GetRandomPositiveIntWithRand(rand, q)
 	alpha := crypto.ScalarBaseMult(ec, a)
 
-	c := common.HashToN(q, X.X(), X.Y(), g.X(), g.Y(), alpha.X(), alpha.Y())
+	c := common.HashToNTagged(session, q, X.X(), X.Y(), g.X(), g.Y(), alpha.X(), alpha.Y())
 	t := new(big.Int).Mul(c, x)
 	t = common.ModInt(q).Add(a, t)
 
@@ -47,7 +55,7 @@ func NewZKProof(x *big.Int, X *crypto.ECPoint) (*ZKProof, error) {
 }
 
 // NewZKProof verifies a new Schnorr ZK proof of knowledge of the discrete logarithm (GG18Spec Fig. 16)
-func (pf *ZKProof) Verify(X *crypto.ECPoint) bool {
+func (pf *ZKProof) Verify(session []byte, X *crypto.ECPoint) bool {
 	if pf == nil || !pf.ValidateBasic() {
 		return false
 	}
@@ -56,7 +64,7 @@ func (pf *ZKProof) Verify(X *crypto.ECPoint) bool {
 	q := ecParams.N
 	g := crypto.NewECPointNoCurveCheck(ec, ecParams.Gx, ecParams.Gy)
 
-	c := common.HashToN(q, X.X(), X.Y(), g.X(), g.Y(), pf.Alpha.X(), pf.Alpha.Y())
+	c := common.HashToNTagged(session, q, X.X(), X.Y(), g.X(), g.Y(), pf.Alpha.X(), pf.Alpha.Y())
 
 	tG := crypto.ScalarBaseMult(ec, pf.T)
 	Xc := X.ScalarMult(c)
@@ -72,7 +80,12 @@ func (pf *ZKProof) ValidateBasic() bool {
 }
 
 // NewZKProof constructs a new Schnorr ZK proof of knowledge s_i, l_i such that V_i = R^s_i, g^l_i (GG18Spec Fig. 17)
-func NewZKVProof(V, R *crypto.ECPoint, s, l *big.Int) (*ZKVProof, error) {
+func NewZKVProof(session []byte, V, R *crypto.ECPoint, s, l *big.Int) (*ZKVProof, error) {
+	return NewZKVProofWithRand(rand.Reader, session, V, R, s, l)
+}
+
+// NewZKVProofWithRand is NewZKVProof with the randomness drawn from `rand`.
+func NewZKVProofWithRand(rand io.Reader, session []byte, V, R *crypto.ECPoint, s, l *big.Int) (*ZKVProof, error) {
 	if V == nil || R == nil || s == nil || l == nil || !V.ValidateBasic() || !R.ValidateBasic() {
 		return nil, errors.New("ZKVProof constructor received nil value(s)")
 	}
@@ -81,12 +94,12 @@ func NewZKVProof(V, R *crypto.ECPoint, s, l *big.Int) (*ZKVProof, error) {
 	q := ecParams.N
 	g := crypto.NewECPointNoCurveCheck(ec, ecParams.Gx, ecParams.Gy)
 
-	a, b := common.GetRandomPositiveInt(q), common.GetRandomPositiveInt(q)
+	a, b := common.GetRandomPositiveIntWithRand(rand, q), common.GetRandomPositiveIntWithRand(rand, q)
 	aR := R.ScalarMult(a)
 	bG := crypto.ScalarBaseMult(ec, b)
 	alpha, _ := aR.Add(bG) // already on the curve.
 
-	c := common.HashToN(q, V.X(), V.Y(), R.X(), R.Y(), g.X(), g.Y(), alpha.X(), alpha.Y())
+	c := common.HashToNTagged(session, q, V.X(), V.Y(), R.X(), R.Y(), g.X(), g.Y(), alpha.X(), alpha.Y())
 
 	modQ := common.ModInt(q)
 	t := modQ.Add(a, new(big.Int).Mul(c, s))
@@ -95,7 +108,7 @@ func NewZKVProof(V, R *crypto.ECPoint, s, l *big.Int) (*ZKVProof, error) {
 	return &ZKVProof{Alpha: alpha, T: t, U: u}, nil
 }
 
-func (pf *ZKVProof) Verify(V, R *crypto.ECPoint) bool {
+func (pf *ZKVProof) Verify(session []byte, V, R *crypto.ECPoint) bool {
 	if pf == nil || !pf.ValidateBasic() {
 		return false
 	}
@@ -104,7 +117,7 @@ func (pf *ZKVProof) Verify(V, R *crypto.ECPoint) bool {
 	q := ecParams.N
 	g := crypto.NewECPointNoCurveCheck(ec, ecParams.Gx, ecParams.Gy)
 
-	c := common.HashToN(q, V.X(), V.Y(), R.X(), R.Y(), g.X(), g.Y(), pf.Alpha.X(), pf.Alpha.Y())
+	c := common.HashToNTagged(session, q, V.X(), V.Y(), R.X(), R.Y(), g.X(), g.Y(), pf.Alpha.X(), pf.Alpha.Y())
 
 	tR := R.ScalarMult(pf.T)
 	uG := crypto.ScalarBaseMult(ec, pf.U)
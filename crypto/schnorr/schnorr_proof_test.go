@@ -21,7 +21,7 @@ func TestSchnorrProof(t *testing.T) {
 	q := tss.EC().Params().N
 	u := common.GetRandomPositiveInt(q)
 	uG := crypto.ScalarBaseMult(tss.EC(), u)
-	proof, _ := NewZKProof(u, uG)
+	proof, _ := NewZKProof(nil, u, uG)
 
 	assert.True(t, proof.Alpha.IsOnCurve())
 	assert.NotZero(t, proof.Alpha.X())
@@ -34,8 +34,8 @@ func TestSchnorrProofVerify(t *testing.T) {
 	u := common.GetRandomPositiveInt(q)
 	X := crypto.ScalarBaseMult(tss.EC(), u)
 
-	proof, _ := NewZKProof(u, X)
-	res := proof.Verify(X)
+	proof, _ := NewZKProof(nil, u, X)
+	res := proof.Verify(nil, X)
 
 	assert.True(t, res, "verify result must be true")
 }
@@ -47,12 +47,23 @@ func TestSchnorrProofVerifyBadX(t *testing.T) {
 	X := crypto.ScalarBaseMult(tss.EC(), u)
 	X2 := crypto.ScalarBaseMult(tss.EC(), u2)
 
-	proof, _ := NewZKProof(u2, X2)
-	res := proof.Verify(X)
+	proof, _ := NewZKProof(nil, u2, X2)
+	res := proof.Verify(nil, X)
 
 	assert.False(t, res, "verify result must be false")
 }
 
+func TestSchnorrProofVerifyBadSession(t *testing.T) {
+	q := tss.EC().Params().N
+	u := common.GetRandomPositiveInt(q)
+	X := crypto.ScalarBaseMult(tss.EC(), u)
+
+	proof, _ := NewZKProof([]byte("session-1"), u, X)
+	assert.True(t, proof.Verify([]byte("session-1"), X), "verify result must be true")
+	assert.False(t, proof.Verify([]byte("session-2"), X), "verify result must be false in another session")
+	assert.False(t, proof.Verify(nil, X), "verify result must be false without the session")
+}
+
 func TestSchnorrVProofVerify(t *testing.T) {
 	q := tss.EC().Params().N
 	k := common.GetRandomPositiveInt(q)
@@ -63,8 +74,8 @@ func TestSchnorrVProofVerify(t *testing.T) {
 	lG := crypto.ScalarBaseMult(tss.EC(), l)
 	V, _ := Rs.Add(lG)
 
-	proof, _ := NewZKVProof(V, R, s, l)
-	res := proof.Verify(V, R)
+	proof, _ := NewZKVProof(nil, V, R, s, l)
+	res := proof.Verify(nil, V, R)
 
 	assert.True(t, res, "verify result must be true")
 }
@@ -78,8 +89,8 @@ func TestSchnorrVProofVerifyBadPartialV(t *testing.T) {
 	Rs := R.ScalarMult(s)
 	V := Rs
 
-	proof, _ := NewZKVProof(V, R, s, l)
-	res := proof.Verify(V, R)
+	proof, _ := NewZKVProof(nil, V, R, s, l)
+	res := proof.Verify(nil, V, R)
 
 	assert.False(t, res, "verify result must be false")
 }
@@ -95,8 +106,8 @@ func TestSchnorrVProofVerifyBadS(t *testing.T) {
 	lG := crypto.ScalarBaseMult(tss.EC(), l)
 	V, _ := Rs.Add(lG)
 
-	proof, _ := NewZKVProof(V, R, s2, l)
-	res := proof.Verify(V, R)
+	proof, _ := NewZKVProof(nil, V, R, s2, l)
+	res := proof.Verify(nil, V, R)
 
 	assert.False(t, res, "verify result must be false")
 }
@@ -12,8 +12,10 @@ package vss
 
 import (
 	"crypto/elliptic"
+	"crypto/rand"
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
 
 	"github.com/bnb-chain/tss-lib/common"
@@ -59,6 +61,11 @@ func CheckIndexes(ec elliptic.Curve, indexes []*big.Int) ([]*big.Int, error) {
 // Returns a new array of secret shares created by Shamir's Secret Sharing Algorithm,
 // requiring a minimum number of shares to recreate, of length shares, from the input secret
 func Create(ec elliptic.Curve, threshold int, secret *big.Int, indexes []*big.Int) (Vs, Shares, error) {
+	return CreateWithRand(rand.Reader, ec, threshold, secret, indexes)
+}
+
+// CreateWithRand is Create with the randomness drawn from `rand`.
+func CreateWithRand(rand io.Reader, ec elliptic.Curve, threshold int, secret *big.Int, indexes []*big.Int) (Vs, Shares, error) {
 	if secret == nil || indexes == nil {
 		return nil, nil, fmt.Errorf("vss secret or indexes == nil: %v %v", secret, indexes)
 	}
@@ -76,7 +83,7 @@ func Create(ec elliptic.Curve, threshold int, secret *big.Int, indexes []*big.In
 		return nil, nil, ErrNumSharesBelowThreshold
 	}
 
-	poly := samplePolynomial(ec, threshold, secret)
+	poly := samplePolynomial(ec, threshold, secret, rand)
 	poly[0] = secret // becomes sigma*G in v
 	v := make(Vs, len(poly))
 	for i, ai := range poly {
@@ -91,8 +98,77 @@ func Create(ec elliptic.Curve, threshold int, secret *big.Int, indexes []*big.In
 	return v, shares, nil
 }
 
+// CreateZeroSharing returns shares of zero for a polynomial of degree `threshold`, as used to refresh existing shares.
+// The commitments are to the coefficients v1..vt, since that of v0 would be the point at infinity.
+func CreateZeroSharing(rand io.Reader, ec elliptic.Curve, threshold int, indexes []*big.Int) (Vs, Shares, error) {
+	if indexes == nil {
+		return nil, nil, errors.New("vss indexes == nil")
+	}
+	if threshold < 1 {
+		return nil, nil, errors.New("vss threshold < 1")
+	}
+	ids, err := CheckIndexes(ec, indexes)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(ids) < threshold {
+		return nil, nil, ErrNumSharesBelowThreshold
+	}
+
+	poly := samplePolynomial(ec, threshold, zero, rand)
+	v := make(Vs, threshold)
+	for i, ai := range poly[1:] {
+		v[i] = crypto.ScalarBaseMult(ec, ai)
+	}
+	shares := make(Shares, len(ids))
+	for i, id := range ids {
+		shares[i] = &Share{Threshold: threshold, ID: id, Share: evaluatePolynomial(ec, threshold, poly, id)}
+	}
+	return v, shares, nil
+}
+
+// ZeroSharingCommitment returns the commitment to the share of zero at `id`, v1^id * v2^id^2 * ... * vt^id^t,
+// for the commitments made by CreateZeroSharing
+func ZeroSharingCommitment(ec elliptic.Curve, vs Vs, id *big.Int) (*crypto.ECPoint, error) {
+	if len(vs) == 0 || id == nil {
+		return nil, errors.New("zero sharing commitments or id are missing")
+	}
+	modQ := common.ModInt(ec.Params().N)
+	var v *crypto.ECPoint
+	t := one
+	for _, vj := range vs {
+		if vj == nil {
+			return nil, errors.New("zero sharing commitment is nil")
+		}
+		t = modQ.Mul(t, id)
+		vjt := vj.SetCurve(ec).ScalarMult(t)
+		if v == nil {
+			v = vjt
+			continue
+		}
+		var err error
+		if v, err = v.Add(vjt); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+// VerifyZeroSharing checks a share made by CreateZeroSharing against its commitments
+func (share *Share) VerifyZeroSharing(ec elliptic.Curve, threshold int, vs Vs) bool {
+	if share.Threshold != threshold || len(vs) != threshold {
+		return false
+	}
+	v, err := ZeroSharingCommitment(ec, vs, share.ID)
+	if err != nil {
+		return false
+	}
+	return crypto.ScalarBaseMult(ec, share.Share).Equals(v)
+}
+
+// Verify checks a share against the commitments to the coefficients of a polynomial of degree `threshold`
 func (share *Share) Verify(ec elliptic.Curve, threshold int, vs Vs) bool {
-	if share.Threshold != threshold || vs == nil {
+	if share.Threshold != threshold || len(vs) != threshold+1 {
 		return false
 	}
 	var err error
@@ -144,12 +220,12 @@ func (shares Shares) ReConstruct(ec elliptic.Curve) (secret *big.Int, err error)
 	return secret, nil
 }
 
-func samplePolynomial(ec elliptic.Curve, threshold int, secret *big.Int) []*big.Int {
+func samplePolynomial(ec elliptic.Curve, threshold int, secret *big.Int, rand io.Reader) []*big.Int {
 	q := ec.Params().N
 	v := make([]*big.Int, threshold+1)
 	v[0] = secret
 	for i := 1; i <= threshold; i++ {
-		ai := common.GetRandomPositiveInt(q)
+		ai := common.GetRandomPositiveIntWithRand(rand, q)
 		v[i] = ai
 	}
 	return v
@@ -7,6 +7,7 @@
 package vss_test
 
 import (
+	"crypto/rand"
 	"math/big"
 	"testing"
 
@@ -87,6 +88,12 @@ func TestVerify(t *testing.T) {
 	for i := 0; i < num; i++ {
 		assert.True(t, shares[i].Verify(tss.EC(), threshold, vs))
 	}
+
+	// the commitments must be to a polynomial of degree threshold
+	assert.False(t, shares[0].Verify(tss.EC(), threshold, vs[:threshold]))
+	assert.False(t, shares[0].Verify(tss.EC(), threshold, append(vs, vs[0])))
+	shares[0].Threshold = threshold - 1
+	assert.False(t, shares[0].Verify(tss.EC(), threshold-1, vs))
 }
 
 func TestReconstruct(t *testing.T) {
@@ -114,3 +121,27 @@ func TestReconstruct(t *testing.T) {
 	assert.NoError(t, err4)
 	assert.NotZero(t, secret4)
 }
+
+func TestZeroSharing(t *testing.T) {
+	num, threshold := 5, 3
+
+	ids := make([]*big.Int, 0)
+	for i := 0; i < num; i++ {
+		ids = append(ids, common.GetRandomPositiveInt(tss.EC().Params().N))
+	}
+
+	vs, shares, err := CreateZeroSharing(rand.Reader, tss.EC(), threshold, ids)
+	assert.NoError(t, err)
+	assert.Equal(t, threshold, len(vs))
+
+	for i := 0; i < num; i++ {
+		assert.True(t, shares[i].VerifyZeroSharing(tss.EC(), threshold, vs))
+	}
+
+	zero, err := shares[:threshold+1].ReConstruct(tss.EC())
+	assert.NoError(t, err)
+	assert.Zero(t, zero.Sign())
+
+	shares[0].Share = new(big.Int).Add(shares[0].Share, big.NewInt(1))
+	assert.False(t, shares[0].VerifyZeroSharing(tss.EC(), threshold, vs))
+}
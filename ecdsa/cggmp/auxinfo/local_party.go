@@ -0,0 +1,173 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package auxinfo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	cmt "github.com/bnb-chain/tss-lib/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// Implements Party
+// Implements Stringer
+var _ tss.Party = (*LocalParty)(nil)
+var _ fmt.Stringer = (*LocalParty)(nil)
+
+type (
+	LocalParty struct {
+		*tss.BaseParty
+		params *tss.Parameters
+
+		temp localTempData
+		data keygen.LocalPartySaveData
+
+		// outbound messaging
+		out chan<- tss.Message
+		end chan<- keygen.LocalPartySaveData
+	}
+
+	localMessageStore struct {
+		auxRound1Messages,
+		auxRound2Messages,
+		auxRound3Message1s,
+		auxRound3Message2s []tss.ParsedMessage
+	}
+
+	localTempData struct {
+		localMessageStore
+
+		// temp data (thrown away after the protocol)
+		KGCs     []cmt.HashCommitment
+		vs       vss.Vs // the commitments to the zero sharing of this party, without the constant term
+		shares   vss.Shares
+		deCommit cmt.HashDeCommitment
+		skTilde  *paillier.PrivateKey
+		ridi     *big.Int
+		rid      *big.Int
+		Vsj      []vss.Vs // the zero sharing commitments of each party, set in round 3
+	}
+)
+
+// NewLocalParty returns a party for the auxiliary info and key refresh protocol of CGGMP21 (Fig. 6).
+// It re-randomises the shares of `key` without changing the public key, and gives each party a fresh Paillier key
+// and ring-Pedersen parameters. Every holder of `key` must take part. Rounds 1 to 3 are the three rounds of the
+// paper; round 4 is its output step, in which each party checks what it received and sends nothing.
+// Pre-params generated with keygen.GeneratePreParams may be passed in to skip the generation of safe primes.
+func NewLocalParty(
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	out chan<- tss.Message,
+	end chan<- keygen.LocalPartySaveData,
+	optionalPreParams ...keygen.LocalPreParams,
+) tss.Party {
+	partyCount := params.PartyCount()
+	if len(key.Ks) != partyCount {
+		panic(errors.New("auxinfo.NewLocalParty expected every holder of the key to take part"))
+	}
+	data := keygen.BuildLocalSaveDataSubset(key, params.Parties().IDs())
+	data.LocalPreParams = keygen.LocalPreParams{}
+	if 0 < len(optionalPreParams) {
+		if 1 < len(optionalPreParams) {
+			panic(errors.New("auxinfo.NewLocalParty expected 0 or 1 item in `optionalPreParams`"))
+		}
+		if !optionalPreParams[0].ValidateWithProof() {
+			panic(errors.New("auxinfo.NewLocalParty: `optionalPreParams` failed to validate; it might have been generated with an older version of tss-lib"))
+		}
+		data.LocalPreParams = optionalPreParams[0]
+	}
+	p := &LocalParty{
+		BaseParty: tss.NewBaseParty(params),
+		params:    params,
+		temp:      localTempData{},
+		data:      data,
+		out:       out,
+		end:       end,
+	}
+	// msgs init
+	p.temp.auxRound1Messages = make([]tss.ParsedMessage, partyCount)
+	p.temp.auxRound2Messages = make([]tss.ParsedMessage, partyCount)
+	p.temp.auxRound3Message1s = make([]tss.ParsedMessage, partyCount)
+	p.temp.auxRound3Message2s = make([]tss.ParsedMessage, partyCount)
+	// temp data init
+	p.temp.KGCs = make([]cmt.HashCommitment, partyCount)
+	p.temp.Vsj = make([]vss.Vs, partyCount)
+	return p
+}
+
+func (p *LocalParty) FirstRound() tss.Round {
+	return newRound1(p.params, &p.data, &p.temp, p.out, p.end)
+}
+
+func (p *LocalParty) Start() *tss.Error {
+	return tss.BaseStart(p, TaskName)
+}
+
+func (p *LocalParty) StartWithContext(ctx context.Context) *tss.Error {
+	return tss.BaseStartWithContext(ctx, p, TaskName)
+}
+
+func (p *LocalParty) Update(msg tss.ParsedMessage) (ok bool, err *tss.Error) {
+	return tss.BaseUpdate(p, msg, TaskName)
+}
+
+func (p *LocalParty) UpdateFromBytes(wireBytes []byte, from *tss.PartyID, isBroadcast bool) (bool, *tss.Error) {
+	msg, err := p.ParseWireMessage(wireBytes, from, isBroadcast)
+	if err != nil {
+		return false, err
+	}
+	return p.Update(msg)
+}
+
+func (p *LocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
+	if ok, err := p.BaseParty.ValidateMessage(msg); !ok || err != nil {
+		return ok, err
+	}
+	// check that the message's "from index" will fit into the array
+	if maxFromIdx := p.params.PartyCount() - 1; maxFromIdx < msg.GetFrom().Index {
+		return false, p.WrapError(fmt.Errorf("%w: received msg with a sender index too great (%d <= %d)", tss.ErrInvalidMessage,
+			p.params.PartyCount(), msg.GetFrom().Index), msg.GetFrom())
+	}
+	return true, nil
+}
+
+func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
+	// ValidateBasic is cheap; double-check the message here in case the public StoreMessage was called externally
+	if ok, err := p.ValidateMessage(msg); !ok || err != nil {
+		return ok, err
+	}
+
+	// switch/case is necessary to store any messages beyond current round
+	// a sender may deliver each message only once; identical re-deliveries are ignored. spoofing protection is left to the caller.
+	switch msg.Content().(type) {
+	case *AuxRound1Message:
+		return tss.StoreMessageOnce(p, p.temp.auxRound1Messages, msg)
+	case *AuxRound2Message:
+		return tss.StoreMessageOnce(p, p.temp.auxRound2Messages, msg)
+	case *AuxRound3Message1:
+		return tss.StoreMessageOnce(p, p.temp.auxRound3Message1s, msg)
+	case *AuxRound3Message2:
+		return tss.StoreMessageOnce(p, p.temp.auxRound3Message2s, msg)
+	default: // unrecognised message, just ignore!
+		p.params.Logger().Warningf("unrecognised message ignored: %s", msg.Type())
+		return false, nil
+	}
+}
+
+func (p *LocalParty) PartyID() *tss.PartyID {
+	return p.params.PartyID()
+}
+
+func (p *LocalParty) String() string {
+	return fmt.Sprintf("id: %s, %s", p.PartyID(), p.BaseParty.String())
+}
@@ -0,0 +1,133 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package auxinfo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	cggmpkeygen "github.com/bnb-chain/tss-lib/ecdsa/cggmp/keygen"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/test/adversary"
+	"github.com/bnb-chain/tss-lib/test/netsim"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+const (
+	testParticipants = 3
+	testThreshold    = 1
+)
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+}
+
+// runKeygen returns the key shares of a cggmp keygen run, in the order of `pIDs`
+func runKeygen(t *testing.T, pIDs tss.SortedPartyIDs) []keygen.LocalPartySaveData {
+	p2pCtx := tss.NewPeerContext(pIDs)
+	outCh := make(chan tss.Message, len(pIDs)*len(pIDs))
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+
+	net := netsim.New(1)
+	for _, pID := range pIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(pIDs), testThreshold)
+		assert.NoError(t, net.Register(cggmpkeygen.NewLocalParty(params, outCh, endCh)))
+	}
+	_, err := net.Run(context.Background(), outCh, time.Second)
+	assert.NoError(t, err)
+
+	keys := make([]keygen.LocalPartySaveData, len(pIDs))
+	for range pIDs {
+		key := <-endCh
+		index, err := key.OriginalIndex()
+		assert.NoError(t, err)
+		keys[index] = key
+	}
+	return keys
+}
+
+func TestE2E(t *testing.T) {
+	setUp("info")
+
+	fixtures, _, err := keygen.LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	pIDs := tss.GenerateTestPartyIDs(testParticipants)
+	keys := runKeygen(t, pIDs)
+
+	p2pCtx := tss.NewPeerContext(pIDs)
+	outCh := make(chan tss.Message, len(pIDs)*len(pIDs))
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+
+	net := netsim.New(1)
+	for i, pID := range pIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(pIDs), testThreshold)
+		assert.NoError(t, net.Register(NewLocalParty(params, keys[i], outCh, endCh, fixtures[i].LocalPreParams)))
+	}
+	results, err := net.Run(context.Background(), outCh, time.Second)
+	assert.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, netsim.Finished, r.Outcome, r.String())
+	}
+
+	assert.Len(t, endCh, len(pIDs))
+	shares := make(vss.Shares, 0, len(pIDs))
+	for range pIDs {
+		save := <-endCh
+		index, err := save.OriginalIndex()
+		assert.NoError(t, err)
+		assert.True(t, save.ECDSAPub.Equals(keys[0].ECDSAPub), "the public key should not change")
+		assert.NotEqual(t, 0, save.Xi.Cmp(keys[index].Xi), "the share should be refreshed")
+		assert.True(t, save.ValidateWithProof(), "the aux info should be saved")
+		for j := range pIDs {
+			assert.True(t, save.BigXj[j].Equals(crypto.ScalarBaseMult(tss.S256(), save.Xi)) == (j == index), "Xj should match the refreshed shares")
+			assert.Equal(t, fixtures[j].PaillierSK.N, save.PaillierPKs[j].N)
+			assert.Equal(t, fixtures[j].NTildei, save.NTildej[j])
+		}
+		shares = append(shares, &vss.Share{Threshold: testThreshold, ID: save.ShareID, Share: save.Xi})
+	}
+	x, err := shares[:testThreshold+1].ReConstruct(tss.S256())
+	assert.NoError(t, err)
+	assert.True(t, crypto.ScalarBaseMult(tss.S256(), x).Equals(keys[0].ECDSAPub), "the refreshed shares should reconstruct the private key")
+}
+
+func TestE2ECheater(t *testing.T) {
+	setUp("info")
+
+	fixtures, _, err := keygen.LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	pIDs := tss.GenerateTestPartyIDs(testParticipants)
+	keys := runKeygen(t, pIDs)
+
+	p2pCtx := tss.NewPeerContext(pIDs)
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+	newParams := func(i int) *tss.Parameters {
+		return tss.NewParameters(tss.S256(), p2pCtx, pIDs[i], len(pIDs), testThreshold)
+	}
+
+	for _, tc := range []struct {
+		corruption adversary.Corruption
+		cause      error
+	}{
+		{adversary.Corruption{MessageType: adversary.MessageType(&AuxRound2Message{}), Corrupt: adversary.TamperField("de_commitment")}, tss.ErrBadDeCommitment},
+		{adversary.Corruption{MessageType: adversary.MessageType(&AuxRound3Message1{}), Corrupt: adversary.TamperField("share")}, tss.ErrBadShare},
+		{adversary.Corruption{MessageType: adversary.MessageType(&AuxRound3Message2{}), Corrupt: adversary.TamperField("modproof.w")}, &tss.ProofError{Proof: tss.ProofPaillierMod}},
+	} {
+		results, err := adversary.Run(len(pIDs), 0, newParams, func(i int, params *tss.Parameters, out chan<- tss.Message) tss.Party {
+			return NewLocalParty(params, keys[i], out, endCh, fixtures[i].LocalPreParams)
+		}, tc.corruption)
+		assert.NoError(t, err)
+		assert.NoError(t, adversary.CheckBlamed(results, pIDs[0], tc.cause))
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package auxinfo
+
+import (
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	cmt "github.com/bnb-chain/tss-lib/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/crypto/dlnproof"
+	"github.com/bnb-chain/tss-lib/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// These messages were generated from Protocol Buffers definitions into ecdsa-cggmp-auxinfo.pb.go
+// The following messages are registered on the Protocol Buffers "wire"
+
+var (
+	// Ensure that auxinfo messages implement ValidateBasic
+	_ = []tss.MessageContent{
+		(*AuxRound1Message)(nil),
+		(*AuxRound2Message)(nil),
+		(*AuxRound3Message1)(nil),
+		(*AuxRound3Message2)(nil),
+	}
+)
+
+// ----- //
+
+func NewAuxRound1Message(from *tss.PartyID, ct cmt.HashCommitment) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &AuxRound1Message{
+		Commitment: ct.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *AuxRound1Message) ValidateBasic() bool {
+	return m != nil && common.NonEmptyBytes(m.GetCommitment())
+}
+
+func (m *AuxRound1Message) UnmarshalCommitment() *big.Int {
+	return new(big.Int).SetBytes(m.GetCommitment())
+}
+
+// ----- //
+
+func NewAuxRound2Message(from *tss.PartyID, deCommitment cmt.HashDeCommitment) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &AuxRound2Message{
+		DeCommitment: common.BigIntsToBytes(deCommitment),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *AuxRound2Message) ValidateBasic() bool {
+	return m != nil && common.NonEmptyMultiBytes(m.GetDeCommitment())
+}
+
+func (m *AuxRound2Message) UnmarshalDeCommitment() []*big.Int {
+	return cmt.NewHashDeCommitmentFromBytes(m.GetDeCommitment())
+}
+
+// ----- //
+
+func NewAuxRound3Message1(
+	to, from *tss.PartyID,
+	share *vss.Share,
+	proof, proofTilde *paillier.FactorProof,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	content := &AuxRound3Message1{
+		Share:         share.Share.Bytes(),
+		Facproof:      newFactorProof(proof),
+		FacproofTilde: newFactorProof(proofTilde),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *AuxRound3Message1) ValidateBasic() bool {
+	return m != nil &&
+		common.NonEmptyBytes(m.GetShare()) &&
+		m.GetFacproof().ValidateBasic() &&
+		m.GetFacproofTilde().ValidateBasic()
+}
+
+func (m *AuxRound3Message1) UnmarshalShare() *big.Int {
+	return new(big.Int).SetBytes(m.GetShare())
+}
+
+func (m *AuxRound3Message1) UnmarshalFactorProof() *paillier.FactorProof {
+	return m.GetFacproof().unmarshal()
+}
+
+func (m *AuxRound3Message1) UnmarshalFactorProofTilde() *paillier.FactorProof {
+	return m.GetFacproofTilde().unmarshal()
+}
+
+// newFactorProof returns nil for a nil proof, as in the self-message of round 3
+func newFactorProof(proof *paillier.FactorProof) *AuxRound3Message1_FactorProof {
+	if proof == nil {
+		return nil
+	}
+	return &AuxRound3Message1_FactorProof{
+		P:     common.MarshalSigned(proof.P),
+		Q:     common.MarshalSigned(proof.Q),
+		A:     common.MarshalSigned(proof.A),
+		B:     common.MarshalSigned(proof.B),
+		T:     common.MarshalSigned(proof.T),
+		Sigma: common.MarshalSigned(proof.Sigma),
+		Z1:    common.MarshalSigned(proof.Z1),
+		Z2:    common.MarshalSigned(proof.Z2),
+		W1:    common.MarshalSigned(proof.W1),
+		W2:    common.MarshalSigned(proof.W2),
+		V:     common.MarshalSigned(proof.V),
+	}
+}
+
+func (proof *AuxRound3Message1_FactorProof) unmarshal() *paillier.FactorProof {
+	return &paillier.FactorProof{
+		P:     common.UnmarshalSigned(proof.GetP()),
+		Q:     common.UnmarshalSigned(proof.GetQ()),
+		A:     common.UnmarshalSigned(proof.GetA()),
+		B:     common.UnmarshalSigned(proof.GetB()),
+		T:     common.UnmarshalSigned(proof.GetT()),
+		Sigma: common.UnmarshalSigned(proof.GetSigma()),
+		Z1:    common.UnmarshalSigned(proof.GetZ1()),
+		Z2:    common.UnmarshalSigned(proof.GetZ2()),
+		W1:    common.UnmarshalSigned(proof.GetW1()),
+		W2:    common.UnmarshalSigned(proof.GetW2()),
+		V:     common.UnmarshalSigned(proof.GetV()),
+	}
+}
+
+func (proof *AuxRound3Message1_FactorProof) ValidateBasic() bool {
+	return proof != nil &&
+		common.NonEmptyBytes(proof.GetP()) &&
+		common.NonEmptyBytes(proof.GetQ()) &&
+		common.NonEmptyBytes(proof.GetA()) &&
+		common.NonEmptyBytes(proof.GetB()) &&
+		common.NonEmptyBytes(proof.GetT()) &&
+		common.NonEmptyBytes(proof.GetSigma()) &&
+		common.NonEmptyBytes(proof.GetZ1()) &&
+		common.NonEmptyBytes(proof.GetZ2()) &&
+		common.NonEmptyBytes(proof.GetW1()) &&
+		common.NonEmptyBytes(proof.GetW2()) &&
+		common.NonEmptyBytes(proof.GetV())
+}
+
+// ----- //
+
+func NewAuxRound3Message2(
+	from *tss.PartyID,
+	dlnProof1, dlnProof2 *dlnproof.Proof,
+	modProof, modProofTilde *paillier.ModProof,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &AuxRound3Message2{
+		Dlnproof_1:    newDLNProof(dlnProof1),
+		Dlnproof_2:    newDLNProof(dlnProof2),
+		Modproof:      newModProof(modProof),
+		ModproofTilde: newModProof(modProofTilde),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *AuxRound3Message2) ValidateBasic() bool {
+	return m != nil &&
+		m.GetDlnproof_1().ValidateBasic() &&
+		m.GetDlnproof_2().ValidateBasic() &&
+		m.GetModproof().ValidateBasic() &&
+		m.GetModproofTilde().ValidateBasic()
+}
+
+func (m *AuxRound3Message2) UnmarshalDLNProof1() (*dlnproof.Proof, error) {
+	p := m.GetDlnproof_1()
+	return dlnproof.UnmarshalDLNProof(p.GetAlpha(), p.GetT())
+}
+
+func (m *AuxRound3Message2) UnmarshalDLNProof2() (*dlnproof.Proof, error) {
+	p := m.GetDlnproof_2()
+	return dlnproof.UnmarshalDLNProof(p.GetAlpha(), p.GetT())
+}
+
+func (m *AuxRound3Message2) UnmarshalModProof() (*paillier.ModProof, error) {
+	p := m.GetModproof()
+	return paillier.UnmarshalModProof(p.GetW(), p.GetX(), p.GetA(), p.GetB(), p.GetZ())
+}
+
+func (m *AuxRound3Message2) UnmarshalModProofTilde() (*paillier.ModProof, error) {
+	p := m.GetModproofTilde()
+	return paillier.UnmarshalModProof(p.GetW(), p.GetX(), p.GetA(), p.GetB(), p.GetZ())
+}
+
+func newDLNProof(proof *dlnproof.Proof) *AuxRound3Message2_DLNProof {
+	return &AuxRound3Message2_DLNProof{
+		Alpha: common.BigIntsToBytes(proof.Alpha[:]),
+		T:     common.BigIntsToBytes(proof.T[:]),
+	}
+}
+
+func newModProof(proof *paillier.ModProof) *AuxRound3Message2_ModProof {
+	return &AuxRound3Message2_ModProof{
+		W: proof.W.Bytes(),
+		X: common.BigIntsToBytes(proof.X[:]),
+		A: proof.A[:],
+		B: proof.B[:],
+		Z: common.BigIntsToBytes(proof.Z[:]),
+	}
+}
+
+func (p *AuxRound3Message2_DLNProof) ValidateBasic() bool {
+	return p != nil &&
+		common.NonEmptyMultiBytes(p.GetAlpha(), dlnproof.Iterations) &&
+		common.NonEmptyMultiBytes(p.GetT(), dlnproof.Iterations)
+}
+
+func (p *AuxRound3Message2_ModProof) ValidateBasic() bool {
+	return p != nil &&
+		common.NonEmptyBytes(p.GetW()) &&
+		common.NonEmptyMultiBytes(p.GetX(), paillier.PARAM_M) &&
+		common.NonEmptyBools(p.GetA(), paillier.PARAM_M) &&
+		common.NonEmptyBools(p.GetB(), paillier.PARAM_M) &&
+		common.NonEmptyMultiBytes(p.GetZ(), paillier.PARAM_M)
+}
@@ -0,0 +1,119 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package auxinfo
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	cmts "github.com/bnb-chain/tss-lib/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+const (
+	// ridBitLen is the bit length of each party's contribution to the joint randomness rid
+	ridBitLen = 256
+
+	paillierBitsLen = 2048
+)
+
+// round 1 represents round 1 of the auxiliary info part of the CGGMP21 ECDSA TSS spec (Canetti et al.; 2021)
+func newRound1(params *tss.Parameters, save *keygen.LocalPartySaveData, temp *localTempData, out chan<- tss.Message, end chan<- keygen.LocalPartySaveData) tss.Round {
+	return &round1{
+		&base{params, save, temp, out, end, make([]bool, len(params.Parties().IDs())), false, 1}}
+}
+
+func (round *round1) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	round.number = 1
+	round.started = true
+	round.resetOK()
+
+	Pi := round.PartyID()
+	i := Pi.Index
+
+	// 1. use the pre-params if they were provided to the LocalParty constructor, otherwise generate them
+	var preParams *keygen.LocalPreParams
+	if round.save.LocalPreParams.ValidateWithProof() {
+		preParams = &round.save.LocalPreParams
+	} else {
+		var err error
+		ctx, cancel := context.WithTimeout(round.Context(), round.SafePrimeGenTimeout())
+		defer cancel()
+		preParams, err = keygen.GeneratePreParamsWithRand(ctx, round.Rand(), round.Params().Logger(), round.Concurrency())
+		if err != nil {
+			return round.WrapError(fmt.Errorf("%w: pre-params generation failed", tss.ErrInternal), Pi)
+		}
+	}
+	round.save.LocalPreParams = *preParams
+	round.save.PaillierSK = preParams.PaillierSK
+	round.save.PaillierPKs[i] = &preParams.PaillierSK.PublicKey
+	round.save.NTildej[i] = preParams.NTildei
+	round.save.H1j[i], round.save.H2j[i] = preParams.H1i, preParams.H2i
+
+	round.temp.skTilde = preParams.NTildeKey()
+
+	// 2. share zero to refresh the key shares
+	vs, shares, err := vss.CreateZeroSharing(round.Rand(), round.Params().EC(), round.Threshold(), round.save.Ks)
+	if err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err), Pi)
+	}
+
+	// 3. sample this party's contribution to rid and commit to it along with the aux info and the zero sharing
+	round.temp.ridi = common.MustGetRandomIntWithRand(round.Rand(), ridBitLen)
+	pGFlat, err := crypto.FlattenECPoints(vs)
+	if err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err), Pi)
+	}
+	secrets := []*big.Int{preParams.PaillierSK.N, preParams.NTildei, preParams.H1i, preParams.H2i}
+	secrets = append(append(secrets, pGFlat...), round.temp.ridi)
+	cmt := cmts.NewHashCommitmentWithRand(round.Rand(), secrets...)
+
+	round.temp.vs = vs
+	round.temp.shares = shares
+	round.temp.deCommit = cmt.D
+
+	// BROADCAST commitment
+	r1msg := NewAuxRound1Message(Pi, cmt.C)
+	round.temp.auxRound1Messages[i] = r1msg
+	if err := tss.SendMessage(round.Params(), round.out, r1msg); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
+	return nil
+}
+
+func (round *round1) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*AuxRound1Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round1) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.auxRound1Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round1) NextRound() tss.Round {
+	round.started = false
+	return &round2{round}
+}
@@ -0,0 +1,62 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package auxinfo
+
+import (
+	"fmt"
+
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func (round *round2) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	round.number = 2
+	round.started = true
+	round.resetOK()
+
+	i := round.PartyID().Index
+
+	// store the commitments of round 1
+	for j, msg := range round.temp.auxRound1Messages {
+		round.temp.KGCs[j] = msg.Content().(*AuxRound1Message).UnmarshalCommitment()
+	}
+
+	// BROADCAST de-commitment of the aux info, the zero sharing and ridi
+	r2msg := NewAuxRound2Message(round.PartyID(), round.temp.deCommit)
+	round.temp.auxRound2Messages[i] = r2msg
+	if err := tss.SendMessage(round.Params(), round.out, r2msg); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
+	return nil
+}
+
+func (round *round2) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*AuxRound2Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round2) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.auxRound2Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round2) NextRound() tss.Round {
+	round.started = false
+	return &round3{round}
+}
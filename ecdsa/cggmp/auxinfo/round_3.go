@@ -0,0 +1,152 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package auxinfo
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func (round *round3) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	round.number = 3
+	round.started = true
+	round.resetOK()
+
+	ec := round.Params().EC()
+	Ps := round.Parties().IDs()
+	PIdx := round.PartyID().Index
+
+	// 1. verify the de-commitments of the other parties and save their aux info
+	round.temp.Vsj[PIdx] = round.temp.vs
+	rid := new(big.Int).Set(round.temp.ridi)
+	{
+		var multiErr error
+		culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+		h1H2Map := make(map[string]struct{}, len(Ps)*2)
+		for j, Pj := range Ps {
+			if j == PIdx {
+				continue
+			}
+			r2msg := round.temp.auxRound2Messages[j].Content().(*AuxRound2Message)
+			cmtDeCmt := commitments.HashCommitDecommit{C: round.temp.KGCs[j], D: r2msg.UnmarshalDeCommitment()}
+			ok, flat := cmtDeCmt.DeCommit()
+			// the de-commitment holds N, NTilde, h1, h2, the flattened zero sharing commitments and ridj
+			if !ok || len(flat) != 4+2*round.Threshold()+1 {
+				multiErr = multierror.Append(multiErr, tss.ErrBadDeCommitment)
+				culprits = append(culprits, Pj)
+				continue
+			}
+			Nj, NTildej, H1j, H2j := flat[0], flat[1], flat[2], flat[3]
+			if Nj.BitLen() != paillierBitsLen || NTildej.BitLen() != paillierBitsLen {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("%w: got a modulus with insufficient bits", tss.ErrInvalidMessage))
+				culprits = append(culprits, Pj)
+				continue
+			}
+			h1JHex, h2JHex := hex.EncodeToString(H1j.Bytes()), hex.EncodeToString(H2j.Bytes())
+			_, h1Found := h1H2Map[h1JHex]
+			_, h2Found := h1H2Map[h2JHex]
+			if H1j.Cmp(H2j) == 0 || h1Found || h2Found {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("%w: h1j and h2j were equal or already used by another party", tss.ErrInvalidMessage))
+				culprits = append(culprits, Pj)
+				continue
+			}
+			h1H2Map[h1JHex], h1H2Map[h2JHex] = struct{}{}, struct{}{}
+			PjVs, err := crypto.UnFlattenECPoints(ec, flat[4:len(flat)-1])
+			if err != nil {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("%w: %v", tss.ErrPointNotOnCurve, err))
+				culprits = append(culprits, Pj)
+				continue
+			}
+			round.save.PaillierPKs[j] = &paillier.PublicKey{N: Nj}
+			round.save.NTildej[j] = NTildej
+			round.save.H1j[j], round.save.H2j[j] = H1j, H2j
+			round.temp.Vsj[j] = PjVs
+			rid.Xor(rid, flat[len(flat)-1])
+		}
+		if len(culprits) > 0 {
+			return round.WrapError(multiErr, culprits...)
+		}
+	}
+	round.temp.rid = rid
+
+	// 2. BROADCAST the proofs that NTildei is well formed and that Ni and NTildei are Paillier-Blum moduli, bound to rid
+	preParams := round.save.LocalPreParams
+	proofs := preParams.Prove(round.Rand(), round.session(), round.Params().Metrics())
+
+	r3msg2 := NewAuxRound3Message2(round.PartyID(), proofs.DLNProof1, proofs.DLNProof2, proofs.ModProof, proofs.ModProofTilde)
+	round.temp.auxRound3Message2s[PIdx] = r3msg2
+	if err := tss.SendMessage(round.Params(), round.out, r3msg2); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
+
+	// 3. p2p send the zero share ij to Pj, with the proofs that Ni and NTildei have no small factors
+	for j, Pj := range Ps {
+		if j == PIdx {
+			// do not send to this Pj, but store for round 4
+			round.temp.auxRound3Message1s[j] = NewAuxRound3Message1(Pj, round.PartyID(), round.temp.shares[j], nil, nil)
+			continue
+		}
+		NTildej, H1j, H2j := round.save.NTildej[j], round.save.H1j[j], round.save.H2j[j]
+		start := time.Now()
+		facProof := preParams.PaillierSK.FactorProofWithRand(round.Rand(), round.session(), NTildej, H1j, H2j)
+		round.Params().Metrics().RecordProof(tss.ProofPaillierFactor, tss.ProofGeneration, start)
+		start = time.Now()
+		facProofTilde := round.temp.skTilde.FactorProofWithRand(round.Rand(), round.session(), NTildej, H1j, H2j)
+		round.Params().Metrics().RecordProof(tss.ProofPaillierFactor, tss.ProofGeneration, start)
+
+		r3msg1 := NewAuxRound3Message1(Pj, round.PartyID(), round.temp.shares[j], facProof, facProofTilde)
+		if err := tss.SendMessage(round.Params(), round.out, r3msg1); err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+		}
+	}
+	return nil
+}
+
+func (round *round3) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*AuxRound3Message1); ok {
+		return !msg.IsBroadcast()
+	}
+	if _, ok := msg.Content().(*AuxRound3Message2); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round3) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.auxRound3Message1s {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		msg2 := round.temp.auxRound3Message2s[j]
+		if msg2 == nil || !round.CanAccept(msg2) {
+			return false, nil
+		}
+		// proof checks are in round 4
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round3) NextRound() tss.Round {
+	round.started = false
+	return &round4{round}
+}
@@ -0,0 +1,152 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package auxinfo
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func (round *round4) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	round.number = 4
+	round.started = true
+	round.resetOK()
+
+	ec := round.Params().EC()
+	Ps := round.Parties().IDs()
+	PIdx := round.PartyID().Index
+
+	// 1. verify the proofs about the aux info and the zero shares of the other parties
+	xi := new(big.Int).Set(round.save.Xi)
+	{
+		var multiErr error
+		culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+		for j, Pj := range Ps {
+			round.ok[j] = true
+			r3msg1 := round.temp.auxRound3Message1s[j].Content().(*AuxRound3Message1)
+			if j == PIdx {
+				xi.Add(xi, r3msg1.UnmarshalShare())
+				continue
+			}
+			if err := round.verifyAuxInfo(j); err != nil {
+				multiErr = multierror.Append(multiErr, err)
+				culprits = append(culprits, Pj)
+				continue
+			}
+			PjShare := vss.Share{
+				Threshold: round.Threshold(),
+				ID:        round.PartyID().KeyInt(),
+				Share:     r3msg1.UnmarshalShare(),
+			}
+			if !PjShare.VerifyZeroSharing(ec, round.Threshold(), round.temp.Vsj[j]) {
+				multiErr = multierror.Append(multiErr, tss.ErrBadShare)
+				culprits = append(culprits, Pj)
+				continue
+			}
+			xi.Add(xi, PjShare.Share)
+		}
+		if len(culprits) > 0 {
+			return round.WrapError(multiErr, culprits...)
+		}
+	}
+
+	// 2. SAVE the refreshed share and the refreshed Xj of each Pj
+	round.save.Xi = xi.Mod(xi, ec.Params().N)
+	for m, Pm := range Ps {
+		BigXm := round.save.BigXj[m]
+		for j := range Ps {
+			Vm, err := vss.ZeroSharingCommitment(ec, round.temp.Vsj[j], Pm.KeyInt())
+			if err == nil {
+				BigXm, err = BigXm.Add(Vm)
+			}
+			if err != nil {
+				return round.WrapError(fmt.Errorf("%w: refreshing BigXj: %v", tss.ErrPointNotOnCurve, err))
+			}
+		}
+		round.save.BigXj[m] = BigXm
+	}
+
+	round.end <- *round.save
+
+	return nil
+}
+
+// verifyAuxInfo checks the proofs that Pj sent in round 3
+func (round *round4) verifyAuxInfo(j int) error {
+	session := round.session()
+	NTildej, H1j, H2j, Nj := round.save.NTildej[j], round.save.H1j[j], round.save.H2j[j], round.save.PaillierPKs[j].N
+	r3msg1 := round.temp.auxRound3Message1s[j].Content().(*AuxRound3Message1)
+	r3msg2 := round.temp.auxRound3Message2s[j].Content().(*AuxRound3Message2)
+
+	dlnProof1, err := r3msg2.UnmarshalDLNProof1()
+	if err != nil {
+		return tss.NewProofError(tss.ProofDLN, err)
+	}
+	dlnProof2, err := r3msg2.UnmarshalDLNProof2()
+	if err != nil {
+		return tss.NewProofError(tss.ProofDLN, err)
+	}
+	start := time.Now()
+	ok := dlnProof1.Verify(session, H1j, H2j, NTildej) && dlnProof2.Verify(session, H2j, H1j, NTildej)
+	round.Params().Metrics().RecordProof(tss.ProofDLN, tss.ProofVerification, start)
+	if !ok {
+		return tss.NewProofError(tss.ProofDLN, nil)
+	}
+
+	modProof, err := r3msg2.UnmarshalModProof()
+	if err != nil {
+		return tss.NewProofError(tss.ProofPaillierMod, err)
+	}
+	modProofTilde, err := r3msg2.UnmarshalModProofTilde()
+	if err != nil {
+		return tss.NewProofError(tss.ProofPaillierMod, err)
+	}
+	start = time.Now()
+	ok, err = modProof.ModVerify(session, Nj)
+	if err == nil && ok {
+		ok, err = modProofTilde.ModVerify(session, NTildej)
+	}
+	round.Params().Metrics().RecordProof(tss.ProofPaillierMod, tss.ProofVerification, start)
+	if err != nil || !ok {
+		return tss.NewProofError(tss.ProofPaillierMod, err)
+	}
+
+	NTildei, H1i, H2i := round.save.LocalPreParams.NTildei, round.save.LocalPreParams.H1i, round.save.LocalPreParams.H2i
+	start = time.Now()
+	ok, err = r3msg1.UnmarshalFactorProof().FactorVerify(session, Nj, NTildei, H1i, H2i)
+	if err == nil && ok {
+		ok, err = r3msg1.UnmarshalFactorProofTilde().FactorVerify(session, NTildej, NTildei, H1i, H2i)
+	}
+	round.Params().Metrics().RecordProof(tss.ProofPaillierFactor, tss.ProofVerification, start)
+	if err != nil || !ok {
+		return tss.NewProofError(tss.ProofPaillierFactor, err)
+	}
+	return nil
+}
+
+func (round *round4) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *round4) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *round4) NextRound() tss.Round {
+	return nil // finished!
+}
@@ -0,0 +1,103 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/test/adversary"
+	"github.com/bnb-chain/tss-lib/test/netsim"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+const (
+	testParticipants = 5
+	testThreshold    = 2
+)
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+}
+
+func TestE2E(t *testing.T) {
+	setUp("info")
+
+	pIDs := tss.GenerateTestPartyIDs(testParticipants)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	outCh := make(chan tss.Message, len(pIDs)*len(pIDs))
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+
+	net := netsim.New(1)
+	for _, pID := range pIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(pIDs), testThreshold)
+		assert.NoError(t, net.Register(NewLocalParty(params, outCh, endCh)))
+	}
+	results, err := net.Run(context.Background(), outCh, time.Second)
+	assert.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, netsim.Finished, r.Outcome, r.String())
+	}
+
+	assert.Len(t, endCh, len(pIDs))
+	saves := make([]keygen.LocalPartySaveData, 0, len(pIDs))
+	shares := make(vss.Shares, 0, len(pIDs))
+	for range pIDs {
+		save := <-endCh
+		saves = append(saves, save)
+		shares = append(shares, &vss.Share{Threshold: testThreshold, ID: save.ShareID, Share: save.Xi})
+	}
+	for _, save := range saves {
+		assert.True(t, save.ECDSAPub.Equals(saves[0].ECDSAPub), "all parties should derive the same public key")
+		index, err := save.OriginalIndex()
+		assert.NoError(t, err)
+		assert.True(t, save.BigXj[index].Equals(crypto.ScalarBaseMult(tss.S256(), save.Xi)), "Xj should match the share of the party")
+		assert.False(t, save.LocalPreParams.Validate(), "keygen should not make aux info")
+	}
+
+	// any t+1 shares reconstruct the private key
+	for _, subset := range []vss.Shares{shares[:testThreshold+1], shares[len(shares)-testThreshold-1:]} {
+		x, err := subset.ReConstruct(tss.S256())
+		assert.NoError(t, err)
+		assert.True(t, crypto.ScalarBaseMult(tss.S256(), x).Equals(saves[0].ECDSAPub), "the shares should reconstruct the private key")
+	}
+}
+
+func TestE2ECheater(t *testing.T) {
+	setUp("info")
+
+	pIDs := tss.GenerateTestPartyIDs(testParticipants)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+	newParams := func(i int) *tss.Parameters {
+		return tss.NewParameters(tss.S256(), p2pCtx, pIDs[i], len(pIDs), testThreshold)
+	}
+
+	for _, tc := range []struct {
+		corruption adversary.Corruption
+		cause      error
+	}{
+		{adversary.Corruption{MessageType: adversary.MessageType(&KGRound2Message1{}), Corrupt: adversary.TamperField("share")}, tss.ErrBadShare},
+		{adversary.Corruption{MessageType: adversary.MessageType(&KGRound2Message2{}), Corrupt: adversary.TamperField("de_commitment")}, tss.ErrBadDeCommitment},
+		{adversary.Corruption{MessageType: adversary.MessageType(&KGRound3Message{}), Corrupt: adversary.TamperField("proof_t")}, &tss.ProofError{Proof: tss.ProofSchnorr}},
+	} {
+		results, err := adversary.Run(len(pIDs), 0, newParams, func(i int, params *tss.Parameters, out chan<- tss.Message) tss.Party {
+			return NewLocalParty(params, out, endCh)
+		}, tc.corruption)
+		assert.NoError(t, err)
+		assert.NoError(t, adversary.CheckBlamed(results, pIDs[0], tc.cause))
+	}
+}
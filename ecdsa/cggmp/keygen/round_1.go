@@ -0,0 +1,105 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	cmts "github.com/bnb-chain/tss-lib/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+const (
+	// ridBitLen is the bit length of each party's contribution to the joint randomness rid
+	ridBitLen = 256
+)
+
+var (
+	zero = big.NewInt(0)
+)
+
+// round 1 represents round 1 of the keygen part of the CGGMP21 ECDSA TSS spec (Canetti et al.; 2021)
+func newRound1(params *tss.Parameters, save *keygen.LocalPartySaveData, temp *localTempData, out chan<- tss.Message, end chan<- keygen.LocalPartySaveData) tss.Round {
+	return &round1{
+		&base{params, save, temp, out, end, make([]bool, len(params.Parties().IDs())), false, 1}}
+}
+
+func (round *round1) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	round.number = 1
+	round.started = true
+	round.resetOK()
+
+	Pi := round.PartyID()
+	i := Pi.Index
+
+	// 1. calculate "partial" key share ui and its vss shares
+	ui := common.GetRandomPositiveIntWithRand(round.Rand(), round.Params().EC().Params().N)
+	ids := round.Parties().IDs().Keys()
+	vs, shares, err := vss.CreateWithRand(round.Rand(), round.Params().EC(), round.Threshold(), ui, ids)
+	if err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err), Pi)
+	}
+	round.save.Ks = ids
+	round.save.ShareID = ids[i]
+
+	// security: the original u_i may be discarded
+	ui = zero // clears the secret data from memory
+	_ = ui    // silences a linter warning
+
+	// 2. sample this party's contribution to rid and commit to it along with the vss polynomial
+	round.temp.ridi = common.MustGetRandomIntWithRand(round.Rand(), ridBitLen)
+	pGFlat, err := crypto.FlattenECPoints(vs)
+	if err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err), Pi)
+	}
+	cmt := cmts.NewHashCommitmentWithRand(round.Rand(), append(pGFlat, round.temp.ridi)...)
+
+	round.temp.vs = vs
+	round.temp.shares = shares
+	round.temp.deCommit = cmt.D
+
+	// BROADCAST commitment
+	r1msg := NewKGRound1Message(Pi, cmt.C)
+	round.temp.kgRound1Messages[i] = r1msg
+	if err := tss.SendMessage(round.Params(), round.out, r1msg); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
+	return nil
+}
+
+func (round *round1) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*KGRound1Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round1) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.kgRound1Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round1) NextRound() tss.Round {
+	round.started = false
+	return &round2{round}
+}
@@ -0,0 +1,82 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"fmt"
+
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func (round *round2) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	round.number = 2
+	round.started = true
+	round.resetOK()
+
+	i := round.PartyID().Index
+
+	// store the commitments of round 1
+	for j, msg := range round.temp.kgRound1Messages {
+		round.temp.KGCs[j] = msg.Content().(*KGRound1Message).UnmarshalCommitment()
+	}
+
+	// 1. p2p send share ij to Pj
+	for j, Pj := range round.Parties().IDs() {
+		r2msg1 := NewKGRound2Message1(Pj, round.PartyID(), round.temp.shares[j])
+		// do not send to this Pj, but store for round 3
+		if j == i {
+			round.temp.kgRound2Message1s[j] = r2msg1
+			continue
+		}
+		if err := tss.SendMessage(round.Params(), round.out, r2msg1); err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+		}
+	}
+
+	// 2. BROADCAST de-commitment of the Shamir poly*G and of ridi
+	r2msg2 := NewKGRound2Message2(round.PartyID(), round.temp.deCommit)
+	round.temp.kgRound2Message2s[i] = r2msg2
+	if err := tss.SendMessage(round.Params(), round.out, r2msg2); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
+	return nil
+}
+
+func (round *round2) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*KGRound2Message1); ok {
+		return !msg.IsBroadcast()
+	}
+	if _, ok := msg.Content().(*KGRound2Message2); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round2) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.kgRound2Message1s {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		msg2 := round.temp.kgRound2Message2s[j]
+		if msg2 == nil || !round.CanAccept(msg2) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round2) NextRound() tss.Round {
+	round.started = false
+	return &round3{round}
+}
@@ -0,0 +1,153 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/crypto/schnorr"
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func (round *round3) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	round.number = 3
+	round.started = true
+	round.resetOK()
+
+	ec := round.Params().EC()
+	Ps := round.Parties().IDs()
+	PIdx := round.PartyID().Index
+
+	// 1. verify the de-commitments and the shares of the other parties
+	Vc := make(vss.Vs, round.Threshold()+1)
+	copy(Vc, round.temp.vs) // ours
+	rid := new(big.Int).Set(round.temp.ridi)
+	xi := new(big.Int).Set(round.temp.shares[PIdx].Share)
+	{
+		var multiErr error
+		culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+		for j, Pj := range Ps {
+			if j == PIdx {
+				continue
+			}
+			r2msg1 := round.temp.kgRound2Message1s[j].Content().(*KGRound2Message1)
+			r2msg2 := round.temp.kgRound2Message2s[j].Content().(*KGRound2Message2)
+			cmtDeCmt := commitments.HashCommitDecommit{C: round.temp.KGCs[j], D: r2msg2.UnmarshalDeCommitment()}
+			ok, flat := cmtDeCmt.DeCommit()
+			// the de-commitment holds the flattened poly*G followed by ridj
+			if !ok || len(flat) != 2*(round.Threshold()+1)+1 {
+				multiErr = multierror.Append(multiErr, tss.ErrBadDeCommitment)
+				culprits = append(culprits, Pj)
+				continue
+			}
+			PjVs, err := crypto.UnFlattenECPoints(ec, flat[:len(flat)-1])
+			if err != nil {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("%w: %v", tss.ErrPointNotOnCurve, err))
+				culprits = append(culprits, Pj)
+				continue
+			}
+			PjShare := vss.Share{
+				Threshold: round.Threshold(),
+				ID:        round.PartyID().KeyInt(),
+				Share:     r2msg1.UnmarshalShare(),
+			}
+			if !PjShare.Verify(ec, round.Threshold(), PjVs) {
+				multiErr = multierror.Append(multiErr, tss.ErrBadShare)
+				culprits = append(culprits, Pj)
+				continue
+			}
+			for c := range Vc {
+				if Vc[c], err = Vc[c].Add(PjVs[c]); err != nil {
+					multiErr = multierror.Append(multiErr, fmt.Errorf("%w: adding PjVs[c] to Vc[c]", tss.ErrPointNotOnCurve))
+					culprits = append(culprits, Pj)
+					break
+				}
+			}
+			rid.Xor(rid, flat[len(flat)-1])
+			xi.Add(xi, PjShare.Share)
+		}
+		if len(culprits) > 0 {
+			return round.WrapError(multiErr, culprits...)
+		}
+	}
+	round.save.Xi = new(big.Int).Mod(xi, ec.Params().N)
+	round.temp.rid = rid
+
+	// 2. compute Xj for each Pj
+	modQ := common.ModInt(ec.Params().N)
+	for j, Pj := range Ps {
+		kj := Pj.KeyInt()
+		BigXj := Vc[0]
+		z := big.NewInt(1)
+		for c := 1; c <= round.Threshold(); c++ {
+			var err error
+			z = modQ.Mul(z, kj)
+			if BigXj, err = BigXj.Add(Vc[c].ScalarMult(z)); err != nil {
+				return round.WrapError(fmt.Errorf("%w: adding Vc[c].ScalarMult(z) to BigXj", tss.ErrPointNotOnCurve))
+			}
+		}
+		round.save.BigXj[j] = BigXj
+	}
+
+	// 3. compute and SAVE the ECDSA public key `y`
+	ecdsaPubKey, err := crypto.NewECPoint(ec, Vc[0].X(), Vc[0].Y())
+	if err != nil {
+		return round.WrapError(fmt.Errorf("%w: public key: %v", tss.ErrPointNotOnCurve, err))
+	}
+	round.save.ECDSAPub = ecdsaPubKey
+
+	// 4. BROADCAST the proof of knowledge of xi, bound to rid
+	start := time.Now()
+	proof, err := schnorr.NewZKProofWithRand(round.Rand(), round.session(), round.save.Xi, round.save.BigXj[PIdx])
+	round.Params().Metrics().RecordProof(tss.ProofSchnorr, tss.ProofGeneration, start)
+	if err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
+	r3msg := NewKGRound3Message(round.PartyID(), proof)
+	round.temp.kgRound3Messages[PIdx] = r3msg
+	if err := tss.SendMessage(round.Params(), round.out, r3msg); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
+	return nil
+}
+
+func (round *round3) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*KGRound3Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round3) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.kgRound3Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		// proof check is in round 4
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round3) NextRound() tss.Round {
+	round.started = false
+	return &round4{round}
+}
@@ -0,0 +1,67 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func (round *round4) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	round.number = 4
+	round.started = true
+	round.resetOK()
+
+	Ps := round.Parties().IDs()
+	PIdx := round.PartyID().Index
+
+	// 1. verify the proofs of knowledge of each xj
+	culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+	for j, msg := range round.temp.kgRound3Messages {
+		round.ok[j] = true
+		if j == PIdx {
+			continue
+		}
+		proof, err := msg.Content().(*KGRound3Message).UnmarshalZKProof(round.Params().EC())
+		if err != nil {
+			culprits = append(culprits, Ps[j])
+			continue
+		}
+		start := time.Now()
+		ok := proof.Verify(round.session(), round.save.BigXj[j])
+		round.Params().Metrics().RecordProof(tss.ProofSchnorr, tss.ProofVerification, start)
+		if !ok {
+			culprits = append(culprits, Ps[j])
+		}
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(tss.NewProofError(tss.ProofSchnorr, nil), culprits...)
+	}
+
+	round.end <- *round.save
+
+	return nil
+}
+
+func (round *round4) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *round4) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *round4) NextRound() tss.Round {
+	return nil // finished!
+}
@@ -0,0 +1,707 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.12
+// source: protob/ecdsa-cggmp-presigning.proto
+
+package presigning
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Represents a BROADCAST message sent during Round 1 of the CGGMP21 ECDSA presigning protocol.
+type PreRound1Message1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	K []byte `protobuf:"bytes,1,opt,name=k,proto3" json:"k,omitempty"`
+	G []byte `protobuf:"bytes,2,opt,name=g,proto3" json:"g,omitempty"`
+}
+
+func (x *PreRound1Message1) Reset() {
+	*x = PreRound1Message1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_ecdsa_cggmp_presigning_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreRound1Message1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreRound1Message1) ProtoMessage() {}
+
+func (x *PreRound1Message1) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_ecdsa_cggmp_presigning_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreRound1Message1.ProtoReflect.Descriptor instead.
+func (*PreRound1Message1) Descriptor() ([]byte, []int) {
+	return file_protob_ecdsa_cggmp_presigning_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PreRound1Message1) GetK() []byte {
+	if x != nil {
+		return x.K
+	}
+	return nil
+}
+
+func (x *PreRound1Message1) GetG() []byte {
+	if x != nil {
+		return x.G
+	}
+	return nil
+}
+
+// Represents a P2P message sent to each party during Round 1 of the CGGMP21 ECDSA presigning protocol.
+type PreRound1Message2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EncProof [][]byte `protobuf:"bytes,1,rep,name=enc_proof,json=encProof,proto3" json:"enc_proof,omitempty"`
+}
+
+func (x *PreRound1Message2) Reset() {
+	*x = PreRound1Message2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_ecdsa_cggmp_presigning_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreRound1Message2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreRound1Message2) ProtoMessage() {}
+
+func (x *PreRound1Message2) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_ecdsa_cggmp_presigning_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreRound1Message2.ProtoReflect.Descriptor instead.
+func (*PreRound1Message2) Descriptor() ([]byte, []int) {
+	return file_protob_ecdsa_cggmp_presigning_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PreRound1Message2) GetEncProof() [][]byte {
+	if x != nil {
+		return x.EncProof
+	}
+	return nil
+}
+
+// Represents a BROADCAST message sent during Round 2 of the CGGMP21 ECDSA presigning protocol.
+// The ciphertexts are indexed by their recipient; the entry of the sender is empty.
+type PreRound2Message1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GammaX []byte   `protobuf:"bytes,1,opt,name=gamma_x,json=gammaX,proto3" json:"gamma_x,omitempty"`
+	GammaY []byte   `protobuf:"bytes,2,opt,name=gamma_y,json=gammaY,proto3" json:"gamma_y,omitempty"`
+	D      [][]byte `protobuf:"bytes,3,rep,name=d,proto3" json:"d,omitempty"`
+	F      [][]byte `protobuf:"bytes,4,rep,name=f,proto3" json:"f,omitempty"`
+	DHat   [][]byte `protobuf:"bytes,5,rep,name=d_hat,json=dHat,proto3" json:"d_hat,omitempty"`
+	FHat   [][]byte `protobuf:"bytes,6,rep,name=f_hat,json=fHat,proto3" json:"f_hat,omitempty"`
+}
+
+func (x *PreRound2Message1) Reset() {
+	*x = PreRound2Message1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_ecdsa_cggmp_presigning_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreRound2Message1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreRound2Message1) ProtoMessage() {}
+
+func (x *PreRound2Message1) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_ecdsa_cggmp_presigning_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreRound2Message1.ProtoReflect.Descriptor instead.
+func (*PreRound2Message1) Descriptor() ([]byte, []int) {
+	return file_protob_ecdsa_cggmp_presigning_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PreRound2Message1) GetGammaX() []byte {
+	if x != nil {
+		return x.GammaX
+	}
+	return nil
+}
+
+func (x *PreRound2Message1) GetGammaY() []byte {
+	if x != nil {
+		return x.GammaY
+	}
+	return nil
+}
+
+func (x *PreRound2Message1) GetD() [][]byte {
+	if x != nil {
+		return x.D
+	}
+	return nil
+}
+
+func (x *PreRound2Message1) GetF() [][]byte {
+	if x != nil {
+		return x.F
+	}
+	return nil
+}
+
+func (x *PreRound2Message1) GetDHat() [][]byte {
+	if x != nil {
+		return x.DHat
+	}
+	return nil
+}
+
+func (x *PreRound2Message1) GetFHat() [][]byte {
+	if x != nil {
+		return x.FHat
+	}
+	return nil
+}
+
+// Represents a P2P message sent to each party during Round 2 of the CGGMP21 ECDSA presigning protocol.
+type PreRound2Message2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AffgProof    [][]byte `protobuf:"bytes,1,rep,name=affg_proof,json=affgProof,proto3" json:"affg_proof,omitempty"`
+	AffgHatProof [][]byte `protobuf:"bytes,2,rep,name=affg_hat_proof,json=affgHatProof,proto3" json:"affg_hat_proof,omitempty"`
+	LogstarProof [][]byte `protobuf:"bytes,3,rep,name=logstar_proof,json=logstarProof,proto3" json:"logstar_proof,omitempty"`
+}
+
+func (x *PreRound2Message2) Reset() {
+	*x = PreRound2Message2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_ecdsa_cggmp_presigning_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreRound2Message2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreRound2Message2) ProtoMessage() {}
+
+func (x *PreRound2Message2) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_ecdsa_cggmp_presigning_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreRound2Message2.ProtoReflect.Descriptor instead.
+func (*PreRound2Message2) Descriptor() ([]byte, []int) {
+	return file_protob_ecdsa_cggmp_presigning_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PreRound2Message2) GetAffgProof() [][]byte {
+	if x != nil {
+		return x.AffgProof
+	}
+	return nil
+}
+
+func (x *PreRound2Message2) GetAffgHatProof() [][]byte {
+	if x != nil {
+		return x.AffgHatProof
+	}
+	return nil
+}
+
+func (x *PreRound2Message2) GetLogstarProof() [][]byte {
+	if x != nil {
+		return x.LogstarProof
+	}
+	return nil
+}
+
+// Represents a BROADCAST message sent during Round 3 of the CGGMP21 ECDSA presigning protocol.
+type PreRound3Message1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Delta     []byte `protobuf:"bytes,1,opt,name=delta,proto3" json:"delta,omitempty"`
+	BigDeltaX []byte `protobuf:"bytes,2,opt,name=big_delta_x,json=bigDeltaX,proto3" json:"big_delta_x,omitempty"`
+	BigDeltaY []byte `protobuf:"bytes,3,opt,name=big_delta_y,json=bigDeltaY,proto3" json:"big_delta_y,omitempty"`
+}
+
+func (x *PreRound3Message1) Reset() {
+	*x = PreRound3Message1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_ecdsa_cggmp_presigning_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreRound3Message1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreRound3Message1) ProtoMessage() {}
+
+func (x *PreRound3Message1) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_ecdsa_cggmp_presigning_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreRound3Message1.ProtoReflect.Descriptor instead.
+func (*PreRound3Message1) Descriptor() ([]byte, []int) {
+	return file_protob_ecdsa_cggmp_presigning_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PreRound3Message1) GetDelta() []byte {
+	if x != nil {
+		return x.Delta
+	}
+	return nil
+}
+
+func (x *PreRound3Message1) GetBigDeltaX() []byte {
+	if x != nil {
+		return x.BigDeltaX
+	}
+	return nil
+}
+
+func (x *PreRound3Message1) GetBigDeltaY() []byte {
+	if x != nil {
+		return x.BigDeltaY
+	}
+	return nil
+}
+
+// Represents a P2P message sent to each party during Round 3 of the CGGMP21 ECDSA presigning protocol.
+type PreRound3Message2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LogstarProof [][]byte `protobuf:"bytes,1,rep,name=logstar_proof,json=logstarProof,proto3" json:"logstar_proof,omitempty"`
+}
+
+func (x *PreRound3Message2) Reset() {
+	*x = PreRound3Message2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_ecdsa_cggmp_presigning_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreRound3Message2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreRound3Message2) ProtoMessage() {}
+
+func (x *PreRound3Message2) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_ecdsa_cggmp_presigning_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreRound3Message2.ProtoReflect.Descriptor instead.
+func (*PreRound3Message2) Descriptor() ([]byte, []int) {
+	return file_protob_ecdsa_cggmp_presigning_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PreRound3Message2) GetLogstarProof() [][]byte {
+	if x != nil {
+		return x.LogstarProof
+	}
+	return nil
+}
+
+// Represents a BROADCAST message sent during Round 4 of the CGGMP21 ECDSA presigning protocol, should delta fail to check out.
+type PreRound4Message1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	H        []byte   `protobuf:"bytes,1,opt,name=h,proto3" json:"h,omitempty"`
+	MulProof [][]byte `protobuf:"bytes,2,rep,name=mul_proof,json=mulProof,proto3" json:"mul_proof,omitempty"`
+}
+
+func (x *PreRound4Message1) Reset() {
+	*x = PreRound4Message1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_ecdsa_cggmp_presigning_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreRound4Message1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreRound4Message1) ProtoMessage() {}
+
+func (x *PreRound4Message1) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_ecdsa_cggmp_presigning_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreRound4Message1.ProtoReflect.Descriptor instead.
+func (*PreRound4Message1) Descriptor() ([]byte, []int) {
+	return file_protob_ecdsa_cggmp_presigning_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PreRound4Message1) GetH() []byte {
+	if x != nil {
+		return x.H
+	}
+	return nil
+}
+
+func (x *PreRound4Message1) GetMulProof() [][]byte {
+	if x != nil {
+		return x.MulProof
+	}
+	return nil
+}
+
+// Represents a P2P message sent to each party during Round 4 of the CGGMP21 ECDSA presigning protocol, should delta fail to check out.
+type PreRound4Message2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DecProof [][]byte `protobuf:"bytes,1,rep,name=dec_proof,json=decProof,proto3" json:"dec_proof,omitempty"`
+}
+
+func (x *PreRound4Message2) Reset() {
+	*x = PreRound4Message2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_ecdsa_cggmp_presigning_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreRound4Message2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreRound4Message2) ProtoMessage() {}
+
+func (x *PreRound4Message2) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_ecdsa_cggmp_presigning_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreRound4Message2.ProtoReflect.Descriptor instead.
+func (*PreRound4Message2) Descriptor() ([]byte, []int) {
+	return file_protob_ecdsa_cggmp_presigning_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PreRound4Message2) GetDecProof() [][]byte {
+	if x != nil {
+		return x.DecProof
+	}
+	return nil
+}
+
+var File_protob_ecdsa_cggmp_presigning_proto protoreflect.FileDescriptor
+
+var file_protob_ecdsa_cggmp_presigning_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2f, 0x65, 0x63, 0x64, 0x73, 0x61, 0x2d, 0x63,
+	0x67, 0x67, 0x6d, 0x70, 0x2d, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x25, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x74,
+	0x73, 0x73, 0x6c, 0x69, 0x62, 0x2e, 0x65, 0x63, 0x64, 0x73, 0x61, 0x2e, 0x63, 0x67, 0x67, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x2f, 0x0a, 0x11,
+	0x50, 0x72, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x31, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x31, 0x12, 0x0c, 0x0a, 0x01, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x01, 0x6b, 0x12,
+	0x0c, 0x0a, 0x01, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x01, 0x67, 0x22, 0x30, 0x0a,
+	0x11, 0x50, 0x72, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x31, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x32, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x63, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22,
+	0x8b, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x32, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x31, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x6d, 0x61, 0x5f, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x6d, 0x61, 0x58, 0x12, 0x17,
+	0x0a, 0x07, 0x67, 0x61, 0x6d, 0x6d, 0x61, 0x5f, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x67, 0x61, 0x6d, 0x6d, 0x61, 0x59, 0x12, 0x0c, 0x0a, 0x01, 0x64, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x01, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x66, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x01, 0x66, 0x12, 0x13, 0x0a, 0x05, 0x64, 0x5f, 0x68, 0x61, 0x74, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x48, 0x61, 0x74, 0x12, 0x13, 0x0a, 0x05, 0x66, 0x5f, 0x68, 0x61,
+	0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x48, 0x61, 0x74, 0x22, 0x7d, 0x0a,
+	0x11, 0x50, 0x72, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x32, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x66, 0x66, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x66, 0x66, 0x67, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x66, 0x66, 0x67, 0x5f, 0x68, 0x61, 0x74, 0x5f, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x61, 0x66, 0x66, 0x67, 0x48,
+	0x61, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x67, 0x73, 0x74,
+	0x61, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c,
+	0x6c, 0x6f, 0x67, 0x73, 0x74, 0x61, 0x72, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x69, 0x0a, 0x11,
+	0x50, 0x72, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x33, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x31, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0b, 0x62, 0x69, 0x67, 0x5f, 0x64,
+	0x65, 0x6c, 0x74, 0x61, 0x5f, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x69,
+	0x67, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x58, 0x12, 0x1e, 0x0a, 0x0b, 0x62, 0x69, 0x67, 0x5f, 0x64,
+	0x65, 0x6c, 0x74, 0x61, 0x5f, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x69,
+	0x67, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x59, 0x22, 0x38, 0x0a, 0x11, 0x50, 0x72, 0x65, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x33, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0x23, 0x0a, 0x0d,
+	0x6c, 0x6f, 0x67, 0x73, 0x74, 0x61, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x0c, 0x6c, 0x6f, 0x67, 0x73, 0x74, 0x61, 0x72, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x22, 0x3e, 0x0a, 0x11, 0x50, 0x72, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x34, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x31, 0x12, 0x0c, 0x0a, 0x01, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x01, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x75, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x75, 0x6c, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x22, 0x30, 0x0a, 0x11, 0x50, 0x72, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x34, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x63, 0x5f, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x64, 0x65, 0x63, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x42, 0x18, 0x5a, 0x16, 0x65, 0x63, 0x64, 0x73, 0x61, 0x2f, 0x63, 0x67, 0x67,
+	0x6d, 0x70, 0x2f, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_protob_ecdsa_cggmp_presigning_proto_rawDescOnce sync.Once
+	file_protob_ecdsa_cggmp_presigning_proto_rawDescData = file_protob_ecdsa_cggmp_presigning_proto_rawDesc
+)
+
+func file_protob_ecdsa_cggmp_presigning_proto_rawDescGZIP() []byte {
+	file_protob_ecdsa_cggmp_presigning_proto_rawDescOnce.Do(func() {
+		file_protob_ecdsa_cggmp_presigning_proto_rawDescData = protoimpl.X.CompressGZIP(file_protob_ecdsa_cggmp_presigning_proto_rawDescData)
+	})
+	return file_protob_ecdsa_cggmp_presigning_proto_rawDescData
+}
+
+var file_protob_ecdsa_cggmp_presigning_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_protob_ecdsa_cggmp_presigning_proto_goTypes = []interface{}{
+	(*PreRound1Message1)(nil), // 0: binance.tsslib.ecdsa.cggmp.presigning.PreRound1Message1
+	(*PreRound1Message2)(nil), // 1: binance.tsslib.ecdsa.cggmp.presigning.PreRound1Message2
+	(*PreRound2Message1)(nil), // 2: binance.tsslib.ecdsa.cggmp.presigning.PreRound2Message1
+	(*PreRound2Message2)(nil), // 3: binance.tsslib.ecdsa.cggmp.presigning.PreRound2Message2
+	(*PreRound3Message1)(nil), // 4: binance.tsslib.ecdsa.cggmp.presigning.PreRound3Message1
+	(*PreRound3Message2)(nil), // 5: binance.tsslib.ecdsa.cggmp.presigning.PreRound3Message2
+	(*PreRound4Message1)(nil), // 6: binance.tsslib.ecdsa.cggmp.presigning.PreRound4Message1
+	(*PreRound4Message2)(nil), // 7: binance.tsslib.ecdsa.cggmp.presigning.PreRound4Message2
+}
+var file_protob_ecdsa_cggmp_presigning_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_protob_ecdsa_cggmp_presigning_proto_init() }
+func file_protob_ecdsa_cggmp_presigning_proto_init() {
+	if File_protob_ecdsa_cggmp_presigning_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_protob_ecdsa_cggmp_presigning_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreRound1Message1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protob_ecdsa_cggmp_presigning_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreRound1Message2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protob_ecdsa_cggmp_presigning_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreRound2Message1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protob_ecdsa_cggmp_presigning_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreRound2Message2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protob_ecdsa_cggmp_presigning_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreRound3Message1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protob_ecdsa_cggmp_presigning_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreRound3Message2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protob_ecdsa_cggmp_presigning_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreRound4Message1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protob_ecdsa_cggmp_presigning_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreRound4Message2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_protob_ecdsa_cggmp_presigning_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_protob_ecdsa_cggmp_presigning_proto_goTypes,
+		DependencyIndexes: file_protob_ecdsa_cggmp_presigning_proto_depIdxs,
+		MessageInfos:      file_protob_ecdsa_cggmp_presigning_proto_msgTypes,
+	}.Build()
+	File_protob_ecdsa_cggmp_presigning_proto = out.File
+	file_protob_ecdsa_cggmp_presigning_proto_rawDesc = nil
+	file_protob_ecdsa_cggmp_presigning_proto_goTypes = nil
+	file_protob_ecdsa_cggmp_presigning_proto_depIdxs = nil
+}
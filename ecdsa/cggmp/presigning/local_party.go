@@ -0,0 +1,199 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package presigning
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// Implements Party
+// Implements Stringer
+var _ tss.Party = (*LocalParty)(nil)
+var _ fmt.Stringer = (*LocalParty)(nil)
+
+type (
+	LocalParty struct {
+		*tss.BaseParty
+		params *tss.Parameters
+
+		keys keygen.LocalPartySaveData
+		temp localTempData
+
+		// outbound messaging
+		out chan<- tss.Message
+		end chan<- *PreSignatureData
+	}
+
+	localMessageStore struct {
+		preRound1Message1s,
+		preRound1Message2s,
+		preRound2Message1s,
+		preRound2Message2s,
+		preRound3Message1s,
+		preRound3Message2s,
+		preRound4Message1s,
+		preRound4Message2s []tss.ParsedMessage
+	}
+
+	localTempData struct {
+		localMessageStore
+
+		// temp data (thrown away after presigning)
+		wi    *big.Int
+		bigWs []*crypto.ECPoint
+
+		ki, gammai *big.Int
+		rhoi, nui  *big.Int // the randomness of Ki and Gi
+
+		K, G       []*big.Int // the ciphertexts of the ks and gammas of each party
+		bigGammas  []*crypto.ECPoint
+		bigGamma   *crypto.ECPoint
+		ys, yHats  []*big.Int   // the masks this party adds to its affine operations, indexed by recipient
+		D, F       [][]*big.Int // the ciphertexts of the affine operations, indexed by recipient then sender
+		DHat, FHat [][]*big.Int
+
+		deltai, chii *big.Int
+		deltas       []*big.Int
+		bigDeltas    []*crypto.ECPoint
+
+		identify bool // set when the check of delta fails
+	}
+)
+
+// NewLocalParty returns a party for the presigning of CGGMP21 (Fig. 7), which makes a presignature that a party then
+// uses to sign a message in a single round with the cggmp signing protocol.
+// The key must carry the Paillier keys and ring-Pedersen parameters made by keygen or auxinfo, and the signing parties
+// are those of `params`. Rounds 1 to 3 are the three rounds of the paper and round 4 is its output step, in which
+// no message is sent. Should the result fail to check out in round 4, the parties send the proofs of their shares of
+// delta and check them in round 5, which identifies the parties that cheated.
+func NewLocalParty(
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	out chan<- tss.Message,
+	end chan<- *PreSignatureData,
+) tss.Party {
+	partyCount := len(params.Parties().IDs())
+	p := &LocalParty{
+		BaseParty: tss.NewBaseParty(params),
+		params:    params,
+		keys:      keygen.BuildLocalSaveDataSubset(key, params.Parties().IDs()),
+		temp:      localTempData{},
+		out:       out,
+		end:       end,
+	}
+	// msgs init
+	p.temp.preRound1Message1s = make([]tss.ParsedMessage, partyCount)
+	p.temp.preRound1Message2s = make([]tss.ParsedMessage, partyCount)
+	p.temp.preRound2Message1s = make([]tss.ParsedMessage, partyCount)
+	p.temp.preRound2Message2s = make([]tss.ParsedMessage, partyCount)
+	p.temp.preRound3Message1s = make([]tss.ParsedMessage, partyCount)
+	p.temp.preRound3Message2s = make([]tss.ParsedMessage, partyCount)
+	p.temp.preRound4Message1s = make([]tss.ParsedMessage, partyCount)
+	p.temp.preRound4Message2s = make([]tss.ParsedMessage, partyCount)
+	// temp data init
+	p.temp.K = make([]*big.Int, partyCount)
+	p.temp.G = make([]*big.Int, partyCount)
+	p.temp.bigGammas = make([]*crypto.ECPoint, partyCount)
+	p.temp.ys = make([]*big.Int, partyCount)
+	p.temp.yHats = make([]*big.Int, partyCount)
+	p.temp.D = newMatrix(partyCount)
+	p.temp.F = newMatrix(partyCount)
+	p.temp.DHat = newMatrix(partyCount)
+	p.temp.FHat = newMatrix(partyCount)
+	p.temp.deltas = make([]*big.Int, partyCount)
+	p.temp.bigDeltas = make([]*crypto.ECPoint, partyCount)
+	return p
+}
+
+func newMatrix(n int) [][]*big.Int {
+	m := make([][]*big.Int, n)
+	for j := range m {
+		m[j] = make([]*big.Int, n)
+	}
+	return m
+}
+
+func (p *LocalParty) FirstRound() tss.Round {
+	return newRound1(p.params, &p.keys, &p.temp, p.out, p.end)
+}
+
+func (p *LocalParty) Start() *tss.Error {
+	return tss.BaseStart(p, TaskName)
+}
+
+func (p *LocalParty) StartWithContext(ctx context.Context) *tss.Error {
+	return tss.BaseStartWithContext(ctx, p, TaskName)
+}
+
+func (p *LocalParty) Update(msg tss.ParsedMessage) (ok bool, err *tss.Error) {
+	return tss.BaseUpdate(p, msg, TaskName)
+}
+
+func (p *LocalParty) UpdateFromBytes(wireBytes []byte, from *tss.PartyID, isBroadcast bool) (bool, *tss.Error) {
+	msg, err := p.ParseWireMessage(wireBytes, from, isBroadcast)
+	if err != nil {
+		return false, err
+	}
+	return p.Update(msg)
+}
+
+func (p *LocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
+	if ok, err := p.BaseParty.ValidateMessage(msg); !ok || err != nil {
+		return ok, err
+	}
+	// check that the message's "from index" will fit into the array
+	if maxFromIdx := len(p.params.Parties().IDs()) - 1; maxFromIdx < msg.GetFrom().Index {
+		return false, p.WrapError(fmt.Errorf("%w: received msg with a sender index too great (%d <= %d)", tss.ErrInvalidMessage,
+			maxFromIdx, msg.GetFrom().Index), msg.GetFrom())
+	}
+	return true, nil
+}
+
+func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
+	// ValidateBasic is cheap; double-check the message here in case the public StoreMessage was called externally
+	if ok, err := p.ValidateMessage(msg); !ok || err != nil {
+		return ok, err
+	}
+
+	// switch/case is necessary to store any messages beyond current round
+	// a sender may deliver each message only once; identical re-deliveries are ignored. spoofing protection is left to the caller.
+	switch msg.Content().(type) {
+	case *PreRound1Message1:
+		return tss.StoreMessageOnce(p, p.temp.preRound1Message1s, msg)
+	case *PreRound1Message2:
+		return tss.StoreMessageOnce(p, p.temp.preRound1Message2s, msg)
+	case *PreRound2Message1:
+		return tss.StoreMessageOnce(p, p.temp.preRound2Message1s, msg)
+	case *PreRound2Message2:
+		return tss.StoreMessageOnce(p, p.temp.preRound2Message2s, msg)
+	case *PreRound3Message1:
+		return tss.StoreMessageOnce(p, p.temp.preRound3Message1s, msg)
+	case *PreRound3Message2:
+		return tss.StoreMessageOnce(p, p.temp.preRound3Message2s, msg)
+	case *PreRound4Message1:
+		return tss.StoreMessageOnce(p, p.temp.preRound4Message1s, msg)
+	case *PreRound4Message2:
+		return tss.StoreMessageOnce(p, p.temp.preRound4Message2s, msg)
+	default: // unrecognised message, just ignore!
+		p.params.Logger().Warningf("unrecognised message ignored: %s", msg.Type())
+		return false, nil
+	}
+}
+
+func (p *LocalParty) PartyID() *tss.PartyID {
+	return p.params.PartyID()
+}
+
+func (p *LocalParty) String() string {
+	return fmt.Sprintf("id: %s, %s", p.PartyID(), p.BaseParty.String())
+}
@@ -0,0 +1,180 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package presigning
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	cggmpkeygen "github.com/bnb-chain/tss-lib/ecdsa/cggmp/keygen"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/ecdsa/signing"
+	"github.com/bnb-chain/tss-lib/test/adversary"
+	"github.com/bnb-chain/tss-lib/test/netsim"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+const (
+	testParticipants = 3
+	testThreshold    = 1
+)
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+}
+
+// loadKeys runs a cggmp keygen and gives its shares the aux info of the keygen fixtures, in place of an auxinfo run
+func loadKeys(t *testing.T) ([]keygen.LocalPartySaveData, tss.SortedPartyIDs) {
+	fixtures, _, err := keygen.LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	pIDs := tss.GenerateTestPartyIDs(testParticipants)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	outCh := make(chan tss.Message, len(pIDs)*len(pIDs))
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+
+	net := netsim.New(1)
+	for _, pID := range pIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(pIDs), testThreshold)
+		assert.NoError(t, net.Register(cggmpkeygen.NewLocalParty(params, outCh, endCh)))
+	}
+	_, err = net.Run(context.Background(), outCh, time.Second)
+	assert.NoError(t, err)
+
+	keys := make([]keygen.LocalPartySaveData, len(pIDs))
+	for range pIDs {
+		key := <-endCh
+		index, err := key.OriginalIndex()
+		assert.NoError(t, err)
+		key.LocalPreParams = fixtures[index].LocalPreParams
+		for j, fixture := range fixtures {
+			key.PaillierPKs[j] = &fixture.PaillierSK.PublicKey
+			key.NTildej[j], key.H1j[j], key.H2j[j] = fixture.NTildei, fixture.H1i, fixture.H2i
+		}
+		keys[index] = key
+	}
+	return keys, pIDs
+}
+
+// liar is a party that goes on with the value of deltai that it broadcasts in place of the one it computed,
+// so that it takes part in the identification of the cheaters
+type liar struct {
+	*LocalParty
+	lied bool
+}
+
+func (p *liar) UpdateFromBytes(wireBytes []byte, from *tss.PartyID, isBroadcast bool) (bool, *tss.Error) {
+	msg, err := p.ParseWireMessage(wireBytes, from, isBroadcast)
+	if err != nil {
+		return false, err
+	}
+	return p.Update(msg)
+}
+
+func (p *liar) Update(msg tss.ParsedMessage) (bool, *tss.Error) {
+	return tss.BaseUpdate(p, msg, TaskName)
+}
+
+func (p *liar) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
+	i := p.PartyID().Index
+	if own := p.temp.preRound3Message1s[i]; own != nil && !p.lied {
+		r3msg1 := own.Content().(*PreRound3Message1)
+		bigDelta, _ := r3msg1.UnmarshalBigDelta(tss.S256())
+		p.temp.preRound3Message1s[i] = NewPreRound3Message1(p.PartyID(), new(big.Int).Add(r3msg1.UnmarshalDelta(), big.NewInt(1)), bigDelta)
+		p.lied = true
+	}
+	return p.LocalParty.StoreMessage(msg)
+}
+
+func TestE2E(t *testing.T) {
+	setUp("info")
+
+	keys, pIDs := loadKeys(t)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	outCh := make(chan tss.Message, len(pIDs)*len(pIDs))
+	endCh := make(chan *PreSignatureData, len(pIDs))
+
+	net := netsim.New(1)
+	for i, pID := range pIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(pIDs), testThreshold)
+		assert.NoError(t, net.Register(NewLocalParty(params, keys[i], outCh, endCh)))
+	}
+	results, err := net.Run(context.Background(), outCh, time.Second)
+	assert.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, netsim.Finished, r.Outcome, r.String())
+	}
+
+	// R = k^-1 * G and sum(chii) = k * x, where x is the sum of the additive shares of the signers
+	assert.Len(t, endCh, len(pIDs))
+	modQ := common.ModInt(tss.S256().Params().N)
+	k, chi, x := big.NewInt(0), big.NewInt(0), big.NewInt(0)
+	var R *crypto.ECPoint
+	for range pIDs {
+		preSig := <-endCh
+		assert.True(t, preSig.ECDSAPub.Equals(keys[0].ECDSAPub))
+		if R != nil {
+			assert.True(t, preSig.R.Equals(R), "all parties should derive the same R")
+		}
+		R = preSig.R
+		k, chi = modQ.Add(k, preSig.Ki), modQ.Add(chi, preSig.ChiI)
+	}
+	for i, key := range keys {
+		wi, _ := signing.PrepareForSigning(tss.S256(), i, len(keys), key.Xi, key.Ks, key.BigXj)
+		x = modQ.Add(x, wi)
+	}
+	assert.True(t, crypto.ScalarBaseMult(tss.S256(), x).Equals(keys[0].ECDSAPub))
+	assert.True(t, crypto.ScalarBaseMult(tss.S256(), modQ.ModInverse(k)).Equals(R), "R should be k^-1 * G")
+	assert.Equal(t, modQ.Mul(k, x), chi, "the chii should be shares of k * x")
+}
+
+func TestE2ECheater(t *testing.T) {
+	setUp("info")
+
+	keys, pIDs := loadKeys(t)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	endCh := make(chan *PreSignatureData, len(pIDs))
+	newParams := func(i int) *tss.Parameters {
+		return tss.NewParameters(tss.S256(), p2pCtx, pIDs[i], len(pIDs), testThreshold)
+	}
+
+	for _, tc := range []struct {
+		corruption adversary.Corruption
+		liar       bool
+		cause      error
+	}{
+		{adversary.Corruption{MessageType: adversary.MessageType(&PreRound1Message2{}), Corrupt: adversary.TamperField("enc_proof")}, false, &tss.ProofError{Proof: tss.ProofPaillierEnc}},
+		{adversary.Corruption{MessageType: adversary.MessageType(&PreRound2Message1{}), Corrupt: func(content tss.MessageContent) {
+			// the first ciphertext is that of the cheater itself
+			d := content.(*PreRound2Message1).D
+			for j := 1; j < len(d); j++ {
+				d[j] = new(big.Int).Add(new(big.Int).SetBytes(d[j]), big.NewInt(1)).Bytes()
+			}
+		}}, false, &tss.ProofError{Proof: tss.ProofPaillierAffG}},
+		{adversary.Corruption{MessageType: adversary.MessageType(&PreRound2Message2{}), Corrupt: adversary.TamperField("logstar_proof")}, false, &tss.ProofError{Proof: tss.ProofPaillierLogStar}},
+		{adversary.Corruption{MessageType: adversary.MessageType(&PreRound3Message1{}), Corrupt: adversary.TamperField("big_delta_x")}, false, tss.ErrPointNotOnCurve},
+		{adversary.Corruption{MessageType: adversary.MessageType(&PreRound3Message1{}), Corrupt: adversary.TamperField("delta")}, true, &tss.ProofError{Proof: tss.ProofPaillierDec}},
+	} {
+		results, err := adversary.Run(len(pIDs), 0, newParams, func(i int, params *tss.Parameters, out chan<- tss.Message) tss.Party {
+			P := NewLocalParty(params, keys[i], out, endCh)
+			if i == 0 && tc.liar {
+				return &liar{LocalParty: P.(*LocalParty)}
+			}
+			return P
+		}, tc.corruption)
+		assert.NoError(t, err)
+		assert.NoError(t, adversary.CheckBlamed(results, pIDs[0], tc.cause))
+	}
+}
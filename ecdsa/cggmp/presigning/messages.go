@@ -0,0 +1,287 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package presigning
+
+import (
+	"crypto/elliptic"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// These messages were generated from Protocol Buffers definitions into ecdsa-cggmp-presigning.pb.go
+// The following messages are registered on the Protocol Buffers "wire"
+
+var (
+	// Ensure that presigning messages implement ValidateBasic
+	_ = []tss.MessageContent{
+		(*PreRound1Message1)(nil),
+		(*PreRound1Message2)(nil),
+		(*PreRound2Message1)(nil),
+		(*PreRound2Message2)(nil),
+		(*PreRound3Message1)(nil),
+		(*PreRound3Message2)(nil),
+		(*PreRound4Message1)(nil),
+		(*PreRound4Message2)(nil),
+	}
+)
+
+// ----- //
+
+func NewPreRound1Message1(from *tss.PartyID, K, G *big.Int) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &PreRound1Message1{
+		K: K.Bytes(),
+		G: G.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *PreRound1Message1) ValidateBasic() bool {
+	return m != nil &&
+		common.NonEmptyBytes(m.GetK()) &&
+		common.NonEmptyBytes(m.GetG())
+}
+
+func (m *PreRound1Message1) UnmarshalK() *big.Int {
+	return new(big.Int).SetBytes(m.GetK())
+}
+
+func (m *PreRound1Message1) UnmarshalG() *big.Int {
+	return new(big.Int).SetBytes(m.GetG())
+}
+
+// ----- //
+
+func NewPreRound1Message2(to, from *tss.PartyID, proof *paillier.EncProof) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	pfBz := proof.Bytes()
+	content := &PreRound1Message2{
+		EncProof: pfBz[:],
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *PreRound1Message2) ValidateBasic() bool {
+	return m != nil && common.NonEmptyMultiBytes(m.GetEncProof(), paillier.EncProofBytesParts)
+}
+
+func (m *PreRound1Message2) UnmarshalEncProof() (*paillier.EncProof, error) {
+	return paillier.EncProofFromBytes(m.GetEncProof())
+}
+
+// ----- //
+
+func NewPreRound2Message1(from *tss.PartyID, Gamma *crypto.ECPoint, D, F, DHat, FHat []*big.Int) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &PreRound2Message1{
+		GammaX: Gamma.X().Bytes(),
+		GammaY: Gamma.Y().Bytes(),
+		D:      common.BigIntsToBytes(D),
+		F:      common.BigIntsToBytes(F),
+		DHat:   common.BigIntsToBytes(DHat),
+		FHat:   common.BigIntsToBytes(FHat),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *PreRound2Message1) ValidateBasic() bool {
+	if m == nil || !common.NonEmptyBytes(m.GetGammaX()) || !common.NonEmptyBytes(m.GetGammaY()) {
+		return false
+	}
+	// the ciphertexts are indexed by their recipient, so the entry of the sender is empty
+	n := len(m.GetD())
+	return 1 < n && len(m.GetF()) == n && len(m.GetDHat()) == n && len(m.GetFHat()) == n
+}
+
+func (m *PreRound2Message1) UnmarshalGamma(ec elliptic.Curve) (*crypto.ECPoint, error) {
+	return crypto.NewECPoint(
+		ec,
+		new(big.Int).SetBytes(m.GetGammaX()),
+		new(big.Int).SetBytes(m.GetGammaY()))
+}
+
+func (m *PreRound2Message1) UnmarshalD() []*big.Int {
+	return common.MultiBytesToBigInts(m.GetD())
+}
+
+func (m *PreRound2Message1) UnmarshalF() []*big.Int {
+	return common.MultiBytesToBigInts(m.GetF())
+}
+
+func (m *PreRound2Message1) UnmarshalDHat() []*big.Int {
+	return common.MultiBytesToBigInts(m.GetDHat())
+}
+
+func (m *PreRound2Message1) UnmarshalFHat() []*big.Int {
+	return common.MultiBytesToBigInts(m.GetFHat())
+}
+
+// ----- //
+
+func NewPreRound2Message2(to, from *tss.PartyID, affG, affGHat *paillier.AffGProof, logStar *paillier.LogStarProof) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	affGBz, affGHatBz, logStarBz := affG.Bytes(), affGHat.Bytes(), logStar.Bytes()
+	content := &PreRound2Message2{
+		AffgProof:    affGBz[:],
+		AffgHatProof: affGHatBz[:],
+		LogstarProof: logStarBz[:],
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *PreRound2Message2) ValidateBasic() bool {
+	return m != nil &&
+		common.NonEmptyMultiBytes(m.GetAffgProof(), paillier.AffGProofBytesParts) &&
+		common.NonEmptyMultiBytes(m.GetAffgHatProof(), paillier.AffGProofBytesParts) &&
+		common.NonEmptyMultiBytes(m.GetLogstarProof(), paillier.LogStarProofBytesParts)
+}
+
+func (m *PreRound2Message2) UnmarshalAffGProof(ec elliptic.Curve) (*paillier.AffGProof, error) {
+	return paillier.AffGProofFromBytes(ec, m.GetAffgProof())
+}
+
+func (m *PreRound2Message2) UnmarshalAffGHatProof(ec elliptic.Curve) (*paillier.AffGProof, error) {
+	return paillier.AffGProofFromBytes(ec, m.GetAffgHatProof())
+}
+
+func (m *PreRound2Message2) UnmarshalLogStarProof(ec elliptic.Curve) (*paillier.LogStarProof, error) {
+	return paillier.LogStarProofFromBytes(ec, m.GetLogstarProof())
+}
+
+// ----- //
+
+func NewPreRound3Message1(from *tss.PartyID, delta *big.Int, BigDelta *crypto.ECPoint) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &PreRound3Message1{
+		Delta:     delta.Bytes(),
+		BigDeltaX: BigDelta.X().Bytes(),
+		BigDeltaY: BigDelta.Y().Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *PreRound3Message1) ValidateBasic() bool {
+	return m != nil &&
+		common.NonEmptyBytes(m.GetDelta()) &&
+		common.NonEmptyBytes(m.GetBigDeltaX()) &&
+		common.NonEmptyBytes(m.GetBigDeltaY())
+}
+
+func (m *PreRound3Message1) UnmarshalDelta() *big.Int {
+	return new(big.Int).SetBytes(m.GetDelta())
+}
+
+func (m *PreRound3Message1) UnmarshalBigDelta(ec elliptic.Curve) (*crypto.ECPoint, error) {
+	return crypto.NewECPoint(
+		ec,
+		new(big.Int).SetBytes(m.GetBigDeltaX()),
+		new(big.Int).SetBytes(m.GetBigDeltaY()))
+}
+
+// ----- //
+
+func NewPreRound3Message2(to, from *tss.PartyID, logStar *paillier.LogStarProof) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	pfBz := logStar.Bytes()
+	content := &PreRound3Message2{
+		LogstarProof: pfBz[:],
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *PreRound3Message2) ValidateBasic() bool {
+	return m != nil && common.NonEmptyMultiBytes(m.GetLogstarProof(), paillier.LogStarProofBytesParts)
+}
+
+func (m *PreRound3Message2) UnmarshalLogStarProof(ec elliptic.Curve) (*paillier.LogStarProof, error) {
+	return paillier.LogStarProofFromBytes(ec, m.GetLogstarProof())
+}
+
+// ----- //
+
+func NewPreRound4Message1(from *tss.PartyID, H *big.Int, proof *paillier.MulProof) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	pfBz := proof.Bytes()
+	content := &PreRound4Message1{
+		H:        H.Bytes(),
+		MulProof: pfBz[:],
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *PreRound4Message1) ValidateBasic() bool {
+	return m != nil &&
+		common.NonEmptyBytes(m.GetH()) &&
+		common.NonEmptyMultiBytes(m.GetMulProof(), paillier.MulProofBytesParts)
+}
+
+func (m *PreRound4Message1) UnmarshalH() *big.Int {
+	return new(big.Int).SetBytes(m.GetH())
+}
+
+func (m *PreRound4Message1) UnmarshalMulProof() (*paillier.MulProof, error) {
+	return paillier.MulProofFromBytes(m.GetMulProof())
+}
+
+// ----- //
+
+func NewPreRound4Message2(to, from *tss.PartyID, proof *paillier.DecProof) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	pfBz := proof.Bytes()
+	content := &PreRound4Message2{
+		DecProof: pfBz[:],
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *PreRound4Message2) ValidateBasic() bool {
+	return m != nil && common.NonEmptyMultiBytes(m.GetDecProof(), paillier.DecProofBytesParts)
+}
+
+func (m *PreRound4Message2) UnmarshalDecProof() (*paillier.DecProof, error) {
+	return paillier.DecProofFromBytes(m.GetDecProof())
+}
@@ -0,0 +1,35 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package presigning
+
+import (
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/crypto"
+)
+
+type (
+	// PreSignatureData is the share of a party in a presignature, made ahead of the message to sign.
+	// It is secret and single-use: signing two messages with the same presignature reveals the private key,
+	// so a stored copy must be deleted before it is used.
+	PreSignatureData struct {
+		// the original indexes of the signing parties; signing must run with the same parties
+		Ks []*big.Int
+
+		ECDSAPub *crypto.ECPoint // y
+		R        *crypto.ECPoint // R = (k * gamma)^-1 * Gamma = k^-1 * G
+
+		// additive shares of k and chi = k * x
+		Ki, ChiI *big.Int
+
+		// the public ciphertexts of presigning that a failed signing checks the shares of sigma against:
+		// the ciphertexts of the ks of each party, and those of the affine operations on the key shares,
+		// indexed by recipient then sender
+		K          []*big.Int
+		DHat, FHat [][]*big.Int
+	}
+)
@@ -0,0 +1,96 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package presigning
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/ecdsa/signing"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// round 1 represents round 1 of the presigning part of the CGGMP21 ECDSA TSS spec (Canetti et al.; 2021)
+func newRound1(params *tss.Parameters, key *keygen.LocalPartySaveData, temp *localTempData, out chan<- tss.Message, end chan<- *PreSignatureData) tss.Round {
+	return &round1{
+		&base{params, key, temp, out, end, make([]bool, len(params.Parties().IDs())), false, 1}}
+}
+
+func (round *round1) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	if round.key.PaillierSK == nil {
+		return round.WrapError(fmt.Errorf("%w: the key has no aux info; run auxinfo first", tss.ErrInternal))
+	}
+	round.number = 1
+	round.started = true
+	round.resetOK()
+
+	ec := round.Params().EC()
+	Pi := round.PartyID()
+	i := Pi.Index
+	pki := &round.key.PaillierSK.PublicKey
+
+	// the additive share wi of the private key, and the Wj = wj*G of each signer
+	round.temp.wi, round.temp.bigWs = signing.PrepareForSigning(ec, i, len(round.Parties().IDs()), round.key.Xi, round.key.Ks, round.key.BigXj)
+
+	// 1. sample ki and gammai, and encrypt them under our Paillier key
+	round.temp.ki = common.GetRandomPositiveIntWithRand(round.Rand(), ec.Params().N)
+	round.temp.gammai = common.GetRandomPositiveIntWithRand(round.Rand(), ec.Params().N)
+	round.temp.rhoi = common.GetRandomPositiveRelativelyPrimeIntWithRand(round.Rand(), pki.N)
+	round.temp.nui = common.GetRandomPositiveRelativelyPrimeIntWithRand(round.Rand(), pki.N)
+	Ki := pki.EncryptWithRandomness(round.temp.ki, round.temp.rhoi)
+	Gi := pki.EncryptWithRandomness(round.temp.gammai, round.temp.nui)
+
+	// 2. BROADCAST Ki and Gi
+	r1msg1 := NewPreRound1Message1(Pi, Ki, Gi)
+	round.temp.preRound1Message1s[i] = r1msg1
+	if err := tss.SendMessage(round.Params(), round.out, r1msg1); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
+
+	// 3. p2p send the proof that ki is in range to each Pj, under the ring-Pedersen parameters of Pj
+	for j, Pj := range round.Parties().IDs() {
+		if j == i {
+			continue
+		}
+		start := time.Now()
+		proof, err := paillier.ProveEnc(round.Rand(), round.SessionID(), ec, pki, round.key.NTildej[j], round.key.H1j[j], round.key.H2j[j], Ki, round.temp.ki, round.temp.rhoi)
+		round.Params().Metrics().RecordProof(tss.ProofPaillierEnc, tss.ProofGeneration, start)
+		if err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err), Pi)
+		}
+		if err := tss.SendMessage(round.Params(), round.out, NewPreRound1Message2(Pj, Pi, proof)); err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+		}
+	}
+	return nil
+}
+
+func (round *round1) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*PreRound1Message1); ok {
+		return msg.IsBroadcast()
+	}
+	if _, ok := msg.Content().(*PreRound1Message2); ok {
+		return !msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round1) Update() (bool, *tss.Error) {
+	// proof checks are in round 2
+	return round.updateWith(round.temp.preRound1Message1s, round.temp.preRound1Message2s, round.CanAccept)
+}
+
+func (round *round1) NextRound() tss.Round {
+	round.started = false
+	return &round2{round}
+}
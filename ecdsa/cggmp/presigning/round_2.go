@@ -0,0 +1,148 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package presigning
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func (round *round2) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	round.number = 2
+	round.started = true
+	round.resetOK()
+
+	ec := round.Params().EC()
+	Ps := round.Parties().IDs()
+	Pi := round.PartyID()
+	i := Pi.Index
+	NTildei, h1i, h2i := round.key.NTildej[i], round.key.H1j[i], round.key.H2j[i]
+
+	// 1. verify the proofs that the kj are in range
+	{
+		var multiErr error
+		culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+		for j, Pj := range Ps {
+			r1msg1 := round.temp.preRound1Message1s[j].Content().(*PreRound1Message1)
+			round.temp.K[j], round.temp.G[j] = r1msg1.UnmarshalK(), r1msg1.UnmarshalG()
+			if j == i {
+				continue
+			}
+			proof, err := round.temp.preRound1Message2s[j].Content().(*PreRound1Message2).UnmarshalEncProof()
+			if err == nil {
+				start := time.Now()
+				if !proof.Verify(round.SessionID(), ec, round.key.PaillierPKs[j], NTildei, h1i, h2i, round.temp.K[j]) {
+					err = tss.NewProofError(tss.ProofPaillierEnc, nil)
+				}
+				round.Params().Metrics().RecordProof(tss.ProofPaillierEnc, tss.ProofVerification, start)
+			} else {
+				err = tss.NewProofError(tss.ProofPaillierEnc, err)
+			}
+			if err != nil {
+				multiErr = multierror.Append(multiErr, err)
+				culprits = append(culprits, Pj)
+			}
+		}
+		if len(culprits) > 0 {
+			return round.WrapError(multiErr, culprits...)
+		}
+	}
+
+	// 2. compute Gammai = gammai*G and the affine operations on the kj of each Pj:
+	// Dji = gammai * Kj + enc_j(yij) and Fji = enc_i(yij), and likewise with wi for the hats
+	pki := &round.key.PaillierSK.PublicKey
+	round.temp.bigGammas[i] = crypto.ScalarBaseMult(ec, round.temp.gammai)
+	for j, Pj := range Ps {
+		if j == i {
+			continue
+		}
+		pkj := round.key.PaillierPKs[j]
+		NTildej, h1j, h2j := round.key.NTildej[j], round.key.H1j[j], round.key.H2j[j]
+
+		D, F, y, affG, err := round.affine(pkj, NTildej, h1j, h2j, round.temp.K[j], round.temp.bigGammas[i], round.temp.gammai)
+		if err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err), Pi)
+		}
+		DHat, FHat, yHat, affGHat, err := round.affine(pkj, NTildej, h1j, h2j, round.temp.K[j], round.temp.bigWs[i], round.temp.wi)
+		if err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err), Pi)
+		}
+		round.temp.D[j][i], round.temp.F[j][i], round.temp.ys[j] = D, F, y
+		round.temp.DHat[j][i], round.temp.FHat[j][i], round.temp.yHats[j] = DHat, FHat, yHat
+
+		start := time.Now()
+		logStar, err := paillier.ProveLogStar(round.Rand(), round.SessionID(), ec, pki, NTildej, h1j, h2j, round.temp.G[i], round.temp.bigGammas[i], nil, round.temp.gammai, round.temp.nui)
+		round.Params().Metrics().RecordProof(tss.ProofPaillierLogStar, tss.ProofGeneration, start)
+		if err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err), Pi)
+		}
+		// p2p send the proofs to Pj
+		if err := tss.SendMessage(round.Params(), round.out, NewPreRound2Message2(Pj, Pi, affG, affGHat, logStar)); err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+		}
+	}
+
+	// 3. BROADCAST Gammai and the ciphertexts, so that every party may check the shares of delta and sigma later on
+	Ds, Fs, DHats, FHats := make([]*big.Int, len(Ps)), make([]*big.Int, len(Ps)), make([]*big.Int, len(Ps)), make([]*big.Int, len(Ps))
+	for j := range Ps {
+		Ds[j], Fs[j], DHats[j], FHats[j] = round.temp.D[j][i], round.temp.F[j][i], round.temp.DHat[j][i], round.temp.FHat[j][i]
+	}
+	r2msg1 := NewPreRound2Message1(Pi, round.temp.bigGammas[i], Ds, Fs, DHats, FHats)
+	round.temp.preRound2Message1s[i] = r2msg1
+	if err := tss.SendMessage(round.Params(), round.out, r2msg1); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
+	return nil
+}
+
+// affine returns D = x * Kj + enc_j(y) and F = enc_i(y) for a fresh mask y, along with the proof of the operation for Pj
+func (round *round2) affine(pkj *paillier.PublicKey, NTildej, h1j, h2j, Kj *big.Int, X *crypto.ECPoint, x *big.Int) (D, F, y *big.Int, proof *paillier.AffGProof, err error) {
+	pki := &round.key.PaillierSK.PublicKey
+	y = common.GetRandomIntIn2PowerRangeWithRand(round.Rand(), paillier.PARAM_LPrime)
+	s := common.GetRandomPositiveRelativelyPrimeIntWithRand(round.Rand(), pkj.N)
+	r := common.GetRandomPositiveRelativelyPrimeIntWithRand(round.Rand(), pki.N)
+
+	modNj2 := common.ModInt(pkj.NSquare())
+	D = modNj2.Mul(modNj2.Exp(Kj, x), pkj.EncryptWithRandomness(y, s))
+	F = pki.EncryptWithRandomness(y, r)
+
+	start := time.Now()
+	proof, err = paillier.ProveAffG(round.Rand(), round.SessionID(), round.Params().EC(), pkj, pki, NTildej, h1j, h2j, Kj, D, F, X, x, y, s, r)
+	round.Params().Metrics().RecordProof(tss.ProofPaillierAffG, tss.ProofGeneration, start)
+	return
+}
+
+func (round *round2) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*PreRound2Message1); ok {
+		return msg.IsBroadcast()
+	}
+	if _, ok := msg.Content().(*PreRound2Message2); ok {
+		return !msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round2) Update() (bool, *tss.Error) {
+	// proof checks are in round 3
+	return round.updateWith(round.temp.preRound2Message1s, round.temp.preRound2Message2s, round.CanAccept)
+}
+
+func (round *round2) NextRound() tss.Round {
+	round.started = false
+	return &round3{round}
+}
@@ -0,0 +1,193 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package presigning
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func (round *round3) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	round.number = 3
+	round.started = true
+	round.resetOK()
+
+	ec := round.Params().EC()
+	Ps := round.Parties().IDs()
+	Pi := round.PartyID()
+	i := Pi.Index
+
+	// 1. store the broadcasts of round 2 and verify the proofs of the affine operations on ki and of Gammaj
+	{
+		var multiErr error
+		culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+		for j, Pj := range Ps {
+			if j == i {
+				continue
+			}
+			if err := round.verifyRound2(j); err != nil {
+				multiErr = multierror.Append(multiErr, err)
+				culprits = append(culprits, Pj)
+			}
+		}
+		if len(culprits) > 0 {
+			return round.WrapError(multiErr, culprits...)
+		}
+	}
+
+	// 2. compute Gamma and Deltai = ki * Gamma
+	bigGamma := round.temp.bigGammas[i]
+	for j := range Ps {
+		if j == i {
+			continue
+		}
+		var err error
+		if bigGamma, err = bigGamma.Add(round.temp.bigGammas[j]); err != nil {
+			return round.WrapError(fmt.Errorf("%w: adding Gammaj to Gamma", tss.ErrPointNotOnCurve))
+		}
+	}
+	round.temp.bigGamma = bigGamma
+	bigDeltai := bigGamma.ScalarMult(round.temp.ki)
+
+	// 3. compute the shares deltai of k * gamma and chii of k * x
+	modQ := common.ModInt(ec.Params().N)
+	deltai := modQ.Mul(round.temp.ki, round.temp.gammai)
+	chii := modQ.Mul(round.temp.ki, round.temp.wi)
+	for j := range Ps {
+		if j == i {
+			continue
+		}
+		alpha, err := round.decrypt(round.temp.D[i][j])
+		if err != nil {
+			return round.WrapError(err)
+		}
+		alphaHat, err := round.decrypt(round.temp.DHat[i][j])
+		if err != nil {
+			return round.WrapError(err)
+		}
+		// betaij = -yij
+		deltai = modQ.Add(deltai, modQ.Sub(alpha, round.temp.ys[j]))
+		chii = modQ.Add(chii, modQ.Sub(alphaHat, round.temp.yHats[j]))
+	}
+	round.temp.deltai, round.temp.chii = deltai, chii
+
+	// 4. BROADCAST deltai and Deltai
+	r3msg1 := NewPreRound3Message1(Pi, deltai, bigDeltai)
+	round.temp.preRound3Message1s[i] = r3msg1
+	if err := tss.SendMessage(round.Params(), round.out, r3msg1); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
+
+	// 5. p2p send the proof that Deltai = ki * Gamma for the ki of Ki to each Pj
+	pki := &round.key.PaillierSK.PublicKey
+	for j, Pj := range Ps {
+		if j == i {
+			continue
+		}
+		start := time.Now()
+		proof, err := paillier.ProveLogStar(round.Rand(), round.SessionID(), ec, pki, round.key.NTildej[j], round.key.H1j[j], round.key.H2j[j],
+			round.temp.K[i], bigDeltai, bigGamma, round.temp.ki, round.temp.rhoi)
+		round.Params().Metrics().RecordProof(tss.ProofPaillierLogStar, tss.ProofGeneration, start)
+		if err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err), Pi)
+		}
+		if err := tss.SendMessage(round.Params(), round.out, NewPreRound3Message2(Pj, Pi, proof)); err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+		}
+	}
+	return nil
+}
+
+// verifyRound2 stores the ciphertexts that Pj broadcast in round 2 and checks the proofs that Pj sent to this party
+func (round *round3) verifyRound2(j int) error {
+	ec := round.Params().EC()
+	i := round.PartyID().Index
+	pki, pkj := &round.key.PaillierSK.PublicKey, round.key.PaillierPKs[j]
+	NTildei, h1i, h2i := round.key.NTildej[i], round.key.H1j[i], round.key.H2j[i]
+
+	r2msg1 := round.temp.preRound2Message1s[j].Content().(*PreRound2Message1)
+	r2msg2 := round.temp.preRound2Message2s[j].Content().(*PreRound2Message2)
+	Ds, Fs, DHats, FHats := r2msg1.UnmarshalD(), r2msg1.UnmarshalF(), r2msg1.UnmarshalDHat(), r2msg1.UnmarshalFHat()
+	if len(Ds) != len(round.temp.D) {
+		return fmt.Errorf("%w: expected %d ciphertexts of each kind", tss.ErrInvalidMessage, len(round.temp.D))
+	}
+	for m := range Ds {
+		round.temp.D[m][j], round.temp.F[m][j], round.temp.DHat[m][j], round.temp.FHat[m][j] = Ds[m], Fs[m], DHats[m], FHats[m]
+	}
+	bigGammaj, err := r2msg1.UnmarshalGamma(ec)
+	if err != nil {
+		return fmt.Errorf("%w: %v", tss.ErrPointNotOnCurve, err)
+	}
+	round.temp.bigGammas[j] = bigGammaj
+
+	affG, err := r2msg2.UnmarshalAffGProof(ec)
+	if err != nil {
+		return tss.NewProofError(tss.ProofPaillierAffG, err)
+	}
+	affGHat, err := r2msg2.UnmarshalAffGHatProof(ec)
+	if err != nil {
+		return tss.NewProofError(tss.ProofPaillierAffG, err)
+	}
+	start := time.Now()
+	ok := affG.Verify(round.SessionID(), ec, pki, pkj, NTildei, h1i, h2i, round.temp.K[i], Ds[i], Fs[i], bigGammaj) &&
+		affGHat.Verify(round.SessionID(), ec, pki, pkj, NTildei, h1i, h2i, round.temp.K[i], DHats[i], FHats[i], round.temp.bigWs[j])
+	round.Params().Metrics().RecordProof(tss.ProofPaillierAffG, tss.ProofVerification, start)
+	if !ok {
+		return tss.NewProofError(tss.ProofPaillierAffG, nil)
+	}
+
+	logStar, err := r2msg2.UnmarshalLogStarProof(ec)
+	if err != nil {
+		return tss.NewProofError(tss.ProofPaillierLogStar, err)
+	}
+	start = time.Now()
+	ok = logStar.Verify(round.SessionID(), ec, pkj, NTildei, h1i, h2i, round.temp.G[j], bigGammaj, nil)
+	round.Params().Metrics().RecordProof(tss.ProofPaillierLogStar, tss.ProofVerification, start)
+	if !ok {
+		return tss.NewProofError(tss.ProofPaillierLogStar, nil)
+	}
+	return nil
+}
+
+// decrypt returns the plaintext of a ciphertext of an affine operation on ki
+func (round *round3) decrypt(c *big.Int) (*big.Int, error) {
+	m, err := round.key.PaillierSK.Decrypt(c)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", tss.ErrInternal, err)
+	}
+	return centered(m, round.key.PaillierSK.N), nil
+}
+
+func (round *round3) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*PreRound3Message1); ok {
+		return msg.IsBroadcast()
+	}
+	if _, ok := msg.Content().(*PreRound3Message2); ok {
+		return !msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round3) Update() (bool, *tss.Error) {
+	// proof checks are in round 4
+	return round.updateWith(round.temp.preRound3Message1s, round.temp.preRound3Message2s, round.CanAccept)
+}
+
+func (round *round3) NextRound() tss.Round {
+	round.started = false
+	return &round4{round}
+}
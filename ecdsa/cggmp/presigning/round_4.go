@@ -0,0 +1,192 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package presigning
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func (round *round4) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	round.number = 4
+	round.started = true
+	round.resetOK()
+
+	ec := round.Params().EC()
+	Ps := round.Parties().IDs()
+	Pi := round.PartyID()
+	i := Pi.Index
+	NTildei, h1i, h2i := round.key.NTildej[i], round.key.H1j[i], round.key.H2j[i]
+
+	// 1. verify the proofs that Deltaj = kj * Gamma
+	{
+		var multiErr error
+		culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+		for j, Pj := range Ps {
+			r3msg1 := round.temp.preRound3Message1s[j].Content().(*PreRound3Message1)
+			round.temp.deltas[j] = r3msg1.UnmarshalDelta()
+			bigDeltaj, err := r3msg1.UnmarshalBigDelta(ec)
+			if err != nil {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("%w: %v", tss.ErrPointNotOnCurve, err))
+				culprits = append(culprits, Pj)
+				continue
+			}
+			round.temp.bigDeltas[j] = bigDeltaj
+			if j == i {
+				continue
+			}
+			proof, err := round.temp.preRound3Message2s[j].Content().(*PreRound3Message2).UnmarshalLogStarProof(ec)
+			if err != nil {
+				multiErr = multierror.Append(multiErr, tss.NewProofError(tss.ProofPaillierLogStar, err))
+				culprits = append(culprits, Pj)
+				continue
+			}
+			start := time.Now()
+			ok := proof.Verify(round.SessionID(), ec, round.key.PaillierPKs[j], NTildei, h1i, h2i, round.temp.K[j], bigDeltaj, round.temp.bigGamma)
+			round.Params().Metrics().RecordProof(tss.ProofPaillierLogStar, tss.ProofVerification, start)
+			if !ok {
+				multiErr = multierror.Append(multiErr, tss.NewProofError(tss.ProofPaillierLogStar, nil))
+				culprits = append(culprits, Pj)
+			}
+		}
+		if len(culprits) > 0 {
+			return round.WrapError(multiErr, culprits...)
+		}
+	}
+
+	// 2. check that delta * G = sum(Deltaj), i.e. that the deltaj are shares of k * gamma
+	modQ := common.ModInt(ec.Params().N)
+	delta := big.NewInt(0)
+	bigDelta := round.temp.bigDeltas[0]
+	for j := range Ps {
+		delta = modQ.Add(delta, round.temp.deltas[j])
+		if j == 0 {
+			continue
+		}
+		var err error
+		if bigDelta, err = bigDelta.Add(round.temp.bigDeltas[j]); err != nil {
+			return round.WrapError(fmt.Errorf("%w: adding Deltaj to Delta", tss.ErrPointNotOnCurve))
+		}
+	}
+	if crypto.ScalarBaseMult(ec, delta).Equals(bigDelta) {
+		// 3. R = delta^-1 * Gamma
+		R := round.temp.bigGamma.ScalarMult(modQ.ModInverse(delta))
+		for j := range round.ok {
+			round.ok[j] = true
+		}
+		preSig := &PreSignatureData{
+			Ks:       round.key.Ks,
+			ECDSAPub: round.key.ECDSAPub,
+			R:        R,
+			Ki:       round.temp.ki,
+			ChiI:     round.temp.chii,
+			K:        round.temp.K,
+			DHat:     round.temp.DHat,
+			FHat:     round.temp.FHat,
+		}
+		round.temp.ki, round.temp.chii = nil, nil
+		round.end <- preSig
+		return nil
+	}
+
+	// 4. otherwise prove that deltai is the plaintext of the ciphertext that every party can compute for it,
+	// BROADCAST Hi = enc_i(ki * gammai) along with the proof that it encrypts the product of the plaintexts of Ki and Gi
+	round.Params().Logger().Warningf("party %s: delta failed to check out; identifying the cheaters", Pi)
+	round.temp.identify = true
+	pki := &round.key.PaillierSK.PublicKey
+	rho := common.GetRandomPositiveRelativelyPrimeIntWithRand(round.Rand(), pki.N)
+	Hi := common.ModInt(pki.NSquare()).ExpMulExp(round.temp.G[i], round.temp.ki, rho, pki.N)
+	start := time.Now()
+	mulProof, err := paillier.ProveMul(round.Rand(), round.SessionID(), ec, pki, round.temp.K[i], round.temp.G[i], Hi, round.temp.ki, rho, round.temp.rhoi)
+	round.Params().Metrics().RecordProof(tss.ProofPaillierMul, tss.ProofGeneration, start)
+	if err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err), Pi)
+	}
+	r4msg1 := NewPreRound4Message1(Pi, Hi, mulProof)
+	round.temp.preRound4Message1s[i] = r4msg1
+	if err := tss.SendMessage(round.Params(), round.out, r4msg1); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
+
+	// 5. p2p send the proof that the ciphertext of deltai decrypts to deltai to each Pj
+	Ci := deltaCiphertext(pki, Hi, round.temp.D[i], round.temp.F, i)
+	if Ci == nil {
+		return round.WrapError(fmt.Errorf("%w: the ciphertext of deltai is not invertible", tss.ErrInternal), Pi)
+	}
+	m, r, err := round.key.PaillierSK.DecryptAndRecoverRandomness(Ci)
+	if err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err), Pi)
+	}
+	for j, Pj := range Ps {
+		if j == i {
+			continue
+		}
+		start = time.Now()
+		decProof, err := paillier.ProveDec(round.Rand(), round.SessionID(), ec, pki, round.key.NTildej[j], round.key.H1j[j], round.key.H2j[j],
+			Ci, round.temp.deltai, centered(m, pki.N), r)
+		round.Params().Metrics().RecordProof(tss.ProofPaillierDec, tss.ProofGeneration, start)
+		if err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err), Pi)
+		}
+		if err := tss.SendMessage(round.Params(), round.out, NewPreRound4Message2(Pj, Pi, decProof)); err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+		}
+	}
+	return nil
+}
+
+// deltaCiphertext returns Hj * prod(Djm) * prod(Fmj)^-1 under the key of Pj, whose plaintext is the share deltaj.
+// It returns nil if one of the Fmj that Pj sent is not invertible.
+func deltaCiphertext(pkj *paillier.PublicKey, Hj *big.Int, Dj []*big.Int, F [][]*big.Int, j int) *big.Int {
+	modNj2 := common.ModInt(pkj.NSquare())
+	C := Hj
+	for m := range Dj {
+		if m == j {
+			continue
+		}
+		FInv := modNj2.ModInverse(F[m][j])
+		if FInv == nil {
+			return nil
+		}
+		C = modNj2.Mul(modNj2.Mul(C, Dj[m]), FInv)
+	}
+	return C
+}
+
+func (round *round4) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*PreRound4Message1); ok {
+		return msg.IsBroadcast()
+	}
+	if _, ok := msg.Content().(*PreRound4Message2); ok {
+		return !msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round4) Update() (bool, *tss.Error) {
+	// proof checks are in round 5
+	return round.updateWith(round.temp.preRound4Message1s, round.temp.preRound4Message2s, round.CanAccept)
+}
+
+func (round *round4) NextRound() tss.Round {
+	if !round.temp.identify {
+		return nil // finished!
+	}
+	round.started = false
+	return &round5{round}
+}
@@ -0,0 +1,90 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package presigning
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func (round *round5) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	round.number = 5
+	round.started = true
+	round.resetOK()
+
+	ec := round.Params().EC()
+	Ps := round.Parties().IDs()
+	i := round.PartyID().Index
+	NTildei, h1i, h2i := round.key.NTildej[i], round.key.H1j[i], round.key.H2j[i]
+
+	// 1. blame the parties whose Hj or deltaj fail to check out against the ciphertexts of presigning
+	var multiErr error
+	culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+	for j, Pj := range Ps {
+		round.ok[j] = true
+		if j == i {
+			continue
+		}
+		pkj := round.key.PaillierPKs[j]
+		r4msg1 := round.temp.preRound4Message1s[j].Content().(*PreRound4Message1)
+		Hj := r4msg1.UnmarshalH()
+		mulProof, err := r4msg1.UnmarshalMulProof()
+		if err != nil {
+			multiErr = multierror.Append(multiErr, tss.NewProofError(tss.ProofPaillierMul, err))
+			culprits = append(culprits, Pj)
+			continue
+		}
+		start := time.Now()
+		ok := mulProof.Verify(round.SessionID(), ec, pkj, round.temp.K[j], round.temp.G[j], Hj)
+		round.Params().Metrics().RecordProof(tss.ProofPaillierMul, tss.ProofVerification, start)
+		if !ok {
+			multiErr = multierror.Append(multiErr, tss.NewProofError(tss.ProofPaillierMul, nil))
+			culprits = append(culprits, Pj)
+			continue
+		}
+		decProof, err := round.temp.preRound4Message2s[j].Content().(*PreRound4Message2).UnmarshalDecProof()
+		if err != nil {
+			multiErr = multierror.Append(multiErr, tss.NewProofError(tss.ProofPaillierDec, err))
+			culprits = append(culprits, Pj)
+			continue
+		}
+		Cj := deltaCiphertext(pkj, Hj, round.temp.D[j], round.temp.F, j)
+		start = time.Now()
+		ok = decProof.Verify(round.SessionID(), ec, pkj, NTildei, h1i, h2i, Cj, round.temp.deltas[j])
+		round.Params().Metrics().RecordProof(tss.ProofPaillierDec, tss.ProofVerification, start)
+		if !ok {
+			multiErr = multierror.Append(multiErr, tss.NewProofError(tss.ProofPaillierDec, nil))
+			culprits = append(culprits, Pj)
+		}
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(multiErr, culprits...)
+	}
+	// every other party proved its share, so none of them can be blamed
+	return round.WrapError(fmt.Errorf("%w: delta failed to check out", tss.ErrInconsistentResult))
+}
+
+func (round *round5) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *round5) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *round5) NextRound() tss.Round {
+	return nil // finished!
+}
@@ -0,0 +1,160 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/ecdsa/cggmp/presigning"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// Implements Party
+// Implements Stringer
+var _ tss.Party = (*LocalParty)(nil)
+var _ fmt.Stringer = (*LocalParty)(nil)
+
+type (
+	LocalParty struct {
+		*tss.BaseParty
+		params *tss.Parameters
+
+		keys keygen.LocalPartySaveData
+		temp localTempData
+		data common.SignatureData
+
+		// outbound messaging
+		out chan<- tss.Message
+		end chan<- common.SignatureData
+	}
+
+	localMessageStore struct {
+		signRound1Messages,
+		signRound2Message1s,
+		signRound2Message2s []tss.ParsedMessage
+	}
+
+	localTempData struct {
+		localMessageStore
+
+		// temp data (thrown away after signing)
+		m, r  *big.Int
+		wi    *big.Int
+		bigWs []*crypto.ECPoint
+
+		preSig   presigning.PreSignatureData // the public part of the presignature
+		ki, chii *big.Int
+		sigmas   []*big.Int
+
+		identify bool // set when the signature fails to verify
+	}
+)
+
+// NewLocalParty returns a party that signs `msg` in a single round with `preSig`, which the same parties made with
+// the cggmp presigning protocol. The secret shares of `preSig` move into the party, which clears them so that the
+// presignature cannot be used again.
+// Should the signature fail to verify, the parties run a further round that identifies the parties that cheated.
+func NewLocalParty(
+	msg *big.Int,
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	preSig *presigning.PreSignatureData,
+	out chan<- tss.Message,
+	end chan<- common.SignatureData,
+) tss.Party {
+	partyCount := len(params.Parties().IDs())
+	p := &LocalParty{
+		BaseParty: tss.NewBaseParty(params),
+		params:    params,
+		keys:      keygen.BuildLocalSaveDataSubset(key, params.Parties().IDs()),
+		temp:      localTempData{},
+		data:      common.SignatureData{},
+		out:       out,
+		end:       end,
+	}
+	// msgs init
+	p.temp.signRound1Messages = make([]tss.ParsedMessage, partyCount)
+	p.temp.signRound2Message1s = make([]tss.ParsedMessage, partyCount)
+	p.temp.signRound2Message2s = make([]tss.ParsedMessage, partyCount)
+	// temp data init
+	p.temp.m = msg
+	p.temp.ki, p.temp.chii = preSig.Ki, preSig.ChiI
+	preSig.Ki, preSig.ChiI = nil, nil
+	p.temp.preSig = *preSig
+	p.temp.sigmas = make([]*big.Int, partyCount)
+	return p
+}
+
+func (p *LocalParty) FirstRound() tss.Round {
+	return newRound1(p.params, &p.keys, &p.data, &p.temp, p.out, p.end)
+}
+
+func (p *LocalParty) Start() *tss.Error {
+	return tss.BaseStart(p, TaskName)
+}
+
+func (p *LocalParty) StartWithContext(ctx context.Context) *tss.Error {
+	return tss.BaseStartWithContext(ctx, p, TaskName)
+}
+
+func (p *LocalParty) Update(msg tss.ParsedMessage) (ok bool, err *tss.Error) {
+	return tss.BaseUpdate(p, msg, TaskName)
+}
+
+func (p *LocalParty) UpdateFromBytes(wireBytes []byte, from *tss.PartyID, isBroadcast bool) (bool, *tss.Error) {
+	msg, err := p.ParseWireMessage(wireBytes, from, isBroadcast)
+	if err != nil {
+		return false, err
+	}
+	return p.Update(msg)
+}
+
+func (p *LocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
+	if ok, err := p.BaseParty.ValidateMessage(msg); !ok || err != nil {
+		return ok, err
+	}
+	// check that the message's "from index" will fit into the array
+	if maxFromIdx := len(p.params.Parties().IDs()) - 1; maxFromIdx < msg.GetFrom().Index {
+		return false, p.WrapError(fmt.Errorf("%w: received msg with a sender index too great (%d <= %d)", tss.ErrInvalidMessage,
+			maxFromIdx, msg.GetFrom().Index), msg.GetFrom())
+	}
+	return true, nil
+}
+
+func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
+	// ValidateBasic is cheap; double-check the message here in case the public StoreMessage was called externally
+	if ok, err := p.ValidateMessage(msg); !ok || err != nil {
+		return ok, err
+	}
+
+	// switch/case is necessary to store any messages beyond current round
+	// a sender may deliver each message only once; identical re-deliveries are ignored. spoofing protection is left to the caller.
+	switch msg.Content().(type) {
+	case *SignRound1Message:
+		return tss.StoreMessageOnce(p, p.temp.signRound1Messages, msg)
+	case *SignRound2Message1:
+		return tss.StoreMessageOnce(p, p.temp.signRound2Message1s, msg)
+	case *SignRound2Message2:
+		return tss.StoreMessageOnce(p, p.temp.signRound2Message2s, msg)
+	default: // unrecognised message, just ignore!
+		p.params.Logger().Warningf("unrecognised message ignored: %s", msg.Type())
+		return false, nil
+	}
+}
+
+func (p *LocalParty) PartyID() *tss.PartyID {
+	return p.params.PartyID()
+}
+
+func (p *LocalParty) String() string {
+	return fmt.Sprintf("id: %s, %s", p.PartyID(), p.BaseParty.String())
+}
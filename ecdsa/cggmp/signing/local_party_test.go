@@ -0,0 +1,236 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+	cggmpkeygen "github.com/bnb-chain/tss-lib/ecdsa/cggmp/keygen"
+	"github.com/bnb-chain/tss-lib/ecdsa/cggmp/presigning"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/test/adversary"
+	"github.com/bnb-chain/tss-lib/test/netsim"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+const (
+	testParticipants = 3
+	testThreshold    = 1
+)
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+}
+
+// loadKeys runs a cggmp keygen and gives its shares the aux info of the keygen fixtures, in place of an auxinfo run
+func loadKeys(t *testing.T) ([]keygen.LocalPartySaveData, tss.SortedPartyIDs) {
+	fixtures, _, err := keygen.LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	pIDs := tss.GenerateTestPartyIDs(testParticipants)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	outCh := make(chan tss.Message, len(pIDs)*len(pIDs))
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+
+	net := netsim.New(1)
+	for _, pID := range pIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(pIDs), testThreshold)
+		assert.NoError(t, net.Register(cggmpkeygen.NewLocalParty(params, outCh, endCh)))
+	}
+	_, err = net.Run(context.Background(), outCh, time.Second)
+	assert.NoError(t, err)
+
+	keys := make([]keygen.LocalPartySaveData, len(pIDs))
+	for range pIDs {
+		key := <-endCh
+		index, err := key.OriginalIndex()
+		assert.NoError(t, err)
+		key.LocalPreParams = fixtures[index].LocalPreParams
+		for j, fixture := range fixtures {
+			key.PaillierPKs[j] = &fixture.PaillierSK.PublicKey
+			key.NTildej[j], key.H1j[j], key.H2j[j] = fixture.NTildei, fixture.H1i, fixture.H2i
+		}
+		keys[index] = key
+	}
+	return keys, pIDs
+}
+
+// presign runs the presigning protocol and returns the presignature of each party
+func presign(t *testing.T, keys []keygen.LocalPartySaveData, pIDs tss.SortedPartyIDs) []*presigning.PreSignatureData {
+	p2pCtx := tss.NewPeerContext(pIDs)
+	outCh := make(chan tss.Message, len(pIDs)*len(pIDs))
+	endChs := make([]chan *presigning.PreSignatureData, len(pIDs))
+
+	net := netsim.New(1)
+	for i, pID := range pIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(pIDs), testThreshold)
+		endChs[i] = make(chan *presigning.PreSignatureData, 1)
+		assert.NoError(t, net.Register(presigning.NewLocalParty(params, keys[i], outCh, endChs[i])))
+	}
+	_, err := net.Run(context.Background(), outCh, time.Second)
+	assert.NoError(t, err)
+
+	preSigs := make([]*presigning.PreSignatureData, len(pIDs))
+	for i, endCh := range endChs {
+		preSigs[i] = <-endCh
+	}
+	return preSigs
+}
+
+// liar is a party that goes on with the value of sigmai that it broadcasts in place of the one it computed,
+// so that it takes part in the identification of the cheaters
+type liar struct {
+	*LocalParty
+	lied bool
+}
+
+func (p *liar) UpdateFromBytes(wireBytes []byte, from *tss.PartyID, isBroadcast bool) (bool, *tss.Error) {
+	msg, err := p.ParseWireMessage(wireBytes, from, isBroadcast)
+	if err != nil {
+		return false, err
+	}
+	return p.Update(msg)
+}
+
+func (p *liar) Update(msg tss.ParsedMessage) (bool, *tss.Error) {
+	return tss.BaseUpdate(p, msg, TaskName)
+}
+
+func (p *liar) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
+	i := p.PartyID().Index
+	if own := p.temp.signRound1Messages[i]; own != nil && !p.lied {
+		sigma := own.Content().(*SignRound1Message).UnmarshalSigma()
+		p.temp.signRound1Messages[i] = NewSignRound1Message(p.PartyID(), new(big.Int).Add(sigma, big.NewInt(1)))
+		p.lied = true
+	}
+	return p.LocalParty.StoreMessage(msg)
+}
+
+func TestE2E(t *testing.T) {
+	setUp("info")
+
+	keys, pIDs := loadKeys(t)
+	preSigs := presign(t, keys, pIDs)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	outCh := make(chan tss.Message, len(pIDs)*len(pIDs))
+	endCh := make(chan common.SignatureData, len(pIDs))
+
+	msg := big.NewInt(42)
+	parties := make([]*LocalParty, 0, len(pIDs))
+	net := netsim.New(1)
+	for i, pID := range pIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(pIDs), testThreshold)
+		P := NewLocalParty(msg, params, keys[i], preSigs[i], outCh, endCh).(*LocalParty)
+		parties = append(parties, P)
+		assert.NoError(t, net.Register(P))
+	}
+	results, err := net.Run(context.Background(), outCh, time.Second)
+	assert.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, netsim.Finished, r.Outcome, r.String())
+	}
+	for _, preSig := range preSigs {
+		assert.Nil(t, preSig.Ki, "the secret shares of the presignature should be cleared")
+		assert.Nil(t, preSig.ChiI, "the secret shares of the presignature should be cleared")
+	}
+
+	assert.Len(t, endCh, len(pIDs))
+	pk := ecdsa.PublicKey{
+		Curve: tss.EC(),
+		X:     keys[0].ECDSAPub.X(),
+		Y:     keys[0].ECDSAPub.Y(),
+	}
+	for _, P := range parties {
+		sig := &P.data
+		assert.Equal(t, msg.Bytes(), sig.M)
+		ok := ecdsa.Verify(&pk, msg.Bytes(), new(big.Int).SetBytes(sig.R), new(big.Int).SetBytes(sig.S))
+		assert.True(t, ok, "ecdsa verify must pass")
+	}
+}
+
+func TestE2ECheater(t *testing.T) {
+	setUp("info")
+
+	keys, pIDs := loadKeys(t)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	endCh := make(chan common.SignatureData, len(pIDs))
+	newParams := func(i int) *tss.Parameters {
+		return tss.NewParameters(tss.S256(), p2pCtx, pIDs[i], len(pIDs), testThreshold)
+	}
+
+	badSigma := adversary.Corruption{MessageType: adversary.MessageType(&SignRound1Message{}), Corrupt: adversary.TamperField("sigma")}
+	for _, tc := range []struct {
+		corruptions []adversary.Corruption
+		cause       error
+	}{
+		{[]adversary.Corruption{badSigma}, &tss.ProofError{Proof: tss.ProofPaillierDec}},
+		{[]adversary.Corruption{badSigma, {MessageType: adversary.MessageType(&SignRound2Message2{}), Corrupt: adversary.TamperField("mul_star_proof")}}, &tss.ProofError{Proof: tss.ProofPaillierMulStar}},
+	} {
+		preSigs := presign(t, keys, pIDs)
+		msg := big.NewInt(42)
+		results, err := adversary.Run(len(pIDs), 0, newParams, func(i int, params *tss.Parameters, out chan<- tss.Message) tss.Party {
+			P := NewLocalParty(msg, params, keys[i], preSigs[i], out, endCh)
+			if i == 0 {
+				return &liar{LocalParty: P.(*LocalParty)}
+			}
+			return P
+		}, tc.corruptions...)
+		assert.NoError(t, err)
+		assert.NoError(t, adversary.CheckBlamed(results, pIDs[0], tc.cause))
+	}
+}
+
+func TestE2ENonInvertibleFHat(t *testing.T) {
+	setUp("info")
+
+	keys, pIDs := loadKeys(t)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	newParams := func(i int) *tss.Parameters {
+		return tss.NewParameters(tss.S256(), p2pCtx, pIDs[i], len(pIDs), testThreshold)
+	}
+	msg := big.NewInt(42)
+	badSigma := adversary.Corruption{MessageType: adversary.MessageType(&SignRound1Message{}), Corrupt: adversary.TamperField("sigma")}
+	run := func(cheater int, preSigs []*presigning.PreSignatureData) []netsim.Result {
+		endCh := make(chan common.SignatureData, len(pIDs))
+		results, err := adversary.Run(len(pIDs), cheater, newParams, func(i int, params *tss.Parameters, out chan<- tss.Message) tss.Party {
+			P := NewLocalParty(msg, params, keys[i], preSigs[i], out, endCh)
+			if i == cheater {
+				return &liar{LocalParty: P.(*LocalParty)}
+			}
+			return P
+		}, badSigma)
+		assert.NoError(t, err)
+		return results
+	}
+
+	// the others hold an FHat of P[0] from presigning that is not invertible, so P[0] is to blame
+	preSigs := presign(t, keys, pIDs)
+	for _, preSig := range preSigs[1:] {
+		preSig.FHat[1][0] = big.NewInt(0)
+	}
+	results := run(0, preSigs)
+	assert.NoError(t, adversary.CheckBlamed(results, pIDs[0], tss.ErrInconsistentResult))
+
+	// P[0] cannot prove its share with an FHat of its own that is not invertible, and no other party is to blame
+	preSigs = presign(t, keys, pIDs)
+	preSigs[0].FHat[1][0] = big.NewInt(0)
+	results = run(1, preSigs)
+	if assert.Equal(t, netsim.Errored, results[0].Outcome, results[0].String()) {
+		assert.True(t, errors.Is(results[0].Err, tss.ErrInternal), results[0].String())
+		assert.Empty(t, results[0].Culprits)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"crypto/elliptic"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// These messages were generated from Protocol Buffers definitions into ecdsa-cggmp-signing.pb.go
+// The following messages are registered on the Protocol Buffers "wire"
+
+var (
+	// Ensure that signing messages implement ValidateBasic
+	_ = []tss.MessageContent{
+		(*SignRound1Message)(nil),
+		(*SignRound2Message1)(nil),
+		(*SignRound2Message2)(nil),
+	}
+)
+
+// ----- //
+
+func NewSignRound1Message(from *tss.PartyID, sigma *big.Int) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &SignRound1Message{
+		Sigma: sigma.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *SignRound1Message) ValidateBasic() bool {
+	return m != nil && common.NonEmptyBytes(m.GetSigma())
+}
+
+func (m *SignRound1Message) UnmarshalSigma() *big.Int {
+	return new(big.Int).SetBytes(m.GetSigma())
+}
+
+// ----- //
+
+func NewSignRound2Message1(from *tss.PartyID, HHat *big.Int) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &SignRound2Message1{
+		HHat: HHat.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *SignRound2Message1) ValidateBasic() bool {
+	return m != nil && common.NonEmptyBytes(m.GetHHat())
+}
+
+func (m *SignRound2Message1) UnmarshalHHat() *big.Int {
+	return new(big.Int).SetBytes(m.GetHHat())
+}
+
+// ----- //
+
+func NewSignRound2Message2(to, from *tss.PartyID, mulStar *paillier.MulStarProof, dec *paillier.DecProof) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	mulStarBz, decBz := mulStar.Bytes(), dec.Bytes()
+	content := &SignRound2Message2{
+		MulStarProof: mulStarBz[:],
+		DecProof:     decBz[:],
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *SignRound2Message2) ValidateBasic() bool {
+	return m != nil &&
+		common.NonEmptyMultiBytes(m.GetMulStarProof(), paillier.MulStarProofBytesParts) &&
+		common.NonEmptyMultiBytes(m.GetDecProof(), paillier.DecProofBytesParts)
+}
+
+func (m *SignRound2Message2) UnmarshalMulStarProof(ec elliptic.Curve) (*paillier.MulStarProof, error) {
+	return paillier.MulStarProofFromBytes(ec, m.GetMulStarProof())
+}
+
+func (m *SignRound2Message2) UnmarshalDecProof() (*paillier.DecProof, error) {
+	return paillier.DecProofFromBytes(m.GetDecProof())
+}
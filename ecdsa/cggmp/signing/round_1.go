@@ -0,0 +1,105 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/ecdsa/signing"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// round 1 represents the single round of the signing part of the CGGMP21 ECDSA TSS spec (Canetti et al.; 2021)
+func newRound1(params *tss.Parameters, key *keygen.LocalPartySaveData, data *common.SignatureData, temp *localTempData, out chan<- tss.Message, end chan<- common.SignatureData) tss.Round {
+	return &round1{
+		&base{params, key, data, temp, out, end, make([]bool, len(params.Parties().IDs())), false, 1}}
+}
+
+func (round *round1) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	ec := round.Params().EC()
+	if round.temp.m.Cmp(ec.Params().N) >= 0 {
+		return round.WrapError(fmt.Errorf("%w: hashed message is not valid", tss.ErrInternal))
+	}
+	if err := round.checkPreSignature(); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
+	round.number = 1
+	round.started = true
+	round.resetOK()
+
+	Pi := round.PartyID()
+	i := Pi.Index
+
+	// the additive share wi of the private key, and the Wj = wj*G of each signer
+	round.temp.wi, round.temp.bigWs = signing.PrepareForSigning(ec, i, len(round.Parties().IDs()), round.key.Xi, round.key.Ks, round.key.BigXj)
+
+	// 1. sigmai = ki * m + r * chii
+	modQ := common.ModInt(ec.Params().N)
+	round.temp.r = new(big.Int).Mod(round.temp.preSig.R.X(), ec.Params().N)
+	sigmai := modQ.Add(modQ.Mul(round.temp.ki, round.temp.m), modQ.Mul(round.temp.r, round.temp.chii))
+	round.temp.ki, round.temp.chii = nil, nil
+
+	// 2. BROADCAST sigmai
+	r1msg := NewSignRound1Message(Pi, sigmai)
+	round.temp.signRound1Messages[i] = r1msg
+	if err := tss.SendMessage(round.Params(), round.out, r1msg); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
+	return nil
+}
+
+// checkPreSignature checks that the presignature was made for this key by the parties of this run
+func (round *round1) checkPreSignature() error {
+	preSig := &round.temp.preSig
+	if preSig.R == nil || preSig.ECDSAPub == nil || round.temp.ki == nil || round.temp.chii == nil {
+		return errors.New("the presignature is incomplete or was already used")
+	}
+	if !preSig.ECDSAPub.Equals(round.key.ECDSAPub) {
+		return errors.New("the presignature was made for another key")
+	}
+	if len(preSig.Ks) != len(round.key.Ks) {
+		return errors.New("the presignature was made by other parties")
+	}
+	for j, kj := range round.key.Ks {
+		if preSig.Ks[j].Cmp(kj) != 0 {
+			return errors.New("the presignature was made by other parties")
+		}
+	}
+	return nil
+}
+
+func (round *round1) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*SignRound1Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round1) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.signRound1Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round1) NextRound() tss.Round {
+	round.started = false
+	return &round2{round}
+}
@@ -0,0 +1,177 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func (round *round2) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	round.number = 2
+	round.started = true
+	round.resetOK()
+
+	ec := round.Params().EC()
+	Ps := round.Parties().IDs()
+	Pi := round.PartyID()
+	i := Pi.Index
+	N := ec.Params().N
+
+	// 1. sigma = sum(sigmaj)
+	modQ := common.ModInt(N)
+	sigma := big.NewInt(0)
+	for j, msg := range round.temp.signRound1Messages {
+		round.temp.sigmas[j] = msg.Content().(*SignRound1Message).UnmarshalSigma()
+		sigma = modQ.Add(sigma, round.temp.sigmas[j])
+	}
+
+	recid := 0
+	// byte v = if(R.X > curve.N) then 2 else 0) | (if R.Y.IsEven then 0 else 1);
+	R := round.temp.preSig.R
+	if R.X().Cmp(N) > 0 {
+		recid = 2
+	}
+	if R.Y().Bit(0) != 0 {
+		recid |= 1
+	}
+	// the signature is normalised to the lower half of the curve order, as in the signing of GG18
+	halfN := new(big.Int).Rsh(N, 1)
+	if sigma.Cmp(halfN) > 0 {
+		sigma.Sub(N, sigma)
+		recid ^= 1
+	}
+
+	pk := ecdsa.PublicKey{
+		Curve: ec,
+		X:     round.key.ECDSAPub.X(),
+		Y:     round.key.ECDSAPub.Y(),
+	}
+	if ecdsa.Verify(&pk, round.temp.m.Bytes(), round.temp.r, sigma) {
+		// 2. save the signature for final output
+		bitSizeInBytes := ec.Params().BitSize / 8
+		round.data.R = padToLengthBytesInPlace(round.temp.r.Bytes(), bitSizeInBytes)
+		round.data.S = padToLengthBytesInPlace(sigma.Bytes(), bitSizeInBytes)
+		round.data.Signature = append(round.data.R, round.data.S...)
+		round.data.SignatureRecovery = []byte{byte(recid)}
+		round.data.M = round.temp.m.Bytes()
+		for j := range round.ok {
+			round.ok[j] = true
+		}
+		round.end <- *round.data
+		return nil
+	}
+
+	// 3. otherwise prove that sigmai is the plaintext of the ciphertext that every party can compute for it,
+	// BROADCAST HHati = enc_i(ki * wi) along with the proof for each Pj that it is Ki times the wi of Wi
+	round.Params().Logger().Warningf("party %s: the signature failed to verify; identifying the cheaters", Pi)
+	round.temp.identify = true
+	pki := &round.key.PaillierSK.PublicKey
+	Ki := round.temp.preSig.K[i]
+	rho := common.GetRandomPositiveRelativelyPrimeIntWithRand(round.Rand(), pki.N)
+	HHati := common.ModInt(pki.NSquare()).ExpMulExp(Ki, round.temp.wi, rho, pki.N)
+	r2msg1 := NewSignRound2Message1(Pi, HHati)
+	round.temp.signRound2Message1s[i] = r2msg1
+	if err := tss.SendMessage(round.Params(), round.out, r2msg1); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
+
+	// 4. p2p send the proofs to each Pj
+	Ci := round.sigmaCiphertext(i, HHati)
+	if Ci == nil {
+		// the FHatli are the ciphertexts that this party broadcast in presigning, so no other party is to blame
+		return round.WrapError(fmt.Errorf("%w: a ciphertext FHat of this party's presignature is not invertible", tss.ErrInternal))
+	}
+	m, r, err := round.key.PaillierSK.DecryptAndRecoverRandomness(Ci)
+	if err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err), Pi)
+	}
+	for j, Pj := range Ps {
+		if j == i {
+			continue
+		}
+		NTildej, h1j, h2j := round.key.NTildej[j], round.key.H1j[j], round.key.H2j[j]
+		start := time.Now()
+		mulStarProof, err := paillier.ProveMulStar(round.Rand(), round.SessionID(), ec, pki, NTildej, h1j, h2j, Ki, HHati, round.temp.bigWs[i], round.temp.wi, rho)
+		round.Params().Metrics().RecordProof(tss.ProofPaillierMulStar, tss.ProofGeneration, start)
+		if err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err), Pi)
+		}
+		start = time.Now()
+		decProof, err := paillier.ProveDec(round.Rand(), round.SessionID(), ec, pki, NTildej, h1j, h2j, Ci, round.temp.sigmas[i], centered(m, pki.N), r)
+		round.Params().Metrics().RecordProof(tss.ProofPaillierDec, tss.ProofGeneration, start)
+		if err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err), Pi)
+		}
+		if err := tss.SendMessage(round.Params(), round.out, NewSignRound2Message2(Pj, Pi, mulStarProof, decProof)); err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+		}
+	}
+	return nil
+}
+
+// sigmaCiphertext returns Kj^m * (HHatj * prod(DHatjl) * prod(FHatlj)^-1)^r under the key of Pj, whose plaintext is the share sigmaj.
+// It returns nil if one of the FHatlj is not invertible; Pj computed and broadcast every FHatlj in presigning, so only Pj is to blame for it.
+func (round *base) sigmaCiphertext(j int, HHatj *big.Int) *big.Int {
+	pkj := round.key.PaillierPKs[j]
+	modNj2 := common.ModInt(pkj.NSquare())
+	preSig := &round.temp.preSig
+	C := HHatj
+	for l := range preSig.DHat[j] {
+		if l == j {
+			continue
+		}
+		FHatInv := modNj2.ModInverse(preSig.FHat[l][j])
+		if FHatInv == nil {
+			return nil
+		}
+		C = modNj2.Mul(modNj2.Mul(C, preSig.DHat[j][l]), FHatInv)
+	}
+	return modNj2.ExpMulExp(preSig.K[j], round.temp.m, C, round.temp.r)
+}
+
+func padToLengthBytesInPlace(src []byte, length int) []byte {
+	oriLen := len(src)
+	if oriLen < length {
+		for i := 0; i < length-oriLen; i++ {
+			src = append([]byte{0}, src...)
+		}
+	}
+	return src
+}
+
+func (round *round2) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*SignRound2Message1); ok {
+		return msg.IsBroadcast()
+	}
+	if _, ok := msg.Content().(*SignRound2Message2); ok {
+		return !msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round2) Update() (bool, *tss.Error) {
+	// proof checks are in round 3
+	return round.updateWith(round.temp.signRound2Message1s, round.temp.signRound2Message2s, round.CanAccept)
+}
+
+func (round *round2) NextRound() tss.Round {
+	if !round.temp.identify {
+		return nil // finished!
+	}
+	round.started = false
+	return &round3{round}
+}
@@ -0,0 +1,96 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func (round *round3) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	round.number = 3
+	round.started = true
+	round.resetOK()
+
+	ec := round.Params().EC()
+	Ps := round.Parties().IDs()
+	i := round.PartyID().Index
+	NTildei, h1i, h2i := round.key.NTildej[i], round.key.H1j[i], round.key.H2j[i]
+
+	// 1. blame the parties whose HHatj or sigmaj fail to check out against the ciphertexts of presigning
+	var multiErr error
+	culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+	for j, Pj := range Ps {
+		round.ok[j] = true
+		if j == i {
+			continue
+		}
+		pkj := round.key.PaillierPKs[j]
+		Kj := round.temp.preSig.K[j]
+		HHatj := round.temp.signRound2Message1s[j].Content().(*SignRound2Message1).UnmarshalHHat()
+		r2msg2 := round.temp.signRound2Message2s[j].Content().(*SignRound2Message2)
+		mulStarProof, err := r2msg2.UnmarshalMulStarProof(ec)
+		if err != nil {
+			multiErr = multierror.Append(multiErr, tss.NewProofError(tss.ProofPaillierMulStar, err))
+			culprits = append(culprits, Pj)
+			continue
+		}
+		start := time.Now()
+		ok := mulStarProof.Verify(round.SessionID(), ec, pkj, NTildei, h1i, h2i, Kj, HHatj, round.temp.bigWs[j])
+		round.Params().Metrics().RecordProof(tss.ProofPaillierMulStar, tss.ProofVerification, start)
+		if !ok {
+			multiErr = multierror.Append(multiErr, tss.NewProofError(tss.ProofPaillierMulStar, nil))
+			culprits = append(culprits, Pj)
+			continue
+		}
+		decProof, err := r2msg2.UnmarshalDecProof()
+		if err != nil {
+			multiErr = multierror.Append(multiErr, tss.NewProofError(tss.ProofPaillierDec, err))
+			culprits = append(culprits, Pj)
+			continue
+		}
+		Cj := round.sigmaCiphertext(j, HHatj)
+		if Cj == nil {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("%w: a ciphertext FHat that the party broadcast in presigning is not invertible", tss.ErrInconsistentResult))
+			culprits = append(culprits, Pj)
+			continue
+		}
+		start = time.Now()
+		ok = decProof.Verify(round.SessionID(), ec, pkj, NTildei, h1i, h2i, Cj, round.temp.sigmas[j])
+		round.Params().Metrics().RecordProof(tss.ProofPaillierDec, tss.ProofVerification, start)
+		if !ok {
+			multiErr = multierror.Append(multiErr, tss.NewProofError(tss.ProofPaillierDec, nil))
+			culprits = append(culprits, Pj)
+		}
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(multiErr, culprits...)
+	}
+	// every other party proved its share, so none of them can be blamed
+	return round.WrapError(fmt.Errorf("%w: signature verification failed", tss.ErrInconsistentResult))
+}
+
+func (round *round3) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *round3) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *round3) NextRound() tss.Round {
+	return nil // finished!
+}
@@ -0,0 +1,174 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Package encoding converts the secp256k1 ECDSA signatures produced by signing into the forms used by
+// Bitcoin, Ethereum and other chains, and recovers the signer's public key from a signature.
+package encoding
+
+import (
+	"crypto/ecdsa"
+	"encoding/asn1"
+	"errors"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	btcecdsa "github.com/btcsuite/btcd/btcec/v2/ecdsa"
+
+	"github.com/bnb-chain/tss-lib/common"
+)
+
+const (
+	// CompactSignatureLen is the length of the R || S form
+	CompactSignatureLen = 64
+	// EthereumSignatureLen is the length of the R || S || V form
+	EthereumSignatureLen = 65
+
+	scalarLen = 32
+)
+
+var (
+	ErrInvalidSignature  = errors.New("the signature data is invalid")
+	ErrHighS             = errors.New("the signature S is not in the lower half of the curve order")
+	ErrInvalidRecovery   = errors.New("the signature has no valid recovery id")
+	ErrPublicKeyMismatch = errors.New("the recovered public key does not match")
+)
+
+// Compact returns the 64-byte R || S form of the signature.
+func Compact(sig *common.SignatureData) ([]byte, error) {
+	r, s, err := components(sig)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, CompactSignatureLen)
+	r.FillBytes(out[:scalarLen])
+	s.FillBytes(out[scalarLen:])
+	return out, nil
+}
+
+// DER returns the ASN.1 DER form of the signature, SEQUENCE { INTEGER r, INTEGER s }.
+func DER(sig *common.SignatureData) ([]byte, error) {
+	r, s, err := components(sig)
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(struct{ R, S *big.Int }{r, s})
+}
+
+// Bitcoin returns the DER form of the signature followed by the sighash type, as pushed in a Bitcoin script.
+// Bitcoin only relays signatures with a low S (BIP-146).
+func Bitcoin(sig *common.SignatureData, hashType byte) ([]byte, error) {
+	_, s, err := components(sig)
+	if err != nil {
+		return nil, err
+	}
+	if s.Cmp(halfN()) > 0 {
+		return nil, ErrHighS
+	}
+	der, err := DER(sig)
+	if err != nil {
+		return nil, err
+	}
+	return append(der, hashType), nil
+}
+
+// EthereumV returns the V value of the signature for the given chain ID, recovery id + chainID*2 + 35 (EIP-155).
+// With a nil chain ID it returns the pre-EIP-155 value, recovery id + 27.
+func EthereumV(sig *common.SignatureData, chainID *big.Int) (*big.Int, error) {
+	recid, err := recoveryID(sig)
+	if err != nil {
+		return nil, err
+	}
+	_, s, err := components(sig)
+	if err != nil {
+		return nil, err
+	}
+	// an Ethereum signature has a low S and no room for the recovery ids 2 and 3
+	if s.Cmp(halfN()) > 0 {
+		return nil, ErrHighS
+	}
+	if recid > 1 {
+		return nil, ErrInvalidRecovery
+	}
+	v := big.NewInt(int64(recid))
+	if chainID == nil {
+		return v.Add(v, big.NewInt(27)), nil
+	}
+	if chainID.Sign() <= 0 {
+		return nil, errors.New("the chain ID must be positive")
+	}
+	v.Add(v, new(big.Int).Lsh(chainID, 1))
+	return v.Add(v, big.NewInt(35)), nil
+}
+
+// Ethereum returns the 65-byte R || S || V form of the signature, with V as given by EthereumV. It fails when V
+// does not fit in a byte, in which case the chain ID must be carried in the transaction with EthereumV.
+func Ethereum(sig *common.SignatureData, chainID *big.Int) ([]byte, error) {
+	v, err := EthereumV(sig, chainID)
+	if err != nil {
+		return nil, err
+	}
+	if !v.IsUint64() || v.Uint64() > 0xff {
+		return nil, errors.New("the V value does not fit in a byte for this chain ID")
+	}
+	compact, err := Compact(sig)
+	if err != nil {
+		return nil, err
+	}
+	return append(compact, byte(v.Uint64())), nil
+}
+
+// RecoverPublicKey recovers the secp256k1 public key that made the signature over sig.M from its recovery id.
+func RecoverPublicKey(sig *common.SignatureData) (*ecdsa.PublicKey, error) {
+	recid, err := recoveryID(sig)
+	if err != nil {
+		return nil, err
+	}
+	compact, err := Compact(sig)
+	if err != nil {
+		return nil, err
+	}
+	// the header byte of a btcec compact signature is 27 + recovery id for an uncompressed key
+	pk, _, err := btcecdsa.RecoverCompact(append([]byte{27 + recid}, compact...), sig.GetM())
+	if err != nil {
+		return nil, err
+	}
+	return pk.ToECDSA(), nil
+}
+
+// CheckPublicKey returns an error unless the public key recovered from the signature is pub.
+func CheckPublicKey(sig *common.SignatureData, pub *ecdsa.PublicKey) error {
+	pk, err := RecoverPublicKey(sig)
+	if err != nil {
+		return err
+	}
+	if pub == nil || pk.X.Cmp(pub.X) != 0 || pk.Y.Cmp(pub.Y) != 0 {
+		return ErrPublicKeyMismatch
+	}
+	return nil
+}
+
+func components(sig *common.SignatureData) (r, s *big.Int, err error) {
+	if sig == nil || len(sig.GetR()) == 0 || len(sig.GetR()) > scalarLen || len(sig.GetS()) == 0 || len(sig.GetS()) > scalarLen {
+		return nil, nil, ErrInvalidSignature
+	}
+	r, s = new(big.Int).SetBytes(sig.GetR()), new(big.Int).SetBytes(sig.GetS())
+	N := btcec.S256().Params().N
+	if r.Sign() == 0 || s.Sign() == 0 || r.Cmp(N) >= 0 || s.Cmp(N) >= 0 {
+		return nil, nil, ErrInvalidSignature
+	}
+	return r, s, nil
+}
+
+func recoveryID(sig *common.SignatureData) (byte, error) {
+	if sig == nil || len(sig.GetSignatureRecovery()) == 0 || sig.GetSignatureRecovery()[0] > 3 {
+		return 0, ErrInvalidRecovery
+	}
+	return sig.GetSignatureRecovery()[0], nil
+}
+
+func halfN() *big.Int {
+	return new(big.Int).Rsh(btcec.S256().Params().N, 1)
+}
@@ -0,0 +1,110 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package encoding
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	btcecdsa "github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+)
+
+// signatureData signs like ecdsa/signing, with a low S and the recovery id in SignatureRecovery
+func signatureData(t *testing.T, priv *btcec.PrivateKey, msg []byte) *common.SignatureData {
+	hash := sha256.Sum256(msg)
+	compact, err := btcecdsa.SignCompact(priv, hash[:], false)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return &common.SignatureData{
+		Signature:         compact[1:],
+		SignatureRecovery: []byte{compact[0] - 27},
+		R:                 compact[1:33],
+		S:                 compact[33:],
+		M:                 new(big.Int).SetBytes(hash[:]).Bytes(),
+	}
+}
+
+func TestEncodings(t *testing.T) {
+	priv, err := btcec.NewPrivateKey()
+	assert.NoError(t, err)
+	for i := 0; i < 16; i++ {
+		sig := signatureData(t, priv, []byte{byte(i)})
+
+		compact, err := Compact(sig)
+		assert.NoError(t, err)
+		assert.Equal(t, sig.Signature, compact)
+
+		der, err := DER(sig)
+		assert.NoError(t, err)
+		parsed, err := btcecdsa.ParseDERSignature(der)
+		if assert.NoError(t, err) {
+			assert.Equal(t, der, parsed.Serialize())
+		}
+
+		btc, err := Bitcoin(sig, 0x01)
+		assert.NoError(t, err)
+		assert.Equal(t, append(der, 0x01), btc)
+
+		eth, err := Ethereum(sig, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, EthereumSignatureLen, len(eth))
+		assert.Equal(t, 27+sig.SignatureRecovery[0], eth[64])
+
+		eth, err = Ethereum(sig, big.NewInt(56))
+		assert.NoError(t, err)
+		assert.Equal(t, 147+sig.SignatureRecovery[0], eth[64])
+
+		_, err = Ethereum(sig, big.NewInt(137))
+		assert.Error(t, err, "V does not fit in a byte")
+		v, err := EthereumV(sig, big.NewInt(137))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(309+int(sig.SignatureRecovery[0])), v.Int64())
+
+		pk, err := RecoverPublicKey(sig)
+		if assert.NoError(t, err) {
+			assert.True(t, pk.Equal(priv.PubKey().ToECDSA()))
+		}
+		assert.NoError(t, CheckPublicKey(sig, priv.PubKey().ToECDSA()))
+	}
+}
+
+func TestBadSignatures(t *testing.T) {
+	priv, err := btcec.NewPrivateKey()
+	assert.NoError(t, err)
+	other, err := btcec.NewPrivateKey()
+	assert.NoError(t, err)
+	sig := signatureData(t, priv, []byte("message"))
+
+	err = CheckPublicKey(sig, other.PubKey().ToECDSA())
+	assert.True(t, errors.Is(err, ErrPublicKeyMismatch))
+
+	flipped := signatureData(t, priv, []byte("message"))
+	flipped.SignatureRecovery = []byte{sig.SignatureRecovery[0] ^ 1}
+	assert.Error(t, CheckPublicKey(flipped, priv.PubKey().ToECDSA()))
+
+	highS := signatureData(t, priv, []byte("message"))
+	highS.S = new(big.Int).Sub(btcec.S256().Params().N, new(big.Int).SetBytes(sig.S)).Bytes()
+	_, err = Bitcoin(highS, 0x01)
+	assert.True(t, errors.Is(err, ErrHighS))
+	_, err = Ethereum(highS, nil)
+	assert.True(t, errors.Is(err, ErrHighS))
+
+	noRecovery := signatureData(t, priv, []byte("message"))
+	noRecovery.SignatureRecovery = nil
+	_, err = RecoverPublicKey(noRecovery)
+	assert.True(t, errors.Is(err, ErrInvalidRecovery))
+
+	_, err = Compact(&common.SignatureData{})
+	assert.True(t, errors.Is(err, ErrInvalidSignature))
+}
@@ -0,0 +1,136 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"bytes"
+	"crypto/elliptic"
+	"fmt"
+
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// VerifyEvidence re-runs the check recorded in evidence raised by a keygen party on curve `ec`, using only the messages it holds.
+// `threshold` is that of the keygen run, which the culprit must have committed to a polynomial of.
+// It returns nil when the culprit failed the check, or an error wrapping tss.ErrInvalidEvidence otherwise.
+// The identity keys of the culprit and the victim in the evidence must be checked against trusted ones by the caller.
+func VerifyEvidence(ec elliptic.Curve, threshold int, ev *tss.Evidence) error {
+	if ev == nil || ev.Task != TaskName {
+		return fmt.Errorf("%w: not evidence of a %s party", tss.ErrInvalidEvidence, TaskName)
+	}
+	msgs, err := ev.ParseMessages()
+	if err != nil {
+		return err
+	}
+	var r1msg, victimR1msg *KGRound1Message
+	var r2msg1 *KGRound2Message1
+	var r2msg2 *KGRound2Message2
+	for _, msg := range msgs {
+		fromCulprit := bytes.Equal(msg.GetFrom().GetKey(), ev.Culprit.GetKey())
+		switch content := msg.Content().(type) {
+		case *KGRound1Message:
+			if fromCulprit {
+				r1msg = content
+			} else {
+				victimR1msg = content
+			}
+		case *KGRound2Message1:
+			// the share and the factor proofs are only checked for the party the culprit signed them for
+			if fromCulprit && ev.IsToVictim(msg) {
+				r2msg1 = content
+			}
+		case *KGRound2Message2:
+			if fromCulprit {
+				r2msg2 = content
+			}
+		}
+	}
+	session := ev.SessionID
+
+	switch ev.Check {
+	case tss.ProofDLN:
+		if r1msg == nil {
+			break
+		}
+		H1j, H2j, NTildej := r1msg.UnmarshalH1(), r1msg.UnmarshalH2(), r1msg.UnmarshalNTilde()
+		dlnProof1, err1 := r1msg.UnmarshalDLNProof1()
+		dlnProof2, err2 := r1msg.UnmarshalDLNProof2()
+		return evidenceResult(err1 != nil || err2 != nil ||
+			!dlnProof1.Verify(session, H1j, H2j, NTildej) ||
+			!dlnProof2.Verify(session, H2j, H1j, NTildej))
+
+	case tss.ProofPaillierMod:
+		if r1msg == nil {
+			break
+		}
+		modProof, err1 := r1msg.UnmarshalModProof()
+		modProofTilde, err2 := r1msg.UnmarshalModProofTilde()
+		if err1 != nil || err2 != nil {
+			return evidenceResult(true)
+		}
+		ok1, err1 := modProof.ModVerify(session, r1msg.UnmarshalPaillierPK().N)
+		ok2, err2 := modProofTilde.ModVerify(session, r1msg.UnmarshalNTilde())
+		return evidenceResult(err1 != nil || err2 != nil || !ok1 || !ok2)
+
+	case tss.CheckDeCommitment:
+		if r1msg == nil || r2msg2 == nil {
+			break
+		}
+		_, err := deCommitPolyG(ec, r1msg, r2msg2)
+		return evidenceResult(err != nil)
+
+	case tss.CheckVSSShare:
+		if r1msg == nil || r2msg1 == nil || r2msg2 == nil {
+			break
+		}
+		// a share can only be checked against commitments that open
+		PjVs, err := deCommitPolyG(ec, r1msg, r2msg2)
+		if err != nil {
+			return fmt.Errorf("%w: the de-commitment does not open", tss.ErrInvalidEvidence)
+		}
+		// commitments to a polynomial of another degree than the threshold fail the check
+		share := vss.Share{Threshold: threshold, ID: ev.Victim.KeyInt(), Share: r2msg1.UnmarshalShare()}
+		return evidenceResult(!share.Verify(ec, threshold, PjVs))
+
+	case tss.ProofPaillierFactor:
+		if r1msg == nil || r2msg1 == nil || victimR1msg == nil {
+			break
+		}
+		// the victim's parameters must be those that the culprit signed its proofs were made for
+		NTildei, H1i, H2i := victimR1msg.UnmarshalNTilde(), victimR1msg.UnmarshalH1(), victimR1msg.UnmarshalH2()
+		if !bytes.Equal(r2msg1.GetVerifierHash(), FactorVerifierHash(NTildei, H1i, H2i)) {
+			return fmt.Errorf("%w: the factor proofs were not made for the victim's parameters", tss.ErrInvalidEvidence)
+		}
+		ok1, err1 := r2msg1.UnmarshalFactorProof().FactorVerify(session, r1msg.UnmarshalPaillierPK().N, NTildei, H1i, H2i)
+		ok2, err2 := r2msg1.UnmarshalFactorProofTilde().FactorVerify(session, r1msg.UnmarshalNTilde(), NTildei, H1i, H2i)
+		return evidenceResult(err1 != nil || err2 != nil || !ok1 || !ok2)
+
+	default:
+		return fmt.Errorf("%w: unknown check %q", tss.ErrInvalidEvidence, ev.Check)
+	}
+	return fmt.Errorf("%w: missing messages for check %q", tss.ErrInvalidEvidence, ev.Check)
+}
+
+// deCommitPolyG opens the commitment to the VSS polynomial of a party
+func deCommitPolyG(ec elliptic.Curve, r1msg *KGRound1Message, r2msg2 *KGRound2Message2) (vss.Vs, error) {
+	cmtDeCmt := commitments.HashCommitDecommit{C: r1msg.UnmarshalCommitment(), D: r2msg2.UnmarshalDeCommitment()}
+	ok, flatPolyGs := cmtDeCmt.DeCommit()
+	if !ok || len(flatPolyGs) == 0 {
+		return nil, tss.ErrBadDeCommitment
+	}
+	return crypto.UnFlattenECPoints(ec, flatPolyGs)
+}
+
+func evidenceResult(failed bool) error {
+	if !failed {
+		return fmt.Errorf("%w: the culprit passed the check", tss.ErrInvalidEvidence)
+	}
+	return nil
+}
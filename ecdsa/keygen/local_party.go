@@ -7,11 +7,11 @@
 package keygen
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
 
-	"github.com/bnb-chain/tss-lib/common"
 	cmt "github.com/bnb-chain/tss-lib/crypto/commitments"
 	"github.com/bnb-chain/tss-lib/crypto/paillier"
 	"github.com/bnb-chain/tss-lib/crypto/vss"
@@ -76,7 +76,7 @@ func NewLocalParty(
 		data.LocalPreParams = optionalPreParams[0]
 	}
 	p := &LocalParty{
-		BaseParty: new(tss.BaseParty),
+		BaseParty: tss.NewBaseParty(params),
 		params:    params,
 		temp:      localTempData{},
 		data:      data,
@@ -101,14 +101,18 @@ func (p *LocalParty) Start() *tss.Error {
 	return tss.BaseStart(p, TaskName)
 }
 
+func (p *LocalParty) StartWithContext(ctx context.Context) *tss.Error {
+	return tss.BaseStartWithContext(ctx, p, TaskName)
+}
+
 func (p *LocalParty) Update(msg tss.ParsedMessage) (ok bool, err *tss.Error) {
 	return tss.BaseUpdate(p, msg, TaskName)
 }
 
 func (p *LocalParty) UpdateFromBytes(wireBytes []byte, from *tss.PartyID, isBroadcast bool) (bool, *tss.Error) {
-	msg, err := tss.ParseWireMessage(wireBytes, from, isBroadcast)
+	msg, err := p.ParseWireMessage(wireBytes, from, isBroadcast)
 	if err != nil {
-		return false, p.WrapError(err)
+		return false, err
 	}
 	return p.Update(msg)
 }
@@ -119,7 +123,7 @@ func (p *LocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	}
 	// check that the message's "from index" will fit into the array
 	if maxFromIdx := p.params.PartyCount() - 1; maxFromIdx < msg.GetFrom().Index {
-		return false, p.WrapError(fmt.Errorf("received msg with a sender index too great (%d <= %d)",
+		return false, p.WrapError(fmt.Errorf("%w: received msg with a sender index too great (%d <= %d)", tss.ErrInvalidMessage,
 			p.params.PartyCount(), msg.GetFrom().Index), msg.GetFrom())
 	}
 	return true, nil
@@ -130,24 +134,22 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	if ok, err := p.ValidateMessage(msg); !ok || err != nil {
 		return ok, err
 	}
-	fromPIdx := msg.GetFrom().Index
 
 	// switch/case is necessary to store any messages beyond current round
-	// this does not handle message replays. we expect the caller to apply replay and spoofing protection.
+	// a sender may deliver each message only once; identical re-deliveries are ignored. spoofing protection is left to the caller.
 	switch msg.Content().(type) {
 	case *KGRound1Message:
-		p.temp.kgRound1Messages[fromPIdx] = msg
+		return tss.StoreMessageOnce(p, p.temp.kgRound1Messages, msg)
 	case *KGRound2Message1:
-		p.temp.kgRound2Message1s[fromPIdx] = msg
+		return tss.StoreMessageOnce(p, p.temp.kgRound2Message1s, msg)
 	case *KGRound2Message2:
-		p.temp.kgRound2Message2s[fromPIdx] = msg
+		return tss.StoreMessageOnce(p, p.temp.kgRound2Message2s, msg)
 	case *KGRound3Message:
-		p.temp.kgRound3Messages[fromPIdx] = msg
+		return tss.StoreMessageOnce(p, p.temp.kgRound3Messages, msg)
 	default: // unrecognised message, just ignore!
-		common.Logger.Warningf("unrecognised message ignored: %v", msg)
+		p.params.Logger().Warningf("unrecognised message ignored: %s", msg.Type())
 		return false, nil
 	}
-	return true, nil
 }
 
 // recovers a party's original index in the set of parties during keygen
@@ -7,9 +7,13 @@
 package keygen
 
 import (
+	"bytes"
+	"context"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rand"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"os"
@@ -19,6 +23,7 @@ import (
 
 	"github.com/ipfs/go-log"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
@@ -26,6 +31,8 @@ import (
 	"github.com/bnb-chain/tss-lib/crypto/paillier"
 	"github.com/bnb-chain/tss-lib/crypto/vss"
 	"github.com/bnb-chain/tss-lib/test"
+	"github.com/bnb-chain/tss-lib/test/adversary"
+	"github.com/bnb-chain/tss-lib/test/netsim"
 	"github.com/bnb-chain/tss-lib/tss"
 )
 
@@ -164,10 +171,353 @@ func TestBadMessageCulprits(t *testing.T) {
 	assert.Equal(t, 1, len(err2.Culprits()))
 	assert.Equal(t, pIDs[1], err2.Culprits()[0])
 	assert.Equal(t,
-		"task ecdsa-keygen, party {0,P[1]}, round 1, culprits [{1,P[2]}]: message failed ValidateBasic: Type: binance.tsslib.ecdsa.keygen.KGRound1Message, From: {1,P[2]}, To: all",
+		"task ecdsa-keygen, party {0,P[1]}, round 1, culprits [{1,P[2]}]: invalid message: message failed ValidateBasic: Type: binance.tsslib.ecdsa.keygen.KGRound1Message, From: {1,P[2]}, To: all",
 		err2.Error())
 }
 
+func TestDuplicateMessageCulprit(t *testing.T) {
+	setUp("debug")
+
+	fixtures, _, err := LoadKeygenTestFixtures(testParticipants)
+	if err != nil {
+		t.Skip("no test fixtures were found; skipping as the safe primes would be generated from scratch")
+	}
+
+	pIDs := tss.GenerateTestPartyIDs(2)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	out := make(chan tss.Message, len(pIDs))
+
+	// two runs of the same party produce different round 1 messages
+	r1Bytes := make([][]byte, 2)
+	for i := range r1Bytes {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pIDs[0], len(pIDs), 1)
+		lp := NewLocalParty(params, out, nil, fixtures[0].LocalPreParams).(*LocalParty)
+		if err := lp.Start(); err != nil {
+			assert.FailNow(t, err.Error())
+		}
+		r1Bytes[i], _, err = (<-out).WireBytes()
+		assert.NoError(t, err)
+	}
+
+	params1 := tss.NewParameters(tss.S256(), p2pCtx, pIDs[1], len(pIDs), 1)
+	lp1 := NewLocalParty(params1, out, nil, fixtures[1].LocalPreParams).(*LocalParty)
+	ok, err2 := lp1.UpdateFromBytes(r1Bytes[0], pIDs[0], true)
+	assert.True(t, ok)
+	assert.Nil(t, err2)
+
+	// an identical re-delivery is ignored
+	ok, err2 = lp1.UpdateFromBytes(r1Bytes[0], pIDs[0], true)
+	assert.True(t, ok)
+	assert.Nil(t, err2)
+
+	// a different message of the same type is rejected and blames the sender
+	ok, err2 = lp1.UpdateFromBytes(r1Bytes[1], pIDs[0], true)
+	assert.False(t, ok)
+	if !assert.NotNil(t, err2) {
+		return
+	}
+	assert.True(t, errors.Is(err2, tss.ErrDuplicateMessage))
+	assert.Equal(t, []*tss.PartyID{pIDs[0]}, err2.Culprits())
+}
+
+// the threshold of the keygen runs with a cheater
+const cheaterThreshold = 1
+
+// runWithCheater runs keygen with party 0 corrupting its messages and checks that every honest party blames it alone, for `cause`,
+// with evidence of the failed `check` that can be verified by anyone. It returns the evidence and the parameters of the parties.
+func runWithCheater(t *testing.T, corruption adversary.Corruption, cause error, check string) ([]*tss.Evidence, []*tss.Parameters) {
+	fixtures, pIDs, err := LoadKeygenTestFixtures(3)
+	if !assert.NoError(t, err, "should load keygen fixtures") {
+		return nil, nil
+	}
+	p2pCtx := tss.NewPeerContext(pIDs)
+	endCh := make(chan LocalPartySaveData, len(pIDs))
+
+	allParams := make([]*tss.Parameters, 0, len(pIDs))
+	newParams := func(i int) *tss.Parameters {
+		pub, key, err := ed25519.GenerateKey(rand.Reader)
+		assert.NoError(t, err)
+		pIDs[i].SetIdentityKey(pub)
+		params := tss.NewParameters(tss.S256(), p2pCtx, pIDs[i], len(pIDs), cheaterThreshold)
+		params.SetIdentityKey(key)
+		allParams = append(allParams, params)
+		return params
+	}
+	results, err := adversary.Run(len(pIDs), 0, newParams, func(i int, params *tss.Parameters, out chan<- tss.Message) tss.Party {
+		return NewLocalParty(params, out, endCh, fixtures[i].LocalPreParams)
+	}, corruption)
+	assert.NoError(t, err)
+	assert.NoError(t, adversary.CheckBlamed(results, pIDs[0], cause))
+	var evidence []*tss.Evidence
+	for _, r := range results {
+		if r.Err == nil || !assert.Len(t, r.Err.Evidence(), 1) {
+			continue
+		}
+		// the evidence is checked by a third party that received it serialized
+		bz, err := json.Marshal(r.Err.Evidence()[0])
+		assert.NoError(t, err)
+		ev := new(tss.Evidence)
+		assert.NoError(t, json.Unmarshal(bz, ev))
+		assert.Equal(t, check, ev.Check)
+		assert.NoError(t, VerifyEvidence(tss.S256(), cheaterThreshold, ev), "the evidence should show that the cheater failed the check")
+		evidence = append(evidence, ev)
+
+		// the checks of point-to-point messages cannot be passed off as run by another victim
+		if check == tss.CheckVSSShare || check == tss.ProofPaillierFactor {
+			relabelled := *ev
+			for _, pID := range pIDs[1:] {
+				if pID.Index != ev.Victim.Index {
+					relabelled.Victim = pID
+				}
+			}
+			assert.ErrorIs(t, VerifyEvidence(tss.S256(), cheaterThreshold, &relabelled), tss.ErrInvalidEvidence, "evidence with another victim should be rejected")
+		}
+
+		altered := *ev
+		altered.Messages = append([][]byte{}, ev.Messages...)
+		last := append([]byte{}, altered.Messages[len(altered.Messages)-1]...)
+		last[len(last)-1] ^= 1
+		altered.Messages[len(altered.Messages)-1] = last
+		assert.ErrorIs(t, VerifyEvidence(tss.S256(), cheaterThreshold, &altered), tss.ErrInvalidEvidence, "altered evidence should be rejected")
+	}
+	return evidence, allParams
+}
+
+func TestCheaterWithForgedDLNProofIsBlamed(t *testing.T) {
+	setUp("info")
+
+	runWithCheater(t, adversary.Corruption{
+		MessageType: adversary.MessageType(&KGRound1Message{}),
+		Corrupt:     adversary.TamperField("dlnproof_1.t"),
+	}, &tss.ProofError{Proof: tss.ProofDLN}, tss.ProofDLN)
+}
+
+func TestCheaterWithForgedModProofIsBlamed(t *testing.T) {
+	setUp("info")
+
+	runWithCheater(t, adversary.Corruption{
+		MessageType: adversary.MessageType(&KGRound1Message{}),
+		Corrupt:     adversary.TamperField("modproof.w"),
+	}, &tss.ProofError{Proof: tss.ProofPaillierMod}, tss.ProofPaillierMod)
+}
+
+func TestCheaterWithForgedFactorProofIsBlamed(t *testing.T) {
+	setUp("info")
+
+	runWithCheater(t, adversary.Corruption{
+		MessageType: adversary.MessageType(&KGRound2Message1{}),
+		Corrupt:     adversary.TamperField("facproof.sigma"),
+	}, &tss.ProofError{Proof: tss.ProofPaillierFactor}, tss.ProofPaillierFactor)
+}
+
+func TestFactorEvidenceWithSwappedVictimParametersIsRejected(t *testing.T) {
+	setUp("info")
+
+	evidence, params := runWithCheater(t, adversary.Corruption{
+		MessageType: adversary.MessageType(&KGRound2Message1{}),
+		Corrupt:     adversary.TamperField("facproof.sigma"),
+	}, &tss.ProofError{Proof: tss.ProofPaillierFactor}, tss.ProofPaillierFactor)
+	fixtures, _, err := LoadKeygenTestFixtures(4)
+	if !assert.NoError(t, err, "should load keygen fixtures") {
+		return
+	}
+	other := fixtures[3].LocalPreParams
+	for _, ev := range evidence {
+		// the victim signs a round 1 message with other parameters than those it sent the culprit
+		victimParams := params[ev.Victim.Index]
+		for i, bz := range ev.Messages {
+			wire := new(tss.MessageWrapper)
+			assert.NoError(t, proto.Unmarshal(bz, wire))
+			if !bytes.Equal(wire.GetFrom().GetKey(), ev.Victim.GetKey()) {
+				continue
+			}
+			content := new(KGRound1Message)
+			assert.NoError(t, wire.GetMessage().UnmarshalTo(content))
+			content.NTilde, content.H1, content.H2 = other.NTildei.Bytes(), other.H1i.Bytes(), other.H2i.Bytes()
+			meta := tss.MessageRouting{From: victimParams.PartyID(), IsBroadcast: true}
+			out := make(chan tss.Message, 1)
+			assert.NoError(t, tss.SendMessage(victimParams, out, tss.NewMessage(meta, content, tss.NewMessageWrapper(meta, content))))
+			ev.Messages[i], err = proto.Marshal((<-out).WireMsg())
+			assert.NoError(t, err)
+		}
+		assert.ErrorIs(t, VerifyEvidence(tss.S256(), cheaterThreshold, ev), tss.ErrInvalidEvidence, "the victim should not choose the parameters the proofs are checked with")
+	}
+}
+
+func TestCheaterWithBadShareIsBlamed(t *testing.T) {
+	setUp("info")
+
+	runWithCheater(t, adversary.Corruption{
+		MessageType: adversary.MessageType(&KGRound2Message1{}),
+		Corrupt:     adversary.TamperField("share"),
+	}, tss.ErrBadShare, tss.CheckVSSShare)
+}
+
+func TestCheaterWithWrongDeCommitmentIsBlamed(t *testing.T) {
+	setUp("info")
+
+	runWithCheater(t, adversary.Corruption{
+		MessageType: adversary.MessageType(&KGRound2Message2{}),
+		Corrupt:     adversary.TamperField("de_commitment"),
+	}, tss.ErrBadDeCommitment, tss.CheckDeCommitment)
+}
+
+func TestE2EEncryptedP2PMessages(t *testing.T) {
+	setUp("info")
+
+	fixtures, pIDs, err := LoadKeygenTestFixtures(3)
+	if !assert.NoError(t, err, "should load keygen fixtures") {
+		return
+	}
+	p2pCtx := tss.NewPeerContext(pIDs)
+	sent := make(chan tss.Message, len(pIDs)*len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs)*len(pIDs))
+	endCh := make(chan LocalPartySaveData, len(pIDs))
+
+	keys := make([]ed25519.PrivateKey, len(pIDs))
+	for i, pID := range pIDs {
+		pub, key, err := ed25519.GenerateKey(rand.Reader)
+		assert.NoError(t, err)
+		pID.SetIdentityKey(pub)
+		keys[i] = key
+	}
+	net := netsim.New(1)
+	parties := make([]*LocalParty, 0, len(pIDs))
+	for i, pID := range pIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(pIDs), 1)
+		params.SetIdentityKey(keys[i])
+		params.SetP2PEncryption(true)
+		P := NewLocalParty(params, sent, endCh, fixtures[i].LocalPreParams).(*LocalParty)
+		parties = append(parties, P)
+		assert.NoError(t, net.Register(P))
+	}
+
+	// the relay sees every message on its way to the network
+	var shares int32
+	go func() {
+		for msg := range sent {
+			if _, ok := msg.(tss.ParsedMessage).Content().(*KGRound2Message1); ok {
+				atomic.AddInt32(&shares, 1)
+				bz, _, err := msg.WireBytes()
+				assert.NoError(t, err)
+				share := msg.WireMsg().GetMessage().GetValue()
+				assert.False(t, bytes.Contains(bz, share), "the share should be sent encrypted")
+
+				// a tampered ciphertext is rejected without blaming the sender, who may not have sent it
+				tampered := append([]byte{}, bz...)
+				tampered[len(tampered)-1] ^= 1
+				_, err2 := parties[msg.GetTo()[0].Index].UpdateFromBytes(tampered, msg.GetFrom(), false)
+				if assert.NotNil(t, err2) {
+					assert.True(t, errors.Is(err2, tss.ErrDecryptionFailed))
+					assert.Empty(t, err2.Culprits())
+				}
+			}
+			outCh <- msg
+		}
+	}()
+	results, err := net.Run(context.Background(), outCh, 0)
+	close(sent)
+	assert.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, netsim.Finished, r.Outcome, r.String())
+	}
+	assert.Equal(t, int32(len(pIDs)*(len(pIDs)-1)), atomic.LoadInt32(&shares))
+
+	// a share is not sent to a party without an identity key
+	params := tss.NewParameters(tss.S256(), p2pCtx, pIDs[0], len(pIDs), 1)
+	params.SetIdentityKey(keys[0])
+	params.SetP2PEncryption(true)
+	to := tss.NewPartyID(pIDs[1].Id, pIDs[1].Moniker, pIDs[1].KeyInt())
+	to.Index = pIDs[1].Index
+	msg := NewKGRound2Message1(to, pIDs[0], &vss.Share{Threshold: 1, ID: big.NewInt(1), Share: big.NewInt(1)}, nil, nil, nil)
+	assert.Error(t, tss.SendMessage(params, outCh, msg))
+	assert.Empty(t, outCh, "a message that cannot be encrypted should not be sent")
+}
+
+func TestE2EResumeFromSnapshot(t *testing.T) {
+	setUp("info")
+
+	fixtures, pIDs, err := LoadKeygenTestFixtures(3)
+	if !assert.NoError(t, err, "should load keygen fixtures") {
+		return
+	}
+	p2pCtx := tss.NewPeerContext(pIDs)
+	parties := make([]*LocalParty, 0, len(pIDs))
+
+	errCh := make(chan *tss.Error, len(pIDs)*len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs)*len(pIDs))
+	endCh := make(chan LocalPartySaveData, len(pIDs))
+
+	newParty := func(i int) *LocalParty {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pIDs[i], len(pIDs), 1)
+		params.SetSessionID([]byte("ecdsa-keygen-resume"))
+		return NewLocalParty(params, outCh, endCh, fixtures[i].LocalPreParams).(*LocalParty)
+	}
+	for i := range pIDs {
+		P := newParty(i)
+		parties = append(parties, P)
+		if err := P.Start(); err != nil {
+			assert.FailNow(t, err.Error())
+		}
+	}
+
+	snapshotKey, otherKey := make([]byte, 32), make([]byte, 32)
+	otherKey[0] = 1
+	resumes := 0
+	deliver := func(P *LocalParty, msg tss.Message) {
+		if P.PartyID().Index == 0 {
+			// restart P[0] from a snapshot before every message it receives
+			snapshot, err := P.Snapshot(snapshotKey)
+			if !assert.Nil(t, err) {
+				return
+			}
+			err = newParty(0).Resume(otherKey, snapshot)
+			assert.True(t, errors.Is(err, tss.ErrInvalidSnapshot))
+			P = newParty(0)
+			if err := P.Resume(snapshotKey, snapshot); !assert.Nil(t, err) {
+				return
+			}
+			parties[0] = P
+			resumes++
+		}
+		test.SharedPartyUpdater(P, msg, errCh)
+	}
+
+	saves := make([]LocalPartySaveData, 0, len(pIDs))
+	for len(saves) < len(pIDs) {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			if dest := msg.GetTo(); dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index != msg.GetFrom().Index {
+						deliver(P, msg)
+					}
+				}
+			} else {
+				deliver(parties[dest[0].Index], msg)
+			}
+		case save := <-endCh:
+			saves = append(saves, save)
+		}
+	}
+	// one broadcast in rounds 1 and 3, and a broadcast and a share in round 2, from each other party
+	assert.Equal(t, (len(pIDs)-1)*4, resumes)
+
+	for _, save := range saves {
+		assert.True(t, save.ECDSAPub.Equals(saves[0].ECDSAPub), "the parties should agree on the public key")
+		index, err := save.OriginalIndex()
+		if assert.NoError(t, err) {
+			assert.True(t, save.BigXj[index].Equals(crypto.ScalarBaseMult(tss.S256(), save.Xi)), "ensure BigX_j == g^x_j")
+		}
+	}
+
+	// a finished party has nothing to snapshot
+	_, err2 := parties[0].Snapshot(snapshotKey)
+	assert.True(t, errors.Is(err2, tss.ErrInvalidSnapshot))
+}
+
 func TestE2EConcurrentAndSaveFixtures(t *testing.T) {
 	setUp("info")
 
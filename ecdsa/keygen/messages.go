@@ -151,6 +151,7 @@ func NewKGRound2Message1(
 	to, from *tss.PartyID,
 	share *vss.Share,
 	proof, proofTilde *paillier.FactorProof,
+	verifierHash []byte,
 ) tss.ParsedMessage {
 	meta := tss.MessageRouting{
 		From:        from,
@@ -199,6 +200,7 @@ func NewKGRound2Message1(
 		Share:         share.Share.Bytes(),
 		Facproof:      facProof,
 		FacproofTilde: facProofTilde,
+		VerifierHash:  verifierHash,
 	}
 	msg := tss.NewMessageWrapper(meta, content)
 	return tss.NewMessage(meta, content, msg)
@@ -208,13 +210,19 @@ func (m *KGRound2Message1) ValidateBasic() bool {
 	return m != nil &&
 		common.NonEmptyBytes(m.GetShare()) &&
 		m.GetFacproof().ValidateBasic() &&
-		m.GetFacproofTilde().ValidateBasic()
+		m.GetFacproofTilde().ValidateBasic() &&
+		common.NonEmptyBytes(m.GetVerifierHash())
 }
 
 func (m *KGRound2Message1) UnmarshalShare() *big.Int {
 	return new(big.Int).SetBytes(m.Share)
 }
 
+// FactorVerifierHash hashes the NTilde, h1 and h2 of the party that factor proofs are made for, binding them to a KGRound2Message1
+func FactorVerifierHash(NTilde, h1, h2 *big.Int) []byte {
+	return common.SHA512_256i(NTilde, h1, h2).Bytes()
+}
+
 func (m *KGRound2Message1) UnmarshalFactorProof() *paillier.FactorProof {
 	proof := m.GetFacproof()
 	return &paillier.FactorProof{
@@ -8,13 +8,16 @@ package keygen
 
 import (
 	"context"
+	"crypto/rand"
 	"errors"
+	"io"
 	"math/big"
 	"runtime"
 	"time"
 
 	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/tss"
 )
 
 const (
@@ -36,11 +39,20 @@ func GeneratePreParams(timeout time.Duration, optionalConcurrency ...int) (*Loca
 	return GeneratePreParamsWithContext(ctx, optionalConcurrency...)
 }
 
-// GeneratePreParams finds two safe primes and computes the Paillier secret required for the protocol.
-// This can be a time consuming process so it is recommended to do it out-of-band.
-// If not specified, a concurrency value equal to the number of available CPU cores will be used.
+// GeneratePreParamsWithContext is GeneratePreParams with a context in place of the timeout.
 // If pre-parameters could not be generated before the context is done, an error is returned.
 func GeneratePreParamsWithContext(ctx context.Context, optionalConcurrency ...int) (*LocalPreParams, error) {
+	return GeneratePreParamsWithRand(ctx, rand.Reader, nil, optionalConcurrency...)
+}
+
+// GeneratePreParamsWithRand is GeneratePreParamsWithContext with the randomness drawn from `rand` and the progress
+// written to `logger`, e.g. the Logger of the parameters of the party that needs the pre-params; a nil logger writes
+// to common.Logger. Safe primes are searched for concurrently, so the result is not reproducible even with a
+// deterministic reader.
+func GeneratePreParamsWithRand(ctx context.Context, rand io.Reader, logger tss.Logger, optionalConcurrency ...int) (*LocalPreParams, error) {
+	if logger == nil {
+		logger = common.Logger
+	}
 	var concurrency int
 	if 0 < len(optionalConcurrency) {
 		if 1 < len(optionalConcurrency) {
@@ -60,29 +72,29 @@ func GeneratePreParamsWithContext(ctx context.Context, optionalConcurrency ...in
 
 	// 4. generate Paillier public key E_i, private key and proof
 	go func(ch chan<- *paillier.PrivateKey) {
-		common.Logger.Info("generating the Paillier modulus, please wait...")
+		logger.Infof("generating the Paillier modulus, please wait...")
 		start := time.Now()
 		// more concurrency weight is assigned here because the paillier primes have a requirement of having "large" P-Q
-		PiPaillierSk, _, err := paillier.GenerateKeyPair(ctx, paillierModulusLen, concurrency*2)
+		PiPaillierSk, _, err := paillier.GenerateKeyPairWithRand(ctx, rand, paillierModulusLen, concurrency*2)
 		if err != nil {
 			ch <- nil
 			return
 		}
-		common.Logger.Infof("paillier modulus generated. took %s\n", time.Since(start))
+		logger.Infof("paillier modulus generated. took %s\n", time.Since(start))
 		ch <- PiPaillierSk
 	}(paiCh)
 
 	// 5-7. generate safe primes for ZKPs used later on
 	go func(ch chan<- []*common.GermainSafePrime) {
 		var err error
-		common.Logger.Info("generating the safe primes for the signing proofs, please wait...")
+		logger.Infof("generating the safe primes for the signing proofs, please wait...")
 		start := time.Now()
-		sgps, err := common.GetRandomSafePrimesConcurrent(ctx, safePrimeBitLen, 2, concurrency)
+		sgps, err := common.GetRandomSafePrimesConcurrentWithRand(ctx, rand, safePrimeBitLen, 2, concurrency)
 		if err != nil {
 			ch <- nil
 			return
 		}
-		common.Logger.Infof("safe primes generated. took %s\n", time.Since(start))
+		logger.Infof("safe primes generated. took %s\n", time.Since(start))
 		ch <- sgps
 	}(sgpCh)
 
@@ -96,7 +108,7 @@ consumer:
 	for {
 		select {
 		case <-logProgressTicker.C:
-			common.Logger.Info("still generating primes...")
+			logger.Infof("still generating primes...")
 		case sgps = <-sgpCh:
 			if sgps == nil ||
 				sgps[0] == nil || sgps[1] == nil ||
@@ -124,8 +136,8 @@ consumer:
 
 	p, q := sgps[0].Prime(), sgps[1].Prime()
 	modPQ := common.ModInt(new(big.Int).Mul(p, q))
-	f1 := common.GetRandomPositiveRelativelyPrimeInt(NTildei)
-	alpha := common.GetRandomPositiveRelativelyPrimeInt(NTildei)
+	f1 := common.GetRandomPositiveRelativelyPrimeIntWithRand(rand, NTildei)
+	alpha := common.GetRandomPositiveRelativelyPrimeIntWithRand(rand, NTildei)
 	beta := modPQ.ModInverse(alpha)
 	h1i := modNTildeI.Mul(f1, f1)
 	h2i := modNTildeI.Exp(h1i, alpha)
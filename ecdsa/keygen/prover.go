@@ -0,0 +1,64 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"io"
+	"math/big"
+	"time"
+
+	"github.com/bnb-chain/tss-lib/crypto/dlnproof"
+	"github.com/bnb-chain/tss-lib/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// PreParamsProofs show that the ring-Pedersen parameters of a party are well formed and that its Paillier modulus and NTildei
+// are Paillier-Blum moduli
+type PreParamsProofs struct {
+	DLNProof1, DLNProof2    *dlnproof.Proof
+	ModProof, ModProofTilde *paillier.ModProof
+}
+
+// NTildeKey returns NTildei as a Paillier private key, so that its factorisation can be proved with the Paillier proofs
+func (preParams LocalPreParams) NTildeKey() *paillier.PrivateKey {
+	// NTildei = (2p+1) * (2q+1)
+	// phi(NTildei) = ((2p+1) - 1) * ((2q+1) - 1) = 2p * 2q
+	pp := new(big.Int).Add(preParams.P, preParams.P)
+	qq := new(big.Int).Add(preParams.Q, preParams.Q)
+	phiNTilde := new(big.Int).Mul(pp, qq)
+	// As per paillier.go
+	gcdTilde := new(big.Int).GCD(nil, nil, pp, qq)
+	lambdaNTilde := new(big.Int).Div(phiNTilde, gcdTilde)
+	return &paillier.PrivateKey{PublicKey: paillier.PublicKey{N: preParams.NTildei}, LambdaN: lambdaNTilde, PhiN: phiNTilde}
+}
+
+// Prove makes the PreParamsProofs of these pre-params bound to `session`, and records their timings in `metrics`
+func (preParams LocalPreParams) Prove(rand io.Reader, session []byte, metrics *tss.Metrics) *PreParamsProofs {
+	h1i, h2i, alpha, beta, p, q, NTildei :=
+		preParams.H1i,
+		preParams.H2i,
+		preParams.Alpha,
+		preParams.Beta,
+		preParams.P,
+		preParams.Q,
+		preParams.NTildei
+	proofs := new(PreParamsProofs)
+	start := time.Now()
+	proofs.DLNProof1 = dlnproof.NewDLNProofWithRand(rand, session, h1i, h2i, alpha, p, q, NTildei)
+	metrics.RecordProof(tss.ProofDLN, tss.ProofGeneration, start)
+	start = time.Now()
+	proofs.DLNProof2 = dlnproof.NewDLNProofWithRand(rand, session, h2i, h1i, beta, p, q, NTildei)
+	metrics.RecordProof(tss.ProofDLN, tss.ProofGeneration, start)
+
+	start = time.Now()
+	proofs.ModProof = preParams.PaillierSK.ModProofWithRand(rand, session)
+	metrics.RecordProof(tss.ProofPaillierMod, tss.ProofGeneration, start)
+	start = time.Now()
+	proofs.ModProofTilde = preParams.NTildeKey().ModProofWithRand(rand, session)
+	metrics.RecordProof(tss.ProofPaillierMod, tss.ProofGeneration, start)
+	return proofs
+}
@@ -7,14 +7,13 @@
 package keygen
 
 import (
-	"errors"
+	"context"
+	"fmt"
 	"math/big"
 
 	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
 	cmts "github.com/bnb-chain/tss-lib/crypto/commitments"
-	"github.com/bnb-chain/tss-lib/crypto/dlnproof"
-	"github.com/bnb-chain/tss-lib/crypto/paillier"
 	"github.com/bnb-chain/tss-lib/crypto/vss"
 	"github.com/bnb-chain/tss-lib/tss"
 )
@@ -31,7 +30,7 @@ func newRound1(params *tss.Parameters, save *LocalPartySaveData, temp *localTemp
 
 func (round *round1) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 	round.number = 1
 	round.started = true
@@ -41,15 +40,15 @@ func (round *round1) Start() *tss.Error {
 	i := Pi.Index
 
 	// 1. calculate "partial" key share ui
-	ui := common.GetRandomPositiveInt(round.Params().EC().Params().N)
+	ui := common.GetRandomPositiveIntWithRand(round.Rand(), round.Params().EC().Params().N)
 
 	round.temp.ui = ui
 
 	// 2. compute the vss shares
 	ids := round.Parties().IDs().Keys()
-	vs, shares, err := vss.Create(round.Params().EC(), round.Threshold(), ui, ids)
+	vs, shares, err := vss.CreateWithRand(round.Rand(), round.Params().EC(), round.Threshold(), ui, ids)
 	if err != nil {
-		return round.WrapError(err, Pi)
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err), Pi)
 	}
 	round.save.Ks = ids
 
@@ -60,9 +59,9 @@ func (round *round1) Start() *tss.Error {
 	// make commitment -> (C, D)
 	pGFlat, err := crypto.FlattenECPoints(vs)
 	if err != nil {
-		return round.WrapError(err, Pi)
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err), Pi)
 	}
-	cmt := cmts.NewHashCommitment(pGFlat...)
+	cmt := cmts.NewHashCommitmentWithRand(round.Rand(), pGFlat...)
 
 	// 4. generate Paillier public key E_i, private key and proof
 	// 5-7. generate safe primes for ZKPs used later on
@@ -71,45 +70,23 @@ func (round *round1) Start() *tss.Error {
 	var preParams *LocalPreParams
 	if round.save.LocalPreParams.Validate() && !round.save.LocalPreParams.ValidateWithProof() {
 		return round.WrapError(
-			errors.New("`optionalPreParams` failed to validate; it might have been generated with an older version of tss-lib"))
+			fmt.Errorf("%w: `optionalPreParams` failed to validate; it might have been generated with an older version of tss-lib", tss.ErrInternal))
 	} else if round.save.LocalPreParams.ValidateWithProof() {
 		preParams = &round.save.LocalPreParams
 	} else {
-		preParams, err = GeneratePreParams(round.SafePrimeGenTimeout(), round.Concurrency())
+		ctx, cancel := context.WithTimeout(round.Context(), round.SafePrimeGenTimeout())
+		defer cancel()
+		preParams, err = GeneratePreParamsWithRand(ctx, round.Rand(), round.Params().Logger(), round.Concurrency())
 		if err != nil {
-			return round.WrapError(errors.New("pre-params generation failed"), Pi)
+			return round.WrapError(fmt.Errorf("%w: pre-params generation failed", tss.ErrInternal), Pi)
 		}
 	}
 	round.save.LocalPreParams = *preParams
 	round.save.NTildej[i] = preParams.NTildei
 	round.save.H1j[i], round.save.H2j[i] = preParams.H1i, preParams.H2i
 
-	// generate the dlnproofs for keygen
-	h1i, h2i, alpha, beta, p, q, NTildei :=
-		preParams.H1i,
-		preParams.H2i,
-		preParams.Alpha,
-		preParams.Beta,
-		preParams.P,
-		preParams.Q,
-		preParams.NTildei
-	dlnProof1 := dlnproof.NewDLNProof(h1i, h2i, alpha, p, q, NTildei)
-	dlnProof2 := dlnproof.NewDLNProof(h2i, h1i, beta, p, q, NTildei)
-
-	modProof := preParams.PaillierSK.ModProof()
-
-	// NTildei = (2p+1) * (2q+1)
-	// phi(NTildei) = ((2p+1) - 1) * ((2q+1) - 1) = 2p * 2q
-	pp := new(big.Int).Add(p, p)
-	qq := new(big.Int).Add(q, q)
-	phiNTilde := new(big.Int).Mul(pp, qq)
-	// As per paillier.go
-	gcdTilde := new(big.Int).GCD(nil, nil, pp, qq)
-	lambdaNTilde := new(big.Int).Div(phiNTilde, gcdTilde)
-	pkTilde := &paillier.PublicKey{N: NTildei}
-	skTilde := &paillier.PrivateKey{PublicKey: *pkTilde, LambdaN: lambdaNTilde, PhiN: phiNTilde}
-
-	modProofTilde := skTilde.ModProof()
+	// generate the dlnproofs and modproofs for keygen
+	proofs := preParams.Prove(round.Rand(), round.SessionID(), round.Params().Metrics())
 
 	// for this P: SAVE
 	// - shareID
@@ -125,7 +102,7 @@ func (round *round1) Start() *tss.Error {
 	round.save.PaillierPKs[i] = &preParams.PaillierSK.PublicKey
 	round.temp.deCommitPolyG = cmt.D
 
-	round.temp.skTilde = skTilde
+	round.temp.skTilde = preParams.NTildeKey()
 
 	// BROADCAST commitments, paillier pk + proof; round 1 message
 	{
@@ -136,16 +113,18 @@ func (round *round1) Start() *tss.Error {
 			preParams.NTildei,
 			preParams.H1i,
 			preParams.H2i,
-			dlnProof1,
-			dlnProof2,
-			modProof,
-			modProofTilde,
+			proofs.DLNProof1,
+			proofs.DLNProof2,
+			proofs.ModProof,
+			proofs.ModProofTilde,
 		)
 		if err != nil {
-			return round.WrapError(err, Pi)
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err), Pi)
 		}
 		round.temp.kgRound1Messages[i] = msg
-		round.out <- msg
+		if err := tss.SendMessage(round.Params(), round.out, msg); err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+		}
 	}
 	return nil
 }
@@ -8,10 +8,10 @@ package keygen
 
 import (
 	"encoding/hex"
-	"errors"
+	"fmt"
 	"sync"
+	"time"
 
-	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/tss"
 )
 
@@ -21,18 +21,19 @@ const (
 
 func (round *round2) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 	round.number = 2
 	round.started = true
 	round.resetOK()
 
-	common.Logger.Debugf(
+	round.Params().Logger().Debugf(
 		"%s Setting up DLN verification with concurrency level of %d",
 		round.PartyID(),
 		round.Concurrency(),
 	)
-	verifier := NewProofVerifier(round.Concurrency())
+	verifier := NewProofVerifierWithContext(round.Context(), round.Concurrency())
+	verifier.SetMetrics(round.Params().Metrics())
 
 	i := round.PartyID().Index
 
@@ -51,20 +52,20 @@ func (round *round2) Start() *tss.Error {
 			r1msg.UnmarshalNTilde(),
 			r1msg.UnmarshalPaillierPK()
 		if paillierPKj.N.BitLen() != paillierBitsLen {
-			return round.WrapError(errors.New("got paillier modulus with insufficient bits for this party"), msg.GetFrom())
+			return round.WrapError(fmt.Errorf("%w: got paillier modulus with insufficient bits for this party", tss.ErrInvalidMessage), msg.GetFrom())
 		}
 		if H1j.Cmp(H2j) == 0 {
-			return round.WrapError(errors.New("h1j and h2j were equal for this party"), msg.GetFrom())
+			return round.WrapError(fmt.Errorf("%w: h1j and h2j were equal for this party", tss.ErrInvalidMessage), msg.GetFrom())
 		}
 		if NTildej.BitLen() != paillierBitsLen {
-			return round.WrapError(errors.New("got NTildej with insufficient bits for this party"), msg.GetFrom())
+			return round.WrapError(fmt.Errorf("%w: got NTildej with insufficient bits for this party", tss.ErrInvalidMessage), msg.GetFrom())
 		}
 		h1JHex, h2JHex := hex.EncodeToString(H1j.Bytes()), hex.EncodeToString(H2j.Bytes())
 		if _, found := h1H2Map[h1JHex]; found {
-			return round.WrapError(errors.New("this h1j was already used by another party"), msg.GetFrom())
+			return round.WrapError(fmt.Errorf("%w: this h1j was already used by another party", tss.ErrInvalidMessage), msg.GetFrom())
 		}
 		if _, found := h1H2Map[h2JHex]; found {
-			return round.WrapError(errors.New("this h2j was already used by another party"), msg.GetFrom())
+			return round.WrapError(fmt.Errorf("%w: this h2j was already used by another party", tss.ErrInvalidMessage), msg.GetFrom())
 		}
 		h1H2Map[h1JHex], h1H2Map[h2JHex] = struct{}{}, struct{}{}
 
@@ -72,25 +73,25 @@ func (round *round2) Start() *tss.Error {
 		_j := j
 		_msg := msg
 
-		verifier.VerifyDLNProof1(r1msg, H1j, H2j, NTildej, func(isValid bool) {
+		verifier.VerifyDLNProof1(round.SessionID(), r1msg, H1j, H2j, NTildej, func(isValid bool) {
 			if !isValid {
 				dlnProof1FailCulprits[_j] = _msg.GetFrom()
 			}
 			wg.Done()
 		})
-		verifier.VerifyDLNProof2(r1msg, H2j, H1j, NTildej, func(isValid bool) {
+		verifier.VerifyDLNProof2(round.SessionID(), r1msg, H2j, H1j, NTildej, func(isValid bool) {
 			if !isValid {
 				dlnProof2FailCulprits[_j] = _msg.GetFrom()
 			}
 			wg.Done()
 		})
-		verifier.VerifyModProof(r1msg, paillierPKj.N, func(isValid bool) {
+		verifier.VerifyModProof(round.SessionID(), r1msg, paillierPKj.N, func(isValid bool) {
 			if !isValid {
 				modProofFailCulprits[_j] = _msg.GetFrom()
 			}
 			wg.Done()
 		})
-		verifier.VerifyModProofTilde(r1msg, NTildej, func(isValid bool) {
+		verifier.VerifyModProofTilde(round.SessionID(), r1msg, NTildej, func(isValid bool) {
 			if !isValid {
 				modProofTildeFailCulprits[_j] = _msg.GetFrom()
 			}
@@ -98,14 +99,20 @@ func (round *round2) Start() *tss.Error {
 		})
 	}
 	wg.Wait()
+	// abandoned verifications are not the provers' fault
+	if err := round.Context().Err(); err != nil {
+		return round.WrapError(err)
+	}
 	for _, culprit := range append(dlnProof1FailCulprits, dlnProof2FailCulprits...) {
 		if culprit != nil {
-			return round.WrapError(errors.New("dln proof verification failed"), culprit)
+			return round.WrapError(tss.NewProofError(tss.ProofDLN, nil), culprit).
+				WithEvidence(round.evidence(tss.ProofDLN, culprit, round.temp.kgRound1Messages[culprit.Index]))
 		}
 	}
 	for _, culprit := range append(modProofFailCulprits, modProofTildeFailCulprits...) {
 		if culprit != nil {
-			return round.WrapError(errors.New("mod proof verification failed"), culprit)
+			return round.WrapError(tss.NewProofError(tss.ProofPaillierMod, nil), culprit).
+				WithEvidence(round.evidence(tss.ProofPaillierMod, culprit, round.temp.kgRound1Messages[culprit.Index]))
 		}
 	}
 	// save NTilde_j, h1_j, h2_j, ...
@@ -131,21 +138,29 @@ func (round *round2) Start() *tss.Error {
 	for j, Pj := range round.Parties().IDs() {
 		// do not send to this Pj, but store for round 3
 		if j == i {
-			round.temp.kgRound2Message1s[j] = NewKGRound2Message1(Pj, round.PartyID(), shares[j], nil, nil)
+			round.temp.kgRound2Message1s[j] = NewKGRound2Message1(Pj, round.PartyID(), shares[j], nil, nil, nil)
 			continue
 		}
 		H1j, H2j, NTildej := round.save.H1j[j], round.save.H2j[j], round.save.NTildej[j]
-		facProof := round.save.LocalPreParams.PaillierSK.FactorProof(NTildej, H1j, H2j)
-		facProofTilde := round.temp.skTilde.FactorProof(NTildej, H1j, H2j)
-
-		r2msg1 := NewKGRound2Message1(Pj, round.PartyID(), shares[j], facProof, facProofTilde)
-		round.out <- r2msg1
+		start := time.Now()
+		facProof := round.save.LocalPreParams.PaillierSK.FactorProofWithRand(round.Rand(), round.SessionID(), NTildej, H1j, H2j)
+		round.Params().Metrics().RecordProof(tss.ProofPaillierFactor, tss.ProofGeneration, start)
+		start = time.Now()
+		facProofTilde := round.temp.skTilde.FactorProofWithRand(round.Rand(), round.SessionID(), NTildej, H1j, H2j)
+		round.Params().Metrics().RecordProof(tss.ProofPaillierFactor, tss.ProofGeneration, start)
+
+		r2msg1 := NewKGRound2Message1(Pj, round.PartyID(), shares[j], facProof, facProofTilde, FactorVerifierHash(NTildej, H1j, H2j))
+		if err := tss.SendMessage(round.Params(), round.out, r2msg1); err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+		}
 	}
 
 	// 7. BROADCAST de-commitments of Shamir poly*G
 	r2msg2 := NewKGRound2Message2(round.PartyID(), round.temp.deCommitPolyG)
 	round.temp.kgRound2Message2s[i] = r2msg2
-	round.out <- r2msg2
+	if err := tss.SendMessage(round.Params(), round.out, r2msg2); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
 
 	return nil
 }
@@ -7,11 +7,13 @@
 package keygen
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
-	errors2 "github.com/pkg/errors"
 
 	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
@@ -22,7 +24,7 @@ import (
 
 func (round *round3) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 	round.number = 3
 	round.started = true
@@ -53,6 +55,7 @@ func (round *round3) Start() *tss.Error {
 	type vssOut struct {
 		unWrappedErr error
 		pjVs         vss.Vs
+		evidence     *tss.Evidence
 	}
 	chs := make([]chan vssOut, len(Ps))
 	for i := range chs {
@@ -67,53 +70,61 @@ func (round *round3) Start() *tss.Error {
 		}
 		// 6-8.
 		go func(j int, ch chan<- vssOut) {
+			Pj := Ps[j]
+			r1msgj, r2msg1j, r2msg2j := round.temp.kgRound1Messages[j], round.temp.kgRound2Message1s[j], round.temp.kgRound2Message2s[j]
 			// 4-9.
 			KGCj := round.temp.KGCs[j]
-			r2msg2 := round.temp.kgRound2Message2s[j].Content().(*KGRound2Message2)
+			r2msg2 := r2msg2j.Content().(*KGRound2Message2)
 			KGDj := r2msg2.UnmarshalDeCommitment()
 			cmtDeCmt := commitments.HashCommitDecommit{C: KGCj, D: KGDj}
 			ok, flatPolyGs := cmtDeCmt.DeCommit()
 			if !ok || flatPolyGs == nil {
-				ch <- vssOut{errors.New("de-commitment verify failed"), nil}
+				ch <- vssOut{tss.ErrBadDeCommitment, nil, round.evidence(tss.CheckDeCommitment, Pj, r1msgj, r2msg2j)}
 				return
 			}
 			PjVs, err := crypto.UnFlattenECPoints(round.Params().EC(), flatPolyGs)
 			if err != nil {
-				ch <- vssOut{err, nil}
+				ch <- vssOut{fmt.Errorf("%w: %v", tss.ErrPointNotOnCurve, err), nil, round.evidence(tss.CheckDeCommitment, Pj, r1msgj, r2msg2j)}
 				return
 			}
-			r2msg1 := round.temp.kgRound2Message1s[j].Content().(*KGRound2Message1)
+			r2msg1 := r2msg1j.Content().(*KGRound2Message1)
 			PjShare := vss.Share{
 				Threshold: round.Threshold(),
 				ID:        round.PartyID().KeyInt(),
 				Share:     r2msg1.UnmarshalShare(),
 			}
 			if ok = PjShare.Verify(round.Params().EC(), round.Threshold(), PjVs); !ok {
-				ch <- vssOut{errors.New("vss verify failed"), nil}
+				ch <- vssOut{tss.ErrBadShare, nil, round.evidence(tss.CheckVSSShare, Pj, r1msgj, r2msg1j, r2msg2j)}
 				return
 			}
 			FacProof := r2msg1.UnmarshalFactorProof()
 			pkN := round.save.PaillierPKs[j].N
 			NTilde := round.save.LocalPreParams.NTildei
 			H1i, H2i := round.save.LocalPreParams.H1i, round.save.LocalPreParams.H2i
-			ok, err = FacProof.FactorVerify(pkN, NTilde, H1i, H2i)
-			if err != nil {
-				ch <- vssOut{err, nil}
+			// proofs made for other parameters fail, but a third party cannot tell which parameters this party sent Pj,
+			// so there is no evidence
+			if !bytes.Equal(r2msg1.GetVerifierHash(), FactorVerifierHash(NTilde, H1i, H2i)) {
+				ch <- vssOut{tss.NewProofError(tss.ProofPaillierFactor, errors.New("the factor proofs were made for the parameters of another party")), nil, nil}
+				return
 			}
-			if !ok {
-				ch <- vssOut{errors.New("factor proof verify failed"), nil}
+			start := time.Now()
+			ok, err = FacProof.FactorVerify(round.SessionID(), pkN, NTilde, H1i, H2i)
+			round.Params().Metrics().RecordProof(tss.ProofPaillierFactor, tss.ProofVerification, start)
+			if err != nil || !ok {
+				ch <- vssOut{tss.NewProofError(tss.ProofPaillierFactor, err), nil, round.evidence(tss.ProofPaillierFactor, Pj, r1msgj, r2msg1j, round.temp.kgRound1Messages[PIdx])}
+				return
 			}
 			FacProofTilde := r2msg1.UnmarshalFactorProofTilde()
 			NTildej := round.save.NTildej[j]
-			ok, err = FacProofTilde.FactorVerify(NTildej, NTilde, H1i, H2i)
-			if err != nil {
-				ch <- vssOut{err, nil}
-			}
-			if !ok {
-				ch <- vssOut{errors.New("factor proof verify failed"), nil}
+			start = time.Now()
+			ok, err = FacProofTilde.FactorVerify(round.SessionID(), NTildej, NTilde, H1i, H2i)
+			round.Params().Metrics().RecordProof(tss.ProofPaillierFactor, tss.ProofVerification, start)
+			if err != nil || !ok {
+				ch <- vssOut{tss.NewProofError(tss.ProofPaillierFactor, err), nil, round.evidence(tss.ProofPaillierFactor, Pj, r1msgj, r2msg1j, round.temp.kgRound1Messages[PIdx])}
+				return
 			}
 			// (9) handled above
-			ch <- vssOut{nil, PjVs}
+			ch <- vssOut{nil, PjVs, nil}
 		}(j, chs[j])
 	}
 
@@ -133,13 +144,15 @@ func (round *round3) Start() *tss.Error {
 		}
 		var multiErr error
 		if len(culprits) > 0 {
+			evidence := make([]*tss.Evidence, 0, len(culprits))
 			for _, vssResult := range vssResults {
 				if vssResult.unWrappedErr == nil {
 					continue
 				}
 				multiErr = multierror.Append(multiErr, vssResult.unWrappedErr)
+				evidence = append(evidence, vssResult.evidence)
 			}
-			return round.WrapError(multiErr, culprits...)
+			return round.WrapError(multiErr, culprits...).WithEvidence(evidence...)
 		}
 	}
 	{
@@ -159,7 +172,7 @@ func (round *round3) Start() *tss.Error {
 			}
 		}
 		if len(culprits) > 0 {
-			return round.WrapError(errors.New("adding PjVs[c] to Vc[c] resulted in a point not on the curve"), culprits...)
+			return round.WrapError(fmt.Errorf("%w: adding PjVs[c] to Vc[c]", tss.ErrPointNotOnCurve), culprits...)
 		}
 	}
 
@@ -184,7 +197,7 @@ func (round *round3) Start() *tss.Error {
 			bigXj[j] = BigXj
 		}
 		if len(culprits) > 0 {
-			return round.WrapError(errors.New("adding Vc[c].ScalarMult(z) to BigXj resulted in a point not on the curve"), culprits...)
+			return round.WrapError(fmt.Errorf("%w: adding Vc[c].ScalarMult(z) to BigXj", tss.ErrPointNotOnCurve), culprits...)
 		}
 		round.save.BigXj = bigXj
 	}
@@ -192,19 +205,23 @@ func (round *round3) Start() *tss.Error {
 	// 17. compute and SAVE the ECDSA public key `y`
 	ecdsaPubKey, err := crypto.NewECPoint(round.Params().EC(), Vc[0].X(), Vc[0].Y())
 	if err != nil {
-		return round.WrapError(errors2.Wrapf(err, "public key is not on the curve"))
+		return round.WrapError(fmt.Errorf("%w: public key: %v", tss.ErrPointNotOnCurve, err))
 	}
 	round.save.ECDSAPub = ecdsaPubKey
 
 	// PRINT public key & private share
-	common.Logger.Debugf("%s public key: %x", round.PartyID(), ecdsaPubKey)
+	round.Params().Logger().Debugf("%s public key: %x", round.PartyID(), ecdsaPubKey)
 
 	// BROADCAST paillier proof for Pi
 	ki := round.PartyID().KeyInt()
-	proof := round.save.PaillierSK.Proof(ki, ecdsaPubKey)
+	start := time.Now()
+	proof := round.save.PaillierSK.Proof(round.SessionID(), ki, ecdsaPubKey)
+	round.Params().Metrics().RecordProof(tss.ProofPaillierKey, tss.ProofGeneration, start)
 	r3msg := NewKGRound3Message(round.PartyID(), proof)
 	round.temp.kgRound3Messages[PIdx] = r3msg
-	round.out <- r3msg
+	if err := tss.SendMessage(round.Params(), round.out, r3msg); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
 	return nil
 }
 
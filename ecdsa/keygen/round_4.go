@@ -7,16 +7,16 @@
 package keygen
 
 import (
-	"errors"
+	"fmt"
+	"time"
 
-	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto/paillier"
 	"github.com/bnb-chain/tss-lib/tss"
 )
 
 func (round *round4) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 	round.number = 4
 	round.started = true
@@ -41,9 +41,11 @@ func (round *round4) Start() *tss.Error {
 		r3msg := msg.Content().(*KGRound3Message)
 		go func(prf paillier.Proof, j int, ch chan<- bool) {
 			ppk := round.save.PaillierPKs[j]
-			ok, err := prf.Verify(ppk.N, PIDs[j], ecdsaPub)
+			start := time.Now()
+			ok, err := prf.Verify(round.SessionID(), ppk.N, PIDs[j], ecdsaPub)
+			round.Params().Metrics().RecordProof(tss.ProofPaillierKey, tss.ProofVerification, start)
 			if err != nil {
-				common.Logger.Error(round.WrapError(err, Ps[j]).Error())
+				round.Params().Logger().Errorf("%s", round.WrapError(err, Ps[j]))
 				ch <- false
 				return
 			}
@@ -63,14 +65,14 @@ func (round *round4) Start() *tss.Error {
 	for j, ok := range round.ok {
 		if !ok {
 			culprits = append(culprits, Ps[j])
-			common.Logger.Warningf("paillier verify failed for party %s", Ps[j])
+			round.Params().Logger().Warningf("paillier verify failed for party %s", Ps[j])
 			continue
 		}
-		common.Logger.Debugf("paillier verify passed for party %s", Ps[j])
+		round.Params().Logger().Debugf("paillier verify passed for party %s", Ps[j])
 
 	}
 	if len(culprits) > 0 {
-		return round.WrapError(errors.New("paillier verify failed"), culprits...)
+		return round.WrapError(tss.NewProofError(tss.ProofPaillierKey, nil), culprits...)
 	}
 
 	round.end <- *round.save
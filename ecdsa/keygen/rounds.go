@@ -86,6 +86,11 @@ func (round *base) WrapError(err error, culprits ...*tss.PartyID) *tss.Error {
 	return tss.NewError(err, TaskName, round.number, round.PartyID(), culprits...)
 }
 
+// evidence records that `culprit` failed `check` on the messages `msgs`, for VerifyEvidence
+func (round *base) evidence(check string, culprit *tss.PartyID, msgs ...tss.ParsedMessage) *tss.Evidence {
+	return tss.NewEvidence(TaskName, round.number, check, round.SessionID(), round.PartyID(), culprit, msgs...)
+}
+
 // ----- //
 
 // `ok` tracks parties which have been verified by Update()
@@ -94,3 +99,8 @@ func (round *base) resetOK() {
 		round.ok[j] = false
 	}
 }
+
+// getBase is used to take a snapshot of the round a party is in
+func (round *base) getBase() *base {
+	return round
+}
@@ -0,0 +1,122 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	cmt "github.com/bnb-chain/tss-lib/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+type (
+	// snapshotState is what a snapshot of a keygen party holds
+	snapshotState struct {
+		Round    int
+		OK       []bool
+		Data     LocalPartySaveData
+		Messages [][][]byte
+		Temp     snapshotTempData
+	}
+
+	// snapshotTempData mirrors localTempData without its message store
+	snapshotTempData struct {
+		Ui            *big.Int
+		KGCs          []cmt.HashCommitment
+		Vs            vss.Vs
+		Shares        vss.Shares
+		DeCommitPolyG cmt.HashDeCommitment
+		SkTilde       *paillier.PrivateKey
+	}
+)
+
+// Snapshot exports the state of this party in the middle of a keygen run, encrypted with `key` (32 bytes).
+// After a restart, a new party constructed with the same arguments continues the run with Resume.
+func (p *LocalParty) Snapshot(key []byte) ([]byte, *tss.Error) {
+	return tss.BaseSnapshot(p, TaskName, key, p.exportState)
+}
+
+// Resume restores a snapshot taken with Snapshot into this party, which must not have been started.
+// The party then continues from the round it was in; messages that it missed should be delivered to it again.
+func (p *LocalParty) Resume(key, snapshot []byte) *tss.Error {
+	return tss.BaseResume(p, TaskName, key, snapshot, p.restoreState)
+}
+
+func (p *LocalParty) messageStores() []*[]tss.ParsedMessage {
+	return []*[]tss.ParsedMessage{
+		&p.temp.kgRound1Messages,
+		&p.temp.kgRound2Message1s,
+		&p.temp.kgRound2Message2s,
+		&p.temp.kgRound3Messages,
+	}
+}
+
+func (p *LocalParty) exportState(current tss.Round) (interface{}, error) {
+	rnd, ok := current.(interface{ getBase() *base })
+	if !ok {
+		return nil, errors.New("the party is in an unexpected round")
+	}
+	state := &snapshotState{
+		Round: current.RoundNumber(),
+		OK:    rnd.getBase().ok,
+		Data:  p.data,
+		Temp: snapshotTempData{
+			Ui:            p.temp.ui,
+			KGCs:          p.temp.KGCs,
+			Vs:            p.temp.vs,
+			Shares:        p.temp.shares,
+			DeCommitPolyG: p.temp.deCommitPolyG,
+			SkTilde:       p.temp.skTilde,
+		},
+	}
+	for _, store := range p.messageStores() {
+		bzs, err := tss.MarshalMessageStore(*store)
+		if err != nil {
+			return nil, err
+		}
+		state.Messages = append(state.Messages, bzs)
+	}
+	return state, nil
+}
+
+func (p *LocalParty) restoreState(bz []byte) (tss.Round, error) {
+	state := new(snapshotState)
+	if err := json.Unmarshal(bz, state); err != nil {
+		return nil, err
+	}
+	stores := p.messageStores()
+	if len(state.Messages) != len(stores) || len(state.OK) != len(p.params.Parties().IDs()) {
+		return nil, errors.New("the snapshot does not match this party's parameters")
+	}
+	for i, store := range stores {
+		msgs, err := tss.UnmarshalMessageStore(state.Messages[i], p.params.Parties().IDs())
+		if err != nil {
+			return nil, err
+		}
+		*store = msgs
+	}
+	p.data = state.Data
+	p.temp.ui = state.Temp.Ui
+	p.temp.KGCs = state.Temp.KGCs
+	p.temp.vs = state.Temp.Vs
+	p.temp.shares = state.Temp.Shares
+	p.temp.deCommitPolyG = state.Temp.DeCommitPolyG
+	p.temp.skTilde = state.Temp.SkTilde
+
+	round := p.FirstRound()
+	rnd := round.(*round1).base
+	for i := 1; i < state.Round; i++ {
+		round = round.NextRound()
+	}
+	rnd.number, rnd.started = state.Round, true
+	copy(rnd.ok, state.OK)
+	return round, nil
+}
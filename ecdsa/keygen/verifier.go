@@ -7,15 +7,20 @@
 package keygen
 
 import (
+	"context"
 	"errors"
 	"math/big"
+	"time"
 
 	"github.com/bnb-chain/tss-lib/crypto/dlnproof"
 	"github.com/bnb-chain/tss-lib/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/tss"
 )
 
 type ProofVerifier struct {
+	ctx       context.Context
 	semaphore chan interface{}
+	metrics   *tss.Metrics
 }
 
 type dlnMessage interface {
@@ -29,6 +34,12 @@ type modMessage interface {
 }
 
 func NewProofVerifier(concurrency int) *ProofVerifier {
+	return NewProofVerifierWithContext(context.Background(), concurrency)
+}
+
+// NewProofVerifierWithContext returns a ProofVerifier that abandons pending and queued verifications once ctx is done.
+// An abandoned verification reports false; callers should check ctx before blaming anyone for it.
+func NewProofVerifierWithContext(ctx context.Context, concurrency int) *ProofVerifier {
 	if concurrency == 0 {
 		panic(errors.New("NewDlnProofverifier: concurrency level must not be zero"))
 	}
@@ -36,16 +47,39 @@ func NewProofVerifier(concurrency int) *ProofVerifier {
 	semaphore := make(chan interface{}, concurrency)
 
 	return &ProofVerifier{
+		ctx:       ctx,
 		semaphore: semaphore,
 	}
 }
 
+// SetMetrics makes the verifier record the time spent in each verification in `metrics`
+func (pv *ProofVerifier) SetMetrics(metrics *tss.Metrics) {
+	pv.metrics = metrics
+}
+
+// acquire takes a slot in the semaphore, returning false without one if the context is done first
+func (pv *ProofVerifier) acquire() bool {
+	if pv.ctx.Err() != nil {
+		return false
+	}
+	select {
+	case pv.semaphore <- struct{}{}:
+		return true
+	case <-pv.ctx.Done():
+		return false
+	}
+}
+
 func (pv *ProofVerifier) VerifyDLNProof1(
+	session []byte,
 	m dlnMessage,
 	h1, h2, n *big.Int,
 	onDone func(bool),
 ) {
-	pv.semaphore <- struct{}{}
+	if !pv.acquire() {
+		onDone(false)
+		return
+	}
 	go func() {
 		defer func() { <-pv.semaphore }()
 
@@ -55,16 +89,23 @@ func (pv *ProofVerifier) VerifyDLNProof1(
 			return
 		}
 
-		onDone(dlnProof.Verify(h1, h2, n))
+		start := time.Now()
+		ok := dlnProof.Verify(session, h1, h2, n)
+		pv.metrics.RecordProof(tss.ProofDLN, tss.ProofVerification, start)
+		onDone(ok)
 	}()
 }
 
 func (pv *ProofVerifier) VerifyDLNProof2(
+	session []byte,
 	m dlnMessage,
 	h1, h2, n *big.Int,
 	onDone func(bool),
 ) {
-	pv.semaphore <- struct{}{}
+	if !pv.acquire() {
+		onDone(false)
+		return
+	}
 	go func() {
 		defer func() { <-pv.semaphore }()
 
@@ -74,16 +115,23 @@ func (pv *ProofVerifier) VerifyDLNProof2(
 			return
 		}
 
-		onDone(dlnProof.Verify(h1, h2, n))
+		start := time.Now()
+		ok := dlnProof.Verify(session, h1, h2, n)
+		pv.metrics.RecordProof(tss.ProofDLN, tss.ProofVerification, start)
+		onDone(ok)
 	}()
 }
 
 func (pv *ProofVerifier) VerifyModProof(
+	session []byte,
 	m modMessage,
 	N *big.Int,
 	onDone func(bool),
 ) {
-	pv.semaphore <- struct{}{}
+	if !pv.acquire() {
+		onDone(false)
+		return
+	}
 	go func() {
 		defer func() { <-pv.semaphore }()
 
@@ -93,7 +141,9 @@ func (pv *ProofVerifier) VerifyModProof(
 			return
 		}
 
-		ok, err2 := modProof.ModVerify(N)
+		start := time.Now()
+		ok, err2 := modProof.ModVerify(session, N)
+		pv.metrics.RecordProof(tss.ProofPaillierMod, tss.ProofVerification, start)
 		if err2 != nil {
 			onDone(false)
 			return
@@ -103,11 +153,15 @@ func (pv *ProofVerifier) VerifyModProof(
 }
 
 func (pv *ProofVerifier) VerifyModProofTilde(
+	session []byte,
 	m modMessage,
 	N *big.Int,
 	onDone func(bool),
 ) {
-	pv.semaphore <- struct{}{}
+	if !pv.acquire() {
+		onDone(false)
+		return
+	}
 	go func() {
 		defer func() { <-pv.semaphore }()
 
@@ -117,7 +171,9 @@ func (pv *ProofVerifier) VerifyModProofTilde(
 			return
 		}
 
-		ok, err2 := modProof.ModVerify(N)
+		start := time.Now()
+		ok, err2 := modProof.ModVerify(session, N)
+		pv.metrics.RecordProof(tss.ProofPaillierMod, tss.ProofVerification, start)
 		if err2 != nil {
 			onDone(false)
 			return
@@ -7,6 +7,7 @@
 package keygen
 
 import (
+	"context"
 	"math/big"
 	"runtime"
 	"testing"
@@ -24,6 +25,7 @@ func BenchmarkDlnProof_Verify(b *testing.B) {
 	params := localPartySaveData[0].LocalPreParams
 
 	proof := dlnproof.NewDLNProof(
+		nil,
 		params.H1i,
 		params.H2i,
 		params.Alpha,
@@ -34,7 +36,7 @@ func BenchmarkDlnProof_Verify(b *testing.B) {
 
 	b.ResetTimer()
 	for n := 0; n < b.N; n++ {
-		proof.Verify(params.H1i, params.H2i, params.NTildei)
+		proof.Verify(nil, params.H1i, params.H2i, params.NTildei)
 	}
 }
 
@@ -52,7 +54,7 @@ func BenchmarkDlnVerifier_VerifyProof1(b *testing.B) {
 	b.ResetTimer()
 	for n := 0; n < b.N; n++ {
 		resultChan := make(chan bool)
-		verifier.VerifyDLNProof1(message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
+		verifier.VerifyDLNProof1(nil, message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
 			resultChan <- result
 		})
 		<-resultChan
@@ -73,7 +75,7 @@ func BenchmarkDlnVerifier_VerifyProof2(b *testing.B) {
 	b.ResetTimer()
 	for n := 0; n < b.N; n++ {
 		resultChan := make(chan bool)
-		verifier.VerifyDLNProof2(message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
+		verifier.VerifyDLNProof2(nil, message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
 			resultChan <- result
 		})
 		<-resultChan
@@ -93,7 +95,7 @@ func TestVerifyDLNProof1_Success(t *testing.T) {
 
 	resultChan := make(chan bool)
 
-	verifier.VerifyDLNProof1(message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
+	verifier.VerifyDLNProof1(nil, message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
 		resultChan <- result
 	})
 
@@ -103,6 +105,31 @@ func TestVerifyDLNProof1_Success(t *testing.T) {
 	}
 }
 
+func TestVerifyDLNProof1_CancelledContext(t *testing.T) {
+	preParams, alpha, tt := prepareProofT(t)
+	message := &KGRound1Message{
+		Dlnproof_1: &KGRound1Message_DLNProof{
+			Alpha: alpha,
+			T:     tt,
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	verifier := NewProofVerifierWithContext(ctx, runtime.GOMAXPROCS(0))
+
+	resultChan := make(chan bool, 1)
+
+	verifier.VerifyDLNProof1(nil, message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
+		resultChan <- result
+	})
+
+	success := <-resultChan
+	if success {
+		t.Fatal("expected an abandoned verification")
+	}
+}
+
 func TestVerifyDLNProof1_MalformedMessage1(t *testing.T) {
 	preParams, alpha, tt := prepareProofT(t)
 	message := &KGRound1Message{
@@ -116,7 +143,7 @@ func TestVerifyDLNProof1_MalformedMessage1(t *testing.T) {
 
 	resultChan := make(chan bool)
 
-	verifier.VerifyDLNProof1(message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
+	verifier.VerifyDLNProof1(nil, message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
 		resultChan <- result
 	})
 
@@ -139,7 +166,7 @@ func TestVerifyDLNProof1_MalformedMessage2(t *testing.T) {
 
 	resultChan := make(chan bool)
 
-	verifier.VerifyDLNProof1(message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
+	verifier.VerifyDLNProof1(nil, message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
 		resultChan <- result
 	})
 
@@ -163,7 +190,7 @@ func TestVerifyDLNProof1_IncorrectProof(t *testing.T) {
 	resultChan := make(chan bool)
 
 	wrongH1i := preParams.H1i.Sub(preParams.H1i, big.NewInt(1))
-	verifier.VerifyDLNProof1(message, wrongH1i, preParams.H2i, preParams.NTildei, func(result bool) {
+	verifier.VerifyDLNProof1(nil, message, wrongH1i, preParams.H2i, preParams.NTildei, func(result bool) {
 		resultChan <- result
 	})
 
@@ -186,7 +213,7 @@ func TestVerifyDLNProof2_Success(t *testing.T) {
 
 	resultChan := make(chan bool)
 
-	verifier.VerifyDLNProof2(message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
+	verifier.VerifyDLNProof2(nil, message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
 		resultChan <- result
 	})
 
@@ -209,7 +236,7 @@ func TestVerifyDLNProof2_MalformedMessage1(t *testing.T) {
 
 	resultChan := make(chan bool)
 
-	verifier.VerifyDLNProof2(message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
+	verifier.VerifyDLNProof2(nil, message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
 		resultChan <- result
 	})
 
@@ -232,7 +259,7 @@ func TestVerifyDLNProof2_MalformedMessage2(t *testing.T) {
 
 	resultChan := make(chan bool)
 
-	verifier.VerifyDLNProof2(message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
+	verifier.VerifyDLNProof2(nil, message, preParams.H1i, preParams.H2i, preParams.NTildei, func(result bool) {
 		resultChan <- result
 	})
 
@@ -256,7 +283,7 @@ func TestVerifyDLNProof2_IncorrectProof(t *testing.T) {
 	resultChan := make(chan bool)
 
 	wrongH2i := preParams.H2i.Add(preParams.H2i, big.NewInt(1))
-	verifier.VerifyDLNProof2(message, preParams.H1i, wrongH2i, preParams.NTildei, func(result bool) {
+	verifier.VerifyDLNProof2(nil, message, preParams.H1i, wrongH2i, preParams.NTildei, func(result bool) {
 		resultChan <- result
 	})
 
@@ -293,6 +320,7 @@ func prepareProof() (*LocalPreParams, [][]byte, [][]byte, error) {
 	preParams := localPartySaveData[0].LocalPreParams
 
 	proof := dlnproof.NewDLNProof(
+		nil,
 		preParams.H1i,
 		preParams.H2i,
 		preParams.Alpha,
@@ -321,7 +349,7 @@ func BenchmarkProofVerifier_VerifyModProof(b *testing.B) {
 	b.ResetTimer()
 	for n := 0; n < b.N; n++ {
 		resultChan := make(chan bool)
-		verifier.VerifyModProof(message, preParams.PaillierSK.PublicKey.N, func(result bool) {
+		verifier.VerifyModProof(nil, message, preParams.PaillierSK.PublicKey.N, func(result bool) {
 			resultChan <- result
 		})
 		<-resultChan
@@ -344,7 +372,7 @@ func TestVerifyModProof_Success(t *testing.T) {
 
 	resultChan := make(chan bool)
 
-	verifier.VerifyModProof(message, preParams.PaillierSK.PublicKey.N, func(result bool) {
+	verifier.VerifyModProof(nil, message, preParams.PaillierSK.PublicKey.N, func(result bool) {
 		resultChan <- result
 	})
 
@@ -370,7 +398,7 @@ func TestVerifyModProof_MalformedMessage1(t *testing.T) {
 
 	resultChan := make(chan bool)
 
-	verifier.VerifyModProof(message, preParams.PaillierSK.PublicKey.N, func(result bool) {
+	verifier.VerifyModProof(nil, message, preParams.PaillierSK.PublicKey.N, func(result bool) {
 		resultChan <- result
 	})
 
@@ -396,7 +424,7 @@ func TestVerifyModProof_MalformedMessage2(t *testing.T) {
 
 	resultChan := make(chan bool)
 
-	verifier.VerifyModProof(message, preParams.PaillierSK.PublicKey.N, func(result bool) {
+	verifier.VerifyModProof(nil, message, preParams.PaillierSK.PublicKey.N, func(result bool) {
 		resultChan <- result
 	})
 
@@ -422,7 +450,7 @@ func TestVerifyModProof_MalformedMessage3(t *testing.T) {
 
 	resultChan := make(chan bool)
 
-	verifier.VerifyModProof(message, preParams.PaillierSK.PublicKey.N, func(result bool) {
+	verifier.VerifyModProof(nil, message, preParams.PaillierSK.PublicKey.N, func(result bool) {
 		resultChan <- result
 	})
 
@@ -448,7 +476,7 @@ func TestVerifyModProof_MalformedMessage4(t *testing.T) {
 
 	resultChan := make(chan bool)
 
-	verifier.VerifyModProof(message, preParams.PaillierSK.PublicKey.N, func(result bool) {
+	verifier.VerifyModProof(nil, message, preParams.PaillierSK.PublicKey.N, func(result bool) {
 		resultChan <- result
 	})
 
@@ -475,7 +503,7 @@ func TestVerifyModProof_Invalid1(t *testing.T) {
 
 	resultChan := make(chan bool)
 
-	verifier.VerifyModProof(message, preParams.PaillierSK.PublicKey.N, func(result bool) {
+	verifier.VerifyModProof(nil, message, preParams.PaillierSK.PublicKey.N, func(result bool) {
 		resultChan <- result
 	})
 
@@ -502,7 +530,7 @@ func TestVerifyModProof_Invalid2(t *testing.T) {
 
 	resultChan := make(chan bool)
 
-	verifier.VerifyModProof(message, preParams.PaillierSK.PublicKey.N, func(result bool) {
+	verifier.VerifyModProof(nil, message, preParams.PaillierSK.PublicKey.N, func(result bool) {
 		resultChan <- result
 	})
 
@@ -537,7 +565,7 @@ func TestVerifyModProof_Invalid3(t *testing.T) {
 
 	resultChan := make(chan bool)
 
-	verifier.VerifyModProof(message, preParams.PaillierSK.PublicKey.N, func(result bool) {
+	verifier.VerifyModProof(nil, message, preParams.PaillierSK.PublicKey.N, func(result bool) {
 		resultChan <- result
 	})
 
@@ -572,7 +600,7 @@ func TestVerifyModProof_Invalid4(t *testing.T) {
 
 	resultChan := make(chan bool)
 
-	verifier.VerifyModProof(message, preParams.PaillierSK.PublicKey.N, func(result bool) {
+	verifier.VerifyModProof(nil, message, preParams.PaillierSK.PublicKey.N, func(result bool) {
 		resultChan <- result
 	})
 
@@ -599,7 +627,7 @@ func TestVerifyModProof_Invalid5(t *testing.T) {
 
 	resultChan := make(chan bool)
 
-	verifier.VerifyModProof(message, preParams.PaillierSK.PublicKey.N, func(result bool) {
+	verifier.VerifyModProof(nil, message, preParams.PaillierSK.PublicKey.N, func(result bool) {
 		resultChan <- result
 	})
 
@@ -626,7 +654,7 @@ func TestVerifyModProof_Invalid6(t *testing.T) {
 
 	resultChan := make(chan bool)
 
-	verifier.VerifyModProof(message, preParams.PaillierSK.PhiN, func(result bool) {
+	verifier.VerifyModProof(nil, message, preParams.PaillierSK.PhiN, func(result bool) {
 		resultChan <- result
 	})
 
@@ -662,7 +690,7 @@ func prepareModProof() (*LocalPreParams, []byte, [][]byte, []bool, []bool, [][]b
 
 	preParams := localPartySaveData[0].LocalPreParams
 
-	proof := preParams.PaillierSK.ModProof()
+	proof := preParams.PaillierSK.ModProof(nil)
 
 	return &preParams, proof.W.Bytes(), common.BigIntsToBytes(proof.X[:]), proof.A[:], proof.B[:], common.BigIntsToBytes(proof.Z[:]), nil
 }
@@ -0,0 +1,905 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.12
+// source: protob/ecdsa-recovery.proto
+
+package recovery
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Represents a BROADCAST message sent by each helper during Round 1 of the ECDSA TSS share recovery and enrolment protocol.
+// It carries the commitments to the helper's split of its Lagrange-weighted share and the public save data of the key.
+type RecoveryRound1Message1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commitments [][]byte `protobuf:"bytes,1,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	Ks          [][]byte `protobuf:"bytes,2,rep,name=ks,proto3" json:"ks,omitempty"`
+	BigXj       [][]byte `protobuf:"bytes,3,rep,name=big_xj,json=bigXj,proto3" json:"big_xj,omitempty"`
+	PaillierNs  [][]byte `protobuf:"bytes,4,rep,name=paillier_ns,json=paillierNs,proto3" json:"paillier_ns,omitempty"`
+	NTildes     [][]byte `protobuf:"bytes,5,rep,name=n_tildes,json=nTildes,proto3" json:"n_tildes,omitempty"`
+	H1S         [][]byte `protobuf:"bytes,6,rep,name=h1s,proto3" json:"h1s,omitempty"`
+	H2S         [][]byte `protobuf:"bytes,7,rep,name=h2s,proto3" json:"h2s,omitempty"`
+	EcdsaPub    [][]byte `protobuf:"bytes,8,rep,name=ecdsa_pub,json=ecdsaPub,proto3" json:"ecdsa_pub,omitempty"`
+}
+
+func (x *RecoveryRound1Message1) Reset() {
+	*x = RecoveryRound1Message1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_ecdsa_recovery_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoveryRound1Message1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoveryRound1Message1) ProtoMessage() {}
+
+func (x *RecoveryRound1Message1) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_ecdsa_recovery_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoveryRound1Message1.ProtoReflect.Descriptor instead.
+func (*RecoveryRound1Message1) Descriptor() ([]byte, []int) {
+	return file_protob_ecdsa_recovery_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RecoveryRound1Message1) GetCommitments() [][]byte {
+	if x != nil {
+		return x.Commitments
+	}
+	return nil
+}
+
+func (x *RecoveryRound1Message1) GetKs() [][]byte {
+	if x != nil {
+		return x.Ks
+	}
+	return nil
+}
+
+func (x *RecoveryRound1Message1) GetBigXj() [][]byte {
+	if x != nil {
+		return x.BigXj
+	}
+	return nil
+}
+
+func (x *RecoveryRound1Message1) GetPaillierNs() [][]byte {
+	if x != nil {
+		return x.PaillierNs
+	}
+	return nil
+}
+
+func (x *RecoveryRound1Message1) GetNTildes() [][]byte {
+	if x != nil {
+		return x.NTildes
+	}
+	return nil
+}
+
+func (x *RecoveryRound1Message1) GetH1S() [][]byte {
+	if x != nil {
+		return x.H1S
+	}
+	return nil
+}
+
+func (x *RecoveryRound1Message1) GetH2S() [][]byte {
+	if x != nil {
+		return x.H2S
+	}
+	return nil
+}
+
+func (x *RecoveryRound1Message1) GetEcdsaPub() [][]byte {
+	if x != nil {
+		return x.EcdsaPub
+	}
+	return nil
+}
+
+// Represents a BROADCAST message sent by the recovering or enrolling party during Round 1 of the ECDSA TSS share recovery and enrolment protocol.
+type RecoveryRound1Message2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaillierN     []byte                           `protobuf:"bytes,1,opt,name=paillier_n,json=paillierN,proto3" json:"paillier_n,omitempty"`
+	NTilde        []byte                           `protobuf:"bytes,2,opt,name=n_tilde,json=nTilde,proto3" json:"n_tilde,omitempty"`
+	H1            []byte                           `protobuf:"bytes,3,opt,name=h1,proto3" json:"h1,omitempty"`
+	H2            []byte                           `protobuf:"bytes,4,opt,name=h2,proto3" json:"h2,omitempty"`
+	Dlnproof_1    *RecoveryRound1Message2_DLNProof `protobuf:"bytes,5,opt,name=dlnproof_1,json=dlnproof1,proto3" json:"dlnproof_1,omitempty"`
+	Dlnproof_2    *RecoveryRound1Message2_DLNProof `protobuf:"bytes,6,opt,name=dlnproof_2,json=dlnproof2,proto3" json:"dlnproof_2,omitempty"`
+	Modproof      *RecoveryRound1Message2_ModProof `protobuf:"bytes,7,opt,name=modproof,proto3" json:"modproof,omitempty"`
+	ModproofTilde *RecoveryRound1Message2_ModProof `protobuf:"bytes,8,opt,name=modproof_tilde,json=modproofTilde,proto3" json:"modproof_tilde,omitempty"`
+}
+
+func (x *RecoveryRound1Message2) Reset() {
+	*x = RecoveryRound1Message2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_ecdsa_recovery_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoveryRound1Message2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoveryRound1Message2) ProtoMessage() {}
+
+func (x *RecoveryRound1Message2) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_ecdsa_recovery_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoveryRound1Message2.ProtoReflect.Descriptor instead.
+func (*RecoveryRound1Message2) Descriptor() ([]byte, []int) {
+	return file_protob_ecdsa_recovery_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RecoveryRound1Message2) GetPaillierN() []byte {
+	if x != nil {
+		return x.PaillierN
+	}
+	return nil
+}
+
+func (x *RecoveryRound1Message2) GetNTilde() []byte {
+	if x != nil {
+		return x.NTilde
+	}
+	return nil
+}
+
+func (x *RecoveryRound1Message2) GetH1() []byte {
+	if x != nil {
+		return x.H1
+	}
+	return nil
+}
+
+func (x *RecoveryRound1Message2) GetH2() []byte {
+	if x != nil {
+		return x.H2
+	}
+	return nil
+}
+
+func (x *RecoveryRound1Message2) GetDlnproof_1() *RecoveryRound1Message2_DLNProof {
+	if x != nil {
+		return x.Dlnproof_1
+	}
+	return nil
+}
+
+func (x *RecoveryRound1Message2) GetDlnproof_2() *RecoveryRound1Message2_DLNProof {
+	if x != nil {
+		return x.Dlnproof_2
+	}
+	return nil
+}
+
+func (x *RecoveryRound1Message2) GetModproof() *RecoveryRound1Message2_ModProof {
+	if x != nil {
+		return x.Modproof
+	}
+	return nil
+}
+
+func (x *RecoveryRound1Message2) GetModproofTilde() *RecoveryRound1Message2_ModProof {
+	if x != nil {
+		return x.ModproofTilde
+	}
+	return nil
+}
+
+// Represents a P2P message sent by each helper to each other helper during Round 1 of the ECDSA TSS share recovery and enrolment protocol.
+type RecoveryRound1Message3 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Share []byte `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+}
+
+func (x *RecoveryRound1Message3) Reset() {
+	*x = RecoveryRound1Message3{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_ecdsa_recovery_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoveryRound1Message3) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoveryRound1Message3) ProtoMessage() {}
+
+func (x *RecoveryRound1Message3) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_ecdsa_recovery_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoveryRound1Message3.ProtoReflect.Descriptor instead.
+func (*RecoveryRound1Message3) Descriptor() ([]byte, []int) {
+	return file_protob_ecdsa_recovery_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RecoveryRound1Message3) GetShare() []byte {
+	if x != nil {
+		return x.Share
+	}
+	return nil
+}
+
+// Represents a P2P message sent by each helper to the recovering or enrolling party during Round 2 of the ECDSA TSS share recovery and enrolment protocol.
+type RecoveryRound2Message1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sigma []byte `protobuf:"bytes,1,opt,name=sigma,proto3" json:"sigma,omitempty"`
+}
+
+func (x *RecoveryRound2Message1) Reset() {
+	*x = RecoveryRound2Message1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_ecdsa_recovery_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoveryRound2Message1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoveryRound2Message1) ProtoMessage() {}
+
+func (x *RecoveryRound2Message1) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_ecdsa_recovery_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoveryRound2Message1.ProtoReflect.Descriptor instead.
+func (*RecoveryRound2Message1) Descriptor() ([]byte, []int) {
+	return file_protob_ecdsa_recovery_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RecoveryRound2Message1) GetSigma() []byte {
+	if x != nil {
+		return x.Sigma
+	}
+	return nil
+}
+
+// Represents a P2P message sent by the recovering or enrolling party to each helper during Round 2 of the ECDSA TSS share recovery and enrolment protocol.
+type RecoveryRound2Message2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Facproof      *RecoveryRound2Message2_FactorProof `protobuf:"bytes,1,opt,name=facproof,proto3" json:"facproof,omitempty"`
+	FacproofTilde *RecoveryRound2Message2_FactorProof `protobuf:"bytes,2,opt,name=facproof_tilde,json=facproofTilde,proto3" json:"facproof_tilde,omitempty"`
+}
+
+func (x *RecoveryRound2Message2) Reset() {
+	*x = RecoveryRound2Message2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_ecdsa_recovery_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoveryRound2Message2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoveryRound2Message2) ProtoMessage() {}
+
+func (x *RecoveryRound2Message2) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_ecdsa_recovery_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoveryRound2Message2.ProtoReflect.Descriptor instead.
+func (*RecoveryRound2Message2) Descriptor() ([]byte, []int) {
+	return file_protob_ecdsa_recovery_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RecoveryRound2Message2) GetFacproof() *RecoveryRound2Message2_FactorProof {
+	if x != nil {
+		return x.Facproof
+	}
+	return nil
+}
+
+func (x *RecoveryRound2Message2) GetFacproofTilde() *RecoveryRound2Message2_FactorProof {
+	if x != nil {
+		return x.FacproofTilde
+	}
+	return nil
+}
+
+type RecoveryRound1Message2_DLNProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alpha [][]byte `protobuf:"bytes,1,rep,name=alpha,proto3" json:"alpha,omitempty"`
+	T     [][]byte `protobuf:"bytes,2,rep,name=t,proto3" json:"t,omitempty"`
+}
+
+func (x *RecoveryRound1Message2_DLNProof) Reset() {
+	*x = RecoveryRound1Message2_DLNProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_ecdsa_recovery_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoveryRound1Message2_DLNProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoveryRound1Message2_DLNProof) ProtoMessage() {}
+
+func (x *RecoveryRound1Message2_DLNProof) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_ecdsa_recovery_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoveryRound1Message2_DLNProof.ProtoReflect.Descriptor instead.
+func (*RecoveryRound1Message2_DLNProof) Descriptor() ([]byte, []int) {
+	return file_protob_ecdsa_recovery_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *RecoveryRound1Message2_DLNProof) GetAlpha() [][]byte {
+	if x != nil {
+		return x.Alpha
+	}
+	return nil
+}
+
+func (x *RecoveryRound1Message2_DLNProof) GetT() [][]byte {
+	if x != nil {
+		return x.T
+	}
+	return nil
+}
+
+type RecoveryRound1Message2_ModProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	W []byte   `protobuf:"bytes,1,opt,name=w,proto3" json:"w,omitempty"`
+	X [][]byte `protobuf:"bytes,2,rep,name=x,proto3" json:"x,omitempty"`
+	A []bool   `protobuf:"varint,3,rep,packed,name=a,proto3" json:"a,omitempty"`
+	B []bool   `protobuf:"varint,4,rep,packed,name=b,proto3" json:"b,omitempty"`
+	Z [][]byte `protobuf:"bytes,5,rep,name=z,proto3" json:"z,omitempty"`
+}
+
+func (x *RecoveryRound1Message2_ModProof) Reset() {
+	*x = RecoveryRound1Message2_ModProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_ecdsa_recovery_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoveryRound1Message2_ModProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoveryRound1Message2_ModProof) ProtoMessage() {}
+
+func (x *RecoveryRound1Message2_ModProof) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_ecdsa_recovery_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoveryRound1Message2_ModProof.ProtoReflect.Descriptor instead.
+func (*RecoveryRound1Message2_ModProof) Descriptor() ([]byte, []int) {
+	return file_protob_ecdsa_recovery_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *RecoveryRound1Message2_ModProof) GetW() []byte {
+	if x != nil {
+		return x.W
+	}
+	return nil
+}
+
+func (x *RecoveryRound1Message2_ModProof) GetX() [][]byte {
+	if x != nil {
+		return x.X
+	}
+	return nil
+}
+
+func (x *RecoveryRound1Message2_ModProof) GetA() []bool {
+	if x != nil {
+		return x.A
+	}
+	return nil
+}
+
+func (x *RecoveryRound1Message2_ModProof) GetB() []bool {
+	if x != nil {
+		return x.B
+	}
+	return nil
+}
+
+func (x *RecoveryRound1Message2_ModProof) GetZ() [][]byte {
+	if x != nil {
+		return x.Z
+	}
+	return nil
+}
+
+type RecoveryRound2Message2_FactorProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	P     []byte `protobuf:"bytes,1,opt,name=p,proto3" json:"p,omitempty"`
+	Q     []byte `protobuf:"bytes,2,opt,name=q,proto3" json:"q,omitempty"`
+	A     []byte `protobuf:"bytes,3,opt,name=a,proto3" json:"a,omitempty"`
+	B     []byte `protobuf:"bytes,4,opt,name=b,proto3" json:"b,omitempty"`
+	T     []byte `protobuf:"bytes,5,opt,name=t,proto3" json:"t,omitempty"`
+	Sigma []byte `protobuf:"bytes,6,opt,name=sigma,proto3" json:"sigma,omitempty"`
+	Z1    []byte `protobuf:"bytes,7,opt,name=z1,proto3" json:"z1,omitempty"`
+	Z2    []byte `protobuf:"bytes,8,opt,name=z2,proto3" json:"z2,omitempty"`
+	W1    []byte `protobuf:"bytes,9,opt,name=w1,proto3" json:"w1,omitempty"`
+	W2    []byte `protobuf:"bytes,10,opt,name=w2,proto3" json:"w2,omitempty"`
+	V     []byte `protobuf:"bytes,11,opt,name=v,proto3" json:"v,omitempty"`
+}
+
+func (x *RecoveryRound2Message2_FactorProof) Reset() {
+	*x = RecoveryRound2Message2_FactorProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_ecdsa_recovery_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoveryRound2Message2_FactorProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoveryRound2Message2_FactorProof) ProtoMessage() {}
+
+func (x *RecoveryRound2Message2_FactorProof) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_ecdsa_recovery_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoveryRound2Message2_FactorProof.ProtoReflect.Descriptor instead.
+func (*RecoveryRound2Message2_FactorProof) Descriptor() ([]byte, []int) {
+	return file_protob_ecdsa_recovery_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *RecoveryRound2Message2_FactorProof) GetP() []byte {
+	if x != nil {
+		return x.P
+	}
+	return nil
+}
+
+func (x *RecoveryRound2Message2_FactorProof) GetQ() []byte {
+	if x != nil {
+		return x.Q
+	}
+	return nil
+}
+
+func (x *RecoveryRound2Message2_FactorProof) GetA() []byte {
+	if x != nil {
+		return x.A
+	}
+	return nil
+}
+
+func (x *RecoveryRound2Message2_FactorProof) GetB() []byte {
+	if x != nil {
+		return x.B
+	}
+	return nil
+}
+
+func (x *RecoveryRound2Message2_FactorProof) GetT() []byte {
+	if x != nil {
+		return x.T
+	}
+	return nil
+}
+
+func (x *RecoveryRound2Message2_FactorProof) GetSigma() []byte {
+	if x != nil {
+		return x.Sigma
+	}
+	return nil
+}
+
+func (x *RecoveryRound2Message2_FactorProof) GetZ1() []byte {
+	if x != nil {
+		return x.Z1
+	}
+	return nil
+}
+
+func (x *RecoveryRound2Message2_FactorProof) GetZ2() []byte {
+	if x != nil {
+		return x.Z2
+	}
+	return nil
+}
+
+func (x *RecoveryRound2Message2_FactorProof) GetW1() []byte {
+	if x != nil {
+		return x.W1
+	}
+	return nil
+}
+
+func (x *RecoveryRound2Message2_FactorProof) GetW2() []byte {
+	if x != nil {
+		return x.W2
+	}
+	return nil
+}
+
+func (x *RecoveryRound2Message2_FactorProof) GetV() []byte {
+	if x != nil {
+		return x.V
+	}
+	return nil
+}
+
+var File_protob_ecdsa_recovery_proto protoreflect.FileDescriptor
+
+var file_protob_ecdsa_recovery_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2f, 0x65, 0x63, 0x64, 0x73, 0x61, 0x2d, 0x72,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1d, 0x62,
+	0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x74, 0x73, 0x73, 0x6c, 0x69, 0x62, 0x2e, 0x65, 0x63,
+	0x64, 0x73, 0x61, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x22, 0xde, 0x01, 0x0a,
+	0x16, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x31, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x31, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x6b, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x02, 0x6b, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x67,
+	0x5f, 0x78, 0x6a, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x69, 0x67, 0x58, 0x6a,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x69, 0x6c, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x61, 0x69, 0x6c, 0x6c, 0x69, 0x65, 0x72, 0x4e,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x5f, 0x74, 0x69, 0x6c, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x07, 0x6e, 0x54, 0x69, 0x6c, 0x64, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03,
+	0x68, 0x31, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x68, 0x31, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x68, 0x32, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x68, 0x32, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x65, 0x63, 0x64, 0x73, 0x61, 0x5f, 0x70, 0x75, 0x62, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x08, 0x65, 0x63, 0x64, 0x73, 0x61, 0x50, 0x75, 0x62, 0x22, 0xf3, 0x04,
+	0x0a, 0x16, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x31,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x69, 0x6c,
+	0x6c, 0x69, 0x65, 0x72, 0x5f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x61,
+	0x69, 0x6c, 0x6c, 0x69, 0x65, 0x72, 0x4e, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x5f, 0x74, 0x69, 0x6c,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6e, 0x54, 0x69, 0x6c, 0x64, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x68, 0x31, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x68, 0x31,
+	0x12, 0x0e, 0x0a, 0x02, 0x68, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x68, 0x32,
+	0x12, 0x5d, 0x0a, 0x0a, 0x64, 0x6c, 0x6e, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x31, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x74,
+	0x73, 0x73, 0x6c, 0x69, 0x62, 0x2e, 0x65, 0x63, 0x64, 0x73, 0x61, 0x2e, 0x72, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x31, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x2e, 0x44, 0x4c, 0x4e, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x52, 0x09, 0x64, 0x6c, 0x6e, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x31, 0x12,
+	0x5d, 0x0a, 0x0a, 0x64, 0x6c, 0x6e, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x32, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x74, 0x73,
+	0x73, 0x6c, 0x69, 0x62, 0x2e, 0x65, 0x63, 0x64, 0x73, 0x61, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x31, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x2e, 0x44, 0x4c, 0x4e, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x52, 0x09, 0x64, 0x6c, 0x6e, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x32, 0x12, 0x5a,
+	0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x3e, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x74, 0x73, 0x73, 0x6c, 0x69,
+	0x62, 0x2e, 0x65, 0x63, 0x64, 0x73, 0x61, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x31, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x2e, 0x4d, 0x6f, 0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x52, 0x08, 0x6d, 0x6f, 0x64, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x65, 0x0a, 0x0e, 0x6d, 0x6f,
+	0x64, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x74, 0x69, 0x6c, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x74, 0x73, 0x73,
+	0x6c, 0x69, 0x62, 0x2e, 0x65, 0x63, 0x64, 0x73, 0x61, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x31, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x2e, 0x4d, 0x6f, 0x64, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x52, 0x0d, 0x6d, 0x6f, 0x64, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x54, 0x69, 0x6c, 0x64,
+	0x65, 0x1a, 0x2e, 0x0a, 0x08, 0x44, 0x4c, 0x4e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x12, 0x0c, 0x0a, 0x01, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x01,
+	0x74, 0x1a, 0x50, 0x0a, 0x08, 0x4d, 0x6f, 0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x0c, 0x0a,
+	0x01, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x01, 0x77, 0x12, 0x0c, 0x0a, 0x01, 0x78,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x61, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x08, 0x52, 0x01, 0x61, 0x12, 0x0c, 0x0a, 0x01, 0x62, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x08, 0x52, 0x01, 0x62, 0x12, 0x0c, 0x0a, 0x01, 0x7a, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x01, 0x7a, 0x22, 0x2e, 0x0a, 0x16, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x31, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x33, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x22, 0x2e, 0x0a, 0x16, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x31, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x69, 0x67, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x69,
+	0x67, 0x6d, 0x61, 0x22, 0x9b, 0x03, 0x0a, 0x16, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0x5d,
+	0x0a, 0x08, 0x66, 0x61, 0x63, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x41, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x74, 0x73, 0x73, 0x6c, 0x69,
+	0x62, 0x2e, 0x65, 0x63, 0x64, 0x73, 0x61, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x32, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x52, 0x08, 0x66, 0x61, 0x63, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x68, 0x0a,
+	0x0e, 0x66, 0x61, 0x63, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x74, 0x69, 0x6c, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e,
+	0x74, 0x73, 0x73, 0x6c, 0x69, 0x62, 0x2e, 0x65, 0x63, 0x64, 0x73, 0x61, 0x2e, 0x72, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x2e, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x0d, 0x66, 0x61, 0x63, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x54, 0x69, 0x6c, 0x64, 0x65, 0x1a, 0xb7, 0x01, 0x0a, 0x0b, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x0c, 0x0a, 0x01, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x01, 0x70, 0x12, 0x0c, 0x0a, 0x01, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x01, 0x71, 0x12, 0x0c, 0x0a, 0x01, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x01,
+	0x61, 0x12, 0x0c, 0x0a, 0x01, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x01, 0x62, 0x12,
+	0x0c, 0x0a, 0x01, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x01, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x69, 0x67, 0x6d, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x69,
+	0x67, 0x6d, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x7a, 0x31, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x02, 0x7a, 0x31, 0x12, 0x0e, 0x0a, 0x02, 0x7a, 0x32, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x02, 0x7a, 0x32, 0x12, 0x0e, 0x0a, 0x02, 0x77, 0x31, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x02, 0x77, 0x31, 0x12, 0x0e, 0x0a, 0x02, 0x77, 0x32, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x02, 0x77, 0x32, 0x12, 0x0c, 0x0a, 0x01, 0x76, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x01,
+	0x76, 0x42, 0x10, 0x5a, 0x0e, 0x65, 0x63, 0x64, 0x73, 0x61, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_protob_ecdsa_recovery_proto_rawDescOnce sync.Once
+	file_protob_ecdsa_recovery_proto_rawDescData = file_protob_ecdsa_recovery_proto_rawDesc
+)
+
+func file_protob_ecdsa_recovery_proto_rawDescGZIP() []byte {
+	file_protob_ecdsa_recovery_proto_rawDescOnce.Do(func() {
+		file_protob_ecdsa_recovery_proto_rawDescData = protoimpl.X.CompressGZIP(file_protob_ecdsa_recovery_proto_rawDescData)
+	})
+	return file_protob_ecdsa_recovery_proto_rawDescData
+}
+
+var file_protob_ecdsa_recovery_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_protob_ecdsa_recovery_proto_goTypes = []interface{}{
+	(*RecoveryRound1Message1)(nil),             // 0: binance.tsslib.ecdsa.recovery.RecoveryRound1Message1
+	(*RecoveryRound1Message2)(nil),             // 1: binance.tsslib.ecdsa.recovery.RecoveryRound1Message2
+	(*RecoveryRound1Message3)(nil),             // 2: binance.tsslib.ecdsa.recovery.RecoveryRound1Message3
+	(*RecoveryRound2Message1)(nil),             // 3: binance.tsslib.ecdsa.recovery.RecoveryRound2Message1
+	(*RecoveryRound2Message2)(nil),             // 4: binance.tsslib.ecdsa.recovery.RecoveryRound2Message2
+	(*RecoveryRound1Message2_DLNProof)(nil),    // 5: binance.tsslib.ecdsa.recovery.RecoveryRound1Message2.DLNProof
+	(*RecoveryRound1Message2_ModProof)(nil),    // 6: binance.tsslib.ecdsa.recovery.RecoveryRound1Message2.ModProof
+	(*RecoveryRound2Message2_FactorProof)(nil), // 7: binance.tsslib.ecdsa.recovery.RecoveryRound2Message2.FactorProof
+}
+var file_protob_ecdsa_recovery_proto_depIdxs = []int32{
+	5, // 0: binance.tsslib.ecdsa.recovery.RecoveryRound1Message2.dlnproof_1:type_name -> binance.tsslib.ecdsa.recovery.RecoveryRound1Message2.DLNProof
+	5, // 1: binance.tsslib.ecdsa.recovery.RecoveryRound1Message2.dlnproof_2:type_name -> binance.tsslib.ecdsa.recovery.RecoveryRound1Message2.DLNProof
+	6, // 2: binance.tsslib.ecdsa.recovery.RecoveryRound1Message2.modproof:type_name -> binance.tsslib.ecdsa.recovery.RecoveryRound1Message2.ModProof
+	6, // 3: binance.tsslib.ecdsa.recovery.RecoveryRound1Message2.modproof_tilde:type_name -> binance.tsslib.ecdsa.recovery.RecoveryRound1Message2.ModProof
+	7, // 4: binance.tsslib.ecdsa.recovery.RecoveryRound2Message2.facproof:type_name -> binance.tsslib.ecdsa.recovery.RecoveryRound2Message2.FactorProof
+	7, // 5: binance.tsslib.ecdsa.recovery.RecoveryRound2Message2.facproof_tilde:type_name -> binance.tsslib.ecdsa.recovery.RecoveryRound2Message2.FactorProof
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_protob_ecdsa_recovery_proto_init() }
+func file_protob_ecdsa_recovery_proto_init() {
+	if File_protob_ecdsa_recovery_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_protob_ecdsa_recovery_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoveryRound1Message1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protob_ecdsa_recovery_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoveryRound1Message2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protob_ecdsa_recovery_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoveryRound1Message3); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protob_ecdsa_recovery_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoveryRound2Message1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protob_ecdsa_recovery_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoveryRound2Message2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protob_ecdsa_recovery_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoveryRound1Message2_DLNProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protob_ecdsa_recovery_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoveryRound1Message2_ModProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protob_ecdsa_recovery_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoveryRound2Message2_FactorProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_protob_ecdsa_recovery_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_protob_ecdsa_recovery_proto_goTypes,
+		DependencyIndexes: file_protob_ecdsa_recovery_proto_depIdxs,
+		MessageInfos:      file_protob_ecdsa_recovery_proto_msgTypes,
+	}.Build()
+	File_protob_ecdsa_recovery_proto = out.File
+	file_protob_ecdsa_recovery_proto_rawDesc = nil
+	file_protob_ecdsa_recovery_proto_goTypes = nil
+	file_protob_ecdsa_recovery_proto_depIdxs = nil
+}
@@ -0,0 +1,221 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package recovery
+
+import (
+	"crypto/elliptic"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/crypto/schnorr"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// Entries are the public entries of a recovered or enrolled party, for a holder of the key that did not take part in its
+// recovery or enrolment. The party makes them with NewEntries for the ring-Pedersen parameters of that holder, and sends
+// them to it; the holder checks and saves them with ApplyEntries. The entries carry a proof of knowledge of the share of
+// the party, so that only the holder of the share at ShareID can replace or add the entries at ShareID.
+type Entries struct {
+	ShareID    *big.Int
+	PaillierPK *paillier.PublicKey
+	NTildej    *big.Int
+	H1j, H2j   *big.Int
+
+	// the proofs that the ring-Pedersen parameters are well formed and that both moduli are Paillier-Blum moduli
+	Proofs *keygen.PreParamsProofs
+	// the proofs that both moduli have no small factors, made for the ring-Pedersen parameters of the holder
+	FactorProof, FactorProofTilde *paillier.FactorProof
+	// the proof of knowledge of the share behind the Xj at ShareID, bound to the other entries
+	ShareProof *schnorr.ZKProof
+}
+
+// NewEntries returns the entries of the party with save data `key`, as it ended a recovery or an enrolment, for the holder
+// of the key whose ring-Pedersen parameters are `NTildej`, `h1j` and `h2j`. The proofs are bound to `session`, which
+// the holder must pass to ApplyEntries; it should be unique to the exchange, e.g. the session ID of the run.
+func NewEntries(rand io.Reader, session []byte, key keygen.LocalPartySaveData, NTildej, h1j, h2j *big.Int) (*Entries, error) {
+	preParams := key.LocalPreParams
+	if key.ShareID == nil || key.Xi == nil || !preParams.ValidateWithProof() {
+		return nil, errors.New("the save data has no ShareID, share or pre-params")
+	}
+	if NTildej == nil || h1j == nil || h2j == nil {
+		return nil, errors.New("the ring-Pedersen parameters of the holder are incomplete")
+	}
+	var bigXi *crypto.ECPoint
+	for k, kk := range key.Ks {
+		if kk != nil && kk.Cmp(key.ShareID) == 0 && k < len(key.BigXj) {
+			bigXi = key.BigXj[k]
+		}
+	}
+	if bigXi == nil {
+		return nil, errors.New("the save data has no Xj for its ShareID")
+	}
+	entries := &Entries{
+		ShareID:          key.ShareID,
+		PaillierPK:       &preParams.PaillierSK.PublicKey,
+		NTildej:          preParams.NTildei,
+		H1j:              preParams.H1i,
+		H2j:              preParams.H2i,
+		Proofs:           preParams.Prove(rand, session, nil),
+		FactorProof:      preParams.PaillierSK.FactorProofWithRand(rand, session, NTildej, h1j, h2j),
+		FactorProofTilde: preParams.NTildeKey().FactorProofWithRand(rand, session, NTildej, h1j, h2j),
+	}
+	var err error
+	if entries.ShareProof, err = schnorr.NewZKProofWithRand(rand, entries.shareProofSession(session), key.Xi, bigXi); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// ApplyEntries checks `entries` against the save data `key` of a holder that did not take part in a recovery or an
+// enrolment, and returns `key` with them saved. The Paillier key and ring-Pedersen parameters of a recovered party replace
+// its old ones. A new party is appended with its ShareID, and with its Xj interpolated from the Xj of the other holders,
+// so that it does not have to be trusted. Either way the party must prove that it knows the share behind that Xj.
+// The shares of `key` are unchanged.
+func ApplyEntries(ec elliptic.Curve, session []byte, key keygen.LocalPartySaveData, entries *Entries) (keygen.LocalPartySaveData, error) {
+	if entries == nil || entries.ShareID == nil || entries.PaillierPK == nil || entries.PaillierPK.N == nil ||
+		entries.NTildej == nil || entries.H1j == nil || entries.H2j == nil ||
+		entries.Proofs == nil || entries.FactorProof == nil || entries.FactorProofTilde == nil || entries.ShareProof == nil {
+		return key, fmt.Errorf("%w: the entries are incomplete", tss.ErrInvalidMessage)
+	}
+	q := ec.Params().N
+	ki, kT := -1, -1
+	for k, kk := range key.Ks {
+		if key.ShareID != nil && sameShareID(q, kk, key.ShareID) {
+			ki = k
+		}
+		if sameShareID(q, kk, entries.ShareID) {
+			kT = k
+		}
+	}
+	switch {
+	case ki < 0:
+		return key, errors.New("the save data is not that of a holder of the key")
+	case kT == ki:
+		return key, fmt.Errorf("%w: the entries are those of this holder", tss.ErrInvalidMessage)
+	case kT < 0 && new(big.Int).Mod(entries.ShareID, q).Sign() == 0:
+		// a ShareID of zero would be given the private key itself
+		return key, fmt.Errorf("%w: the ShareID is zero mod the curve order", tss.ErrInvalidMessage)
+	}
+
+	// 1. check that the entries were made by the holder of the share at ShareID; the Xj of a new party is interpolated
+	var bigXT *crypto.ECPoint
+	if 0 <= kT {
+		bigXT = key.BigXj[kT]
+	} else {
+		var err error
+		if bigXT, err = interpolateBigX(ec, key.Ks, key.BigXj, entries.ShareID); err != nil {
+			return key, fmt.Errorf("%w: interpolating the Xj of the new party: %v", tss.ErrPointNotOnCurve, err)
+		}
+	}
+	if !entries.ShareProof.Verify(entries.shareProofSession(session), bigXT) {
+		return key, tss.NewProofError(tss.ProofSchnorr, nil)
+	}
+
+	// 2. check the Paillier key and ring-Pedersen parameters, which must stay unique
+	NT, NTildeT, H1T, H2T := entries.PaillierPK.N, entries.NTildej, entries.H1j, entries.H2j
+	if NT.BitLen() != paillierBitsLen || NTildeT.BitLen() != paillierBitsLen {
+		return key, fmt.Errorf("%w: got a modulus with insufficient bits", tss.ErrInvalidMessage)
+	}
+	if H1T.Cmp(H2T) == 0 {
+		return key, fmt.Errorf("%w: h1j and h2j were equal for this party", tss.ErrInvalidMessage)
+	}
+	h1H2Map := map[string]struct{}{
+		hex.EncodeToString(H1T.Bytes()): {},
+		hex.EncodeToString(H2T.Bytes()): {},
+	}
+	for k := range key.Ks {
+		if k == kT {
+			continue
+		}
+		_, h1Found := h1H2Map[hex.EncodeToString(key.H1j[k].Bytes())]
+		_, h2Found := h1H2Map[hex.EncodeToString(key.H2j[k].Bytes())]
+		if h1Found || h2Found {
+			return key, fmt.Errorf("%w: this h1j or h2j was already used by another party", tss.ErrInvalidMessage)
+		}
+	}
+	proofs := entries.Proofs
+	if proofs.DLNProof1 == nil || proofs.DLNProof2 == nil ||
+		!proofs.DLNProof1.Verify(session, H1T, H2T, NTildeT) || !proofs.DLNProof2.Verify(session, H2T, H1T, NTildeT) {
+		return key, tss.NewProofError(tss.ProofDLN, nil)
+	}
+	if proofs.ModProof == nil || proofs.ModProofTilde == nil {
+		return key, tss.NewProofError(tss.ProofPaillierMod, nil)
+	}
+	if ok, err := proofs.ModProof.ModVerify(session, NT); err != nil || !ok {
+		return key, tss.NewProofError(tss.ProofPaillierMod, err)
+	}
+	if ok, err := proofs.ModProofTilde.ModVerify(session, NTildeT); err != nil || !ok {
+		return key, tss.NewProofError(tss.ProofPaillierMod, err)
+	}
+	NTildei, H1i, H2i := key.NTildej[ki], key.H1j[ki], key.H2j[ki]
+	if ok, err := entries.FactorProof.FactorVerify(session, NT, NTildei, H1i, H2i); err != nil || !ok {
+		return key, tss.NewProofError(tss.ProofPaillierFactor, err)
+	}
+	if ok, err := entries.FactorProofTilde.FactorVerify(session, NTildeT, NTildei, H1i, H2i); err != nil || !ok {
+		return key, tss.NewProofError(tss.ProofPaillierFactor, err)
+	}
+
+	// 3. SAVE the entries; those of a new party are appended with their interpolated Xj
+	key.Ks = append([]*big.Int(nil), key.Ks...)
+	key.BigXj = append([]*crypto.ECPoint(nil), key.BigXj...)
+	key.PaillierPKs = append([]*paillier.PublicKey(nil), key.PaillierPKs...)
+	key.NTildej = append([]*big.Int(nil), key.NTildej...)
+	key.H1j = append([]*big.Int(nil), key.H1j...)
+	key.H2j = append([]*big.Int(nil), key.H2j...)
+	if kT < 0 {
+		kT = len(key.Ks)
+		key.Ks = append(key.Ks, entries.ShareID)
+		key.BigXj = append(key.BigXj, bigXT)
+		key.PaillierPKs = append(key.PaillierPKs, nil)
+		key.NTildej = append(key.NTildej, nil)
+		key.H1j, key.H2j = append(key.H1j, nil), append(key.H2j, nil)
+	}
+	key.PaillierPKs[kT] = entries.PaillierPK
+	key.NTildej[kT] = NTildeT
+	key.H1j[kT], key.H2j[kT] = H1T, H2T
+	return key, nil
+}
+
+// shareProofSession returns `session` bound to the other entries, so that the proof of knowledge of the share cannot be
+// replayed along with another Paillier key or other ring-Pedersen parameters
+func (entries *Entries) shareProofSession(session []byte) []byte {
+	hash := common.SHA512_256i(entries.ShareID, entries.PaillierPK.N, entries.NTildej, entries.H1j, entries.H2j)
+	return append(append([]byte(nil), session...), hash.Bytes()...)
+}
+
+// interpolateBigX returns the point at `x` of the polynomial in the exponent whose points at `ks` are `bigXs`,
+// which is the Xj of a party with ShareID `x`
+func interpolateBigX(ec elliptic.Curve, ks []*big.Int, bigXs []*crypto.ECPoint, x *big.Int) (*crypto.ECPoint, error) {
+	modQ := common.ModInt(ec.Params().N)
+	var sum *crypto.ECPoint
+	for j, kj := range ks {
+		coef := big.NewInt(1)
+		for m, km := range ks {
+			if m == j {
+				continue
+			}
+			coef = modQ.Mul(coef, modQ.Mul(modQ.Sub(x, km), modQ.ModInverse(modQ.Sub(kj, km))))
+		}
+		term := bigXs[j].ScalarMult(coef)
+		if sum == nil {
+			sum = term
+			continue
+		}
+		var err error
+		if sum, err = sum.Add(term); err != nil {
+			return nil, err
+		}
+	}
+	return sum, nil
+}
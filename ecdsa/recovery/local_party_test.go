@@ -0,0 +1,323 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package recovery
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/ecdsa/signing"
+	"github.com/bnb-chain/tss-lib/test"
+	"github.com/bnb-chain/tss-lib/test/adversary"
+	"github.com/bnb-chain/tss-lib/test/netsim"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+const (
+	testParticipants = test.TestParticipants
+	testThreshold    = test.TestThreshold
+
+	// the index of the party that lost its share
+	testLost = 3
+)
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+}
+
+// newParty makes party i of the recovery of `testLost` among the first t+2 fixtures; the lost party reuses its
+// fixture pre-params, which stand in for freshly generated ones
+func newParty(params *tss.Parameters, keys []keygen.LocalPartySaveData, pIDs tss.SortedPartyIDs, i int, out chan<- tss.Message, end chan<- keygen.LocalPartySaveData) tss.Party {
+	if i == testLost {
+		return NewLocalPartyToRecover(params, out, end, keys[i].LocalPreParams)
+	}
+	return NewLocalParty(params, keys[i], pIDs[testLost], out, end)
+}
+
+func TestE2E(t *testing.T) {
+	setUp("info")
+
+	keys, allPIDs, err := keygen.LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	pIDs := allPIDs[:testThreshold+2]
+
+	p2pCtx := tss.NewPeerContext(pIDs)
+	outCh := make(chan tss.Message, len(pIDs)*len(pIDs))
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+	net := netsim.New(1)
+	for i, pID := range pIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(pIDs), testThreshold)
+		assert.NoError(t, net.Register(newParty(params, keys, pIDs, i, outCh, endCh)))
+	}
+	results, err := net.Run(context.Background(), outCh, time.Second)
+	assert.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, netsim.Finished, r.Outcome, r.String())
+	}
+
+	saves := make([]keygen.LocalPartySaveData, len(pIDs))
+	for range pIDs {
+		save := <-endCh
+		index, err := save.OriginalIndex()
+		assert.NoError(t, err)
+		saves[index] = save
+	}
+	lost, recovered := keys[testLost], saves[testLost]
+	assert.Equal(t, 0, lost.Xi.Cmp(recovered.Xi), "the share should be recovered")
+	assert.Equal(t, 0, lost.ShareID.Cmp(recovered.ShareID))
+	assert.True(t, recovered.ECDSAPub.Equals(lost.ECDSAPub))
+	assert.True(t, recovered.ValidateWithProof())
+	assert.Equal(t, lost.Ks, recovered.Ks)
+	for j := range lost.Ks {
+		assert.True(t, recovered.BigXj[j].Equals(lost.BigXj[j]))
+		assert.Equal(t, lost.PaillierPKs[j].N, recovered.PaillierPKs[j].N)
+		assert.Equal(t, lost.NTildej[j], recovered.NTildej[j])
+	}
+	for i, save := range saves {
+		if i == testLost {
+			continue
+		}
+		assert.Equal(t, 0, keys[i].Xi.Cmp(save.Xi), "the shares of the helpers should not change")
+		assert.Equal(t, recovered.PaillierSK.N, save.PaillierPKs[testLost].N)
+		assert.Equal(t, recovered.NTildei, save.NTildej[testLost])
+	}
+
+	// sign with the recovered share
+	signers := append([]keygen.LocalPartySaveData{}, keys[:testThreshold+1]...)
+	signers[testLost] = recovered
+	signPIDs := allPIDs[:testThreshold+1]
+	p2pCtx = tss.NewPeerContext(signPIDs)
+	signOutCh := make(chan tss.Message, len(signPIDs)*len(signPIDs))
+	signEndCh := make(chan common.SignatureData, len(signPIDs))
+	msg := big.NewInt(42)
+	net = netsim.New(1)
+	for i, pID := range signPIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(signPIDs), testThreshold)
+		assert.NoError(t, net.Register(signing.NewLocalParty(msg, params, signers[i], signOutCh, signEndCh)))
+	}
+	results, err = net.Run(context.Background(), signOutCh, time.Second)
+	assert.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, netsim.Finished, r.Outcome, r.String())
+	}
+	sig := (<-signEndCh).Signature // R || S
+	pk := ecdsa.PublicKey{Curve: tss.S256(), X: lost.ECDSAPub.X(), Y: lost.ECDSAPub.Y()}
+	ok := ecdsa.Verify(&pk, msg.Bytes(), new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:]))
+	assert.True(t, ok, "ecdsa verify must pass with the recovered share")
+}
+
+func TestE2EEnrol(t *testing.T) {
+	setUp("info")
+
+	keys, allPIDs, err := keygen.LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	// the key is held by all fixtures but the last, whose pre-params the newcomer reuses
+	holderPIDs := allPIDs[:testParticipants-1]
+	holders := make([]keygen.LocalPartySaveData, len(holderPIDs))
+	for i := range holderPIDs {
+		holders[i] = keygen.BuildLocalSaveDataSubset(keys[i], holderPIDs)
+	}
+	newcomer := tss.NewPartyID("newcomer", "N", common.GetRandomPositiveInt(tss.S256().Params().N))
+	unsorted := tss.UnSortedPartyIDs{newcomer}
+	for _, pID := range holderPIDs[:testThreshold+1] {
+		unsorted = append(unsorted, tss.NewPartyID(pID.Id, pID.Moniker, pID.KeyInt()))
+	}
+	pIDs := tss.SortPartyIDs(unsorted)
+
+	p2pCtx := tss.NewPeerContext(pIDs)
+	outCh := make(chan tss.Message, len(pIDs)*len(pIDs))
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+	net := netsim.New(1)
+	for _, pID := range pIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(pIDs), testThreshold)
+		if pID == newcomer {
+			assert.NoError(t, net.Register(NewLocalPartyToEnrol(params, outCh, endCh, keys[testParticipants-1].LocalPreParams)))
+			continue
+		}
+		for _, key := range holders {
+			if key.ShareID.Cmp(pID.KeyInt()) == 0 {
+				assert.NoError(t, net.Register(NewLocalPartyForEnrolment(params, key, newcomer, outCh, endCh)))
+			}
+		}
+	}
+	results, err := net.Run(context.Background(), outCh, time.Second)
+	assert.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, netsim.Finished, r.Outcome, r.String())
+	}
+
+	var enrolled keygen.LocalPartySaveData
+	saves := make([]keygen.LocalPartySaveData, 0, len(pIDs)-1)
+	for range pIDs {
+		save := <-endCh
+		if save.ShareID.Cmp(newcomer.KeyInt()) == 0 {
+			enrolled = save
+			continue
+		}
+		saves = append(saves, save)
+	}
+	kN := len(holderPIDs)
+	assert.True(t, enrolled.ValidateWithProof())
+	assert.True(t, enrolled.ECDSAPub.Equals(keys[0].ECDSAPub))
+	assert.Equal(t, kN+1, len(enrolled.Ks))
+	assert.True(t, crypto.ScalarBaseMult(tss.S256(), enrolled.Xi).Equals(enrolled.BigXj[kN]))
+	for _, save := range saves {
+		assert.Equal(t, kN+1, len(save.Ks))
+		assert.Equal(t, kN+1, len(save.H2j))
+		assert.Equal(t, 0, save.Ks[kN].Cmp(newcomer.KeyInt()))
+		assert.True(t, save.BigXj[kN].Equals(enrolled.BigXj[kN]))
+		assert.Equal(t, enrolled.PaillierSK.N, save.PaillierPKs[kN].N)
+		assert.Equal(t, enrolled.NTildei, save.NTildej[kN])
+		for k := 0; k < kN; k++ {
+			assert.True(t, save.BigXj[k].Equals(enrolled.BigXj[k]))
+		}
+	}
+
+	// a holder that did not take part is given the entries of the newcomer, made for its ring-Pedersen parameters
+	outsiderPID, outsider := holderPIDs[len(holderPIDs)-1], holders[len(holders)-1]
+	session := []byte("ecdsa-enrolment-entries")
+	entries, err := NewEntries(rand.Reader, session, enrolled, outsider.NTildei, outsider.H1i, outsider.H2i)
+	if !assert.NoError(t, err) {
+		return
+	}
+	impostor := enrolled
+	impostor.Xi = common.GetRandomPositiveInt(tss.S256().Params().N)
+	forged, err := NewEntries(rand.Reader, session, impostor, outsider.NTildei, outsider.H1i, outsider.H2i)
+	if assert.NoError(t, err) {
+		_, err = ApplyEntries(tss.S256(), session, outsider, forged)
+		assert.True(t, errors.Is(err, &tss.ProofError{Proof: tss.ProofSchnorr}), "entries without the share should be rejected: %v", err)
+	}
+	swapped := *entries
+	swapped.PaillierPK = &keys[0].PaillierSK.PublicKey
+	_, err = ApplyEntries(tss.S256(), session, outsider, &swapped)
+	assert.True(t, errors.Is(err, &tss.ProofError{Proof: tss.ProofSchnorr}), "entries with another Paillier key should be rejected: %v", err)
+	_, err = ApplyEntries(tss.S256(), []byte("another session"), outsider, entries)
+	assert.True(t, errors.Is(err, &tss.ProofError{Proof: tss.ProofSchnorr}), "entries from another session should be rejected: %v", err)
+	_, err = ApplyEntries(tss.S256(), session, holders[0], entries)
+	assert.True(t, errors.Is(err, &tss.ProofError{Proof: tss.ProofPaillierFactor}), "entries for another holder should be rejected: %v", err)
+	outsider, err = ApplyEntries(tss.S256(), session, outsider, entries)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, kN+1, len(outsider.Ks))
+	assert.Equal(t, 0, outsider.Ks[kN].Cmp(newcomer.KeyInt()))
+	assert.True(t, outsider.BigXj[kN].Equals(enrolled.BigXj[kN]), "the interpolated Xj should be that of the newcomer")
+	assert.Equal(t, enrolled.PaillierSK.N, outsider.PaillierPKs[kN].N)
+	assert.Equal(t, enrolled.NTildei, outsider.NTildej[kN])
+	assert.Equal(t, kN, len(holders[len(holders)-1].Ks), "the save data passed in should not change")
+	saves = append(saves, outsider)
+
+	// sign with the newcomer, the holder that did not take part and t-1 holders that did
+	unsorted = tss.UnSortedPartyIDs{
+		tss.NewPartyID(newcomer.Id, newcomer.Moniker, newcomer.KeyInt()),
+		tss.NewPartyID(outsiderPID.Id, outsiderPID.Moniker, outsiderPID.KeyInt()),
+	}
+	for _, pID := range holderPIDs[:testThreshold-1] {
+		unsorted = append(unsorted, tss.NewPartyID(pID.Id, pID.Moniker, pID.KeyInt()))
+	}
+	signPIDs := tss.SortPartyIDs(unsorted)
+	signers := make([]keygen.LocalPartySaveData, len(signPIDs))
+	for i, pID := range signPIDs {
+		signers[i] = enrolled
+		for _, save := range saves {
+			if save.ShareID.Cmp(pID.KeyInt()) == 0 {
+				signers[i] = save
+			}
+		}
+	}
+	p2pCtx = tss.NewPeerContext(signPIDs)
+	signOutCh := make(chan tss.Message, len(signPIDs)*len(signPIDs))
+	signEndCh := make(chan common.SignatureData, len(signPIDs))
+	msg := big.NewInt(42)
+	net = netsim.New(1)
+	for i, pID := range signPIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(signPIDs), testThreshold)
+		assert.NoError(t, net.Register(signing.NewLocalParty(msg, params, signers[i], signOutCh, signEndCh)))
+	}
+	results, err = net.Run(context.Background(), signOutCh, time.Second)
+	assert.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, netsim.Finished, r.Outcome, r.String())
+	}
+	sig := (<-signEndCh).Signature // R || S
+	pk := ecdsa.PublicKey{Curve: tss.S256(), X: enrolled.ECDSAPub.X(), Y: enrolled.ECDSAPub.Y()}
+	ok := ecdsa.Verify(&pk, msg.Bytes(), new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:]))
+	assert.True(t, ok, "ecdsa verify must pass with the enrolled share")
+}
+
+func TestEnrolHolderPanics(t *testing.T) {
+	keys, allPIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 2)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	// a ShareID is taken mod the curve order, so a newcomer may not take that of a holder plus the order
+	holder := allPIDs[testThreshold+1]
+	newcomer := tss.NewPartyID("newcomer", "N", new(big.Int).Add(holder.KeyInt(), tss.S256().Params().N))
+	unsorted := tss.UnSortedPartyIDs{newcomer}
+	for _, pID := range allPIDs[:testThreshold+1] {
+		unsorted = append(unsorted, tss.NewPartyID(pID.Id, pID.Moniker, pID.KeyInt()))
+	}
+	pIDs := tss.SortPartyIDs(unsorted)
+	params := tss.NewParameters(tss.S256(), tss.NewPeerContext(pIDs), pIDs.FindByKey(allPIDs[0].KeyInt()), len(pIDs), testThreshold)
+	assert.Panics(t, func() {
+		NewLocalPartyForEnrolment(params, keys[0], newcomer, nil, nil)
+	})
+}
+
+func TestE2ECheater(t *testing.T) {
+	setUp("info")
+
+	keys, allPIDs, err := keygen.LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	pIDs := allPIDs[:testThreshold+2]
+	p2pCtx := tss.NewPeerContext(pIDs)
+	newParams := func(i int) *tss.Parameters {
+		return tss.NewParameters(tss.S256(), p2pCtx, pIDs[i], len(pIDs), testThreshold)
+	}
+
+	for _, tc := range []struct {
+		cheater    int
+		corruption adversary.Corruption
+		cause      error
+	}{
+		{0, adversary.Corruption{MessageType: adversary.MessageType(&RecoveryRound1Message3{}), Corrupt: adversary.TamperField("share")}, tss.ErrBadShare},
+		{0, adversary.Corruption{MessageType: adversary.MessageType(&RecoveryRound1Message1{}), Corrupt: adversary.TamperField("n_tildes")}, tss.ErrInvalidMessage},
+		{0, adversary.Corruption{MessageType: adversary.MessageType(&RecoveryRound2Message1{}), Corrupt: adversary.TamperField("sigma")}, tss.ErrBadShare},
+		{testLost, adversary.Corruption{MessageType: adversary.MessageType(&RecoveryRound1Message2{}), Corrupt: adversary.TamperField("modproof.w")}, &tss.ProofError{Proof: tss.ProofPaillierMod}},
+		{testLost, adversary.Corruption{MessageType: adversary.MessageType(&RecoveryRound2Message2{}), Corrupt: adversary.TamperField("facproof.z1")}, &tss.ProofError{Proof: tss.ProofPaillierFactor}},
+	} {
+		endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+		results, err := adversary.Run(len(pIDs), tc.cheater, newParams, func(i int, params *tss.Parameters, out chan<- tss.Message) tss.Party {
+			return newParty(params, keys, pIDs, i, out, endCh)
+		}, tc.corruption)
+		assert.NoError(t, err)
+		errored := 0
+		for _, r := range results {
+			// a party that is not started with a context may still finish after it failed, so its error is checked
+			if r.Err == nil {
+				continue
+			}
+			errored++
+			assert.True(t, adversary.BlamesOnly(r.Err, pIDs[tc.cheater]), "honest parties should blame the cheater alone: %s", r)
+			assert.True(t, errors.Is(r.Err, tc.cause), "unexpected cause: %s", r)
+		}
+		assert.NotZero(t, errored, "the cheating should be detected")
+	}
+}
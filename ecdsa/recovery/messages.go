@@ -0,0 +1,378 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package recovery
+
+import (
+	"bytes"
+	"crypto/elliptic"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/dlnproof"
+	"github.com/bnb-chain/tss-lib/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// These messages were generated from Protocol Buffers definitions into ecdsa-recovery.pb.go
+// The following messages are registered on the Protocol Buffers "wire"
+
+var (
+	// Ensure that recovery messages implement ValidateBasic
+	_ = []tss.MessageContent{
+		(*RecoveryRound1Message1)(nil),
+		(*RecoveryRound1Message2)(nil),
+		(*RecoveryRound1Message3)(nil),
+		(*RecoveryRound2Message1)(nil),
+		(*RecoveryRound2Message2)(nil),
+	}
+)
+
+// ----- //
+
+// NewRecoveryRound1Message1 makes the round 1 broadcast of a helper, with the public parts of its save data
+func NewRecoveryRound1Message1(
+	from *tss.PartyID,
+	commitments []*crypto.ECPoint,
+	key *keygen.LocalPartySaveData,
+) (tss.ParsedMessage, error) {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	commitmentsFlat, err := crypto.FlattenECPoints(commitments)
+	if err != nil {
+		return nil, err
+	}
+	bigXjFlat, err := crypto.FlattenECPoints(key.BigXj)
+	if err != nil {
+		return nil, err
+	}
+	paillierNs := make([]*big.Int, len(key.PaillierPKs))
+	for j, pk := range key.PaillierPKs {
+		if pk != nil {
+			paillierNs[j] = pk.N
+		}
+	}
+	content := &RecoveryRound1Message1{
+		Commitments: common.BigIntsToBytes(commitmentsFlat),
+		Ks:          common.BigIntsToBytes(key.Ks),
+		BigXj:       common.BigIntsToBytes(bigXjFlat),
+		PaillierNs:  common.BigIntsToBytes(paillierNs),
+		NTildes:     common.BigIntsToBytes(key.NTildej),
+		H1S:         common.BigIntsToBytes(key.H1j),
+		H2S:         common.BigIntsToBytes(key.H2j),
+		EcdsaPub:    common.BigIntsToBytes([]*big.Int{key.ECDSAPub.X(), key.ECDSAPub.Y()}),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg), nil
+}
+
+func (m *RecoveryRound1Message1) ValidateBasic() bool {
+	if m == nil || !common.NonEmptyMultiBytes(m.GetCommitments()) || len(m.GetCommitments())%2 != 0 {
+		return false
+	}
+	n := len(m.GetKs())
+	return common.NonEmptyMultiBytes(m.GetKs()) &&
+		common.NonEmptyMultiBytes(m.GetBigXj(), 2*n) &&
+		common.NonEmptyMultiBytes(m.GetPaillierNs(), n) &&
+		common.NonEmptyMultiBytes(m.GetNTildes(), n) &&
+		common.NonEmptyMultiBytes(m.GetH1S(), n) &&
+		common.NonEmptyMultiBytes(m.GetH2S(), n) &&
+		common.NonEmptyMultiBytes(m.GetEcdsaPub(), 2)
+}
+
+func (m *RecoveryRound1Message1) UnmarshalCommitments(ec elliptic.Curve) ([]*crypto.ECPoint, error) {
+	return crypto.UnFlattenECPoints(ec, common.MultiBytesToBigInts(m.GetCommitments()))
+}
+
+// UnmarshalSaveData returns the public parts of the save data sent by the helper
+func (m *RecoveryRound1Message1) UnmarshalSaveData(ec elliptic.Curve) (keygen.LocalPartySaveData, error) {
+	n := len(m.GetKs())
+	save := keygen.NewLocalPartySaveData(n)
+	bigXj, err := crypto.UnFlattenECPoints(ec, common.MultiBytesToBigInts(m.GetBigXj()))
+	if err != nil {
+		return save, err
+	}
+	ecdsaPub, err := crypto.NewECPoint(ec, new(big.Int).SetBytes(m.GetEcdsaPub()[0]), new(big.Int).SetBytes(m.GetEcdsaPub()[1]))
+	if err != nil {
+		return save, err
+	}
+	save.Ks = common.MultiBytesToBigInts(m.GetKs())
+	save.BigXj = bigXj
+	save.NTildej = common.MultiBytesToBigInts(m.GetNTildes())
+	save.H1j = common.MultiBytesToBigInts(m.GetH1S())
+	save.H2j = common.MultiBytesToBigInts(m.GetH2S())
+	for j, N := range common.MultiBytesToBigInts(m.GetPaillierNs()) {
+		save.PaillierPKs[j] = &paillier.PublicKey{N: N}
+	}
+	save.ECDSAPub = ecdsaPub
+	return save, nil
+}
+
+// SameSaveData reports whether two helpers sent the same public save data
+func (m *RecoveryRound1Message1) SameSaveData(other *RecoveryRound1Message1) bool {
+	for _, pair := range [][2][][]byte{
+		{m.GetKs(), other.GetKs()},
+		{m.GetBigXj(), other.GetBigXj()},
+		{m.GetPaillierNs(), other.GetPaillierNs()},
+		{m.GetNTildes(), other.GetNTildes()},
+		{m.GetH1S(), other.GetH1S()},
+		{m.GetH2S(), other.GetH2S()},
+		{m.GetEcdsaPub(), other.GetEcdsaPub()},
+	} {
+		if len(pair[0]) != len(pair[1]) {
+			return false
+		}
+		for k := range pair[0] {
+			if !bytes.Equal(pair[0][k], pair[1][k]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// ----- //
+
+// NewRecoveryRound1Message2 makes the round 1 broadcast of the target, with its new Paillier key
+// and ring-Pedersen parameters
+func NewRecoveryRound1Message2(
+	from *tss.PartyID,
+	paillierPK *paillier.PublicKey,
+	nTildeI, h1I, h2I *big.Int,
+	dlnProof1, dlnProof2 *dlnproof.Proof,
+	modProof, modProofTilde *paillier.ModProof,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &RecoveryRound1Message2{
+		PaillierN:     paillierPK.N.Bytes(),
+		NTilde:        nTildeI.Bytes(),
+		H1:            h1I.Bytes(),
+		H2:            h2I.Bytes(),
+		Dlnproof_1:    newDLNProof(dlnProof1),
+		Dlnproof_2:    newDLNProof(dlnProof2),
+		Modproof:      newModProof(modProof),
+		ModproofTilde: newModProof(modProofTilde),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *RecoveryRound1Message2) ValidateBasic() bool {
+	return m != nil &&
+		common.NonEmptyBytes(m.GetPaillierN()) &&
+		common.NonEmptyBytes(m.GetNTilde()) &&
+		common.NonEmptyBytes(m.GetH1()) &&
+		common.NonEmptyBytes(m.GetH2()) &&
+		m.GetDlnproof_1().ValidateBasic() &&
+		m.GetDlnproof_2().ValidateBasic() &&
+		m.GetModproof().ValidateBasic() &&
+		m.GetModproofTilde().ValidateBasic()
+}
+
+func (m *RecoveryRound1Message2) UnmarshalPaillierPK() *paillier.PublicKey {
+	return &paillier.PublicKey{N: new(big.Int).SetBytes(m.GetPaillierN())}
+}
+
+func (m *RecoveryRound1Message2) UnmarshalNTilde() *big.Int {
+	return new(big.Int).SetBytes(m.GetNTilde())
+}
+
+func (m *RecoveryRound1Message2) UnmarshalH1() *big.Int {
+	return new(big.Int).SetBytes(m.GetH1())
+}
+
+func (m *RecoveryRound1Message2) UnmarshalH2() *big.Int {
+	return new(big.Int).SetBytes(m.GetH2())
+}
+
+func (m *RecoveryRound1Message2) UnmarshalDLNProof1() (*dlnproof.Proof, error) {
+	p := m.GetDlnproof_1()
+	return dlnproof.UnmarshalDLNProof(p.GetAlpha(), p.GetT())
+}
+
+func (m *RecoveryRound1Message2) UnmarshalDLNProof2() (*dlnproof.Proof, error) {
+	p := m.GetDlnproof_2()
+	return dlnproof.UnmarshalDLNProof(p.GetAlpha(), p.GetT())
+}
+
+func (m *RecoveryRound1Message2) UnmarshalModProof() (*paillier.ModProof, error) {
+	p := m.GetModproof()
+	return paillier.UnmarshalModProof(p.GetW(), p.GetX(), p.GetA(), p.GetB(), p.GetZ())
+}
+
+func (m *RecoveryRound1Message2) UnmarshalModProofTilde() (*paillier.ModProof, error) {
+	p := m.GetModproofTilde()
+	return paillier.UnmarshalModProof(p.GetW(), p.GetX(), p.GetA(), p.GetB(), p.GetZ())
+}
+
+func newDLNProof(proof *dlnproof.Proof) *RecoveryRound1Message2_DLNProof {
+	return &RecoveryRound1Message2_DLNProof{
+		Alpha: common.BigIntsToBytes(proof.Alpha[:]),
+		T:     common.BigIntsToBytes(proof.T[:]),
+	}
+}
+
+func newModProof(proof *paillier.ModProof) *RecoveryRound1Message2_ModProof {
+	return &RecoveryRound1Message2_ModProof{
+		W: proof.W.Bytes(),
+		X: common.BigIntsToBytes(proof.X[:]),
+		A: proof.A[:],
+		B: proof.B[:],
+		Z: common.BigIntsToBytes(proof.Z[:]),
+	}
+}
+
+func (p *RecoveryRound1Message2_DLNProof) ValidateBasic() bool {
+	return p != nil &&
+		common.NonEmptyMultiBytes(p.GetAlpha(), dlnproof.Iterations) &&
+		common.NonEmptyMultiBytes(p.GetT(), dlnproof.Iterations)
+}
+
+func (p *RecoveryRound1Message2_ModProof) ValidateBasic() bool {
+	return p != nil &&
+		common.NonEmptyBytes(p.GetW()) &&
+		common.NonEmptyMultiBytes(p.GetX(), paillier.PARAM_M) &&
+		common.NonEmptyBools(p.GetA(), paillier.PARAM_M) &&
+		common.NonEmptyBools(p.GetB(), paillier.PARAM_M) &&
+		common.NonEmptyMultiBytes(p.GetZ(), paillier.PARAM_M)
+}
+
+// ----- //
+
+func NewRecoveryRound1Message3(
+	to, from *tss.PartyID,
+	share *big.Int,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	content := &RecoveryRound1Message3{
+		Share: share.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *RecoveryRound1Message3) ValidateBasic() bool {
+	return m != nil && common.NonEmptyBytes(m.GetShare())
+}
+
+func (m *RecoveryRound1Message3) UnmarshalShare() *big.Int {
+	return new(big.Int).SetBytes(m.GetShare())
+}
+
+// ----- //
+
+func NewRecoveryRound2Message1(
+	to, from *tss.PartyID,
+	sigma *big.Int,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	content := &RecoveryRound2Message1{
+		Sigma: sigma.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *RecoveryRound2Message1) ValidateBasic() bool {
+	return m != nil && common.NonEmptyBytes(m.GetSigma())
+}
+
+func (m *RecoveryRound2Message1) UnmarshalSigma() *big.Int {
+	return new(big.Int).SetBytes(m.GetSigma())
+}
+
+// ----- //
+
+func NewRecoveryRound2Message2(
+	to, from *tss.PartyID,
+	proof, proofTilde *paillier.FactorProof,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	content := &RecoveryRound2Message2{
+		Facproof:      newFactorProof(proof),
+		FacproofTilde: newFactorProof(proofTilde),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *RecoveryRound2Message2) ValidateBasic() bool {
+	return m != nil && m.GetFacproof().ValidateBasic() && m.GetFacproofTilde().ValidateBasic()
+}
+
+func (m *RecoveryRound2Message2) UnmarshalFactorProof() *paillier.FactorProof {
+	return m.GetFacproof().unmarshal()
+}
+
+func (m *RecoveryRound2Message2) UnmarshalFactorProofTilde() *paillier.FactorProof {
+	return m.GetFacproofTilde().unmarshal()
+}
+
+func newFactorProof(proof *paillier.FactorProof) *RecoveryRound2Message2_FactorProof {
+	return &RecoveryRound2Message2_FactorProof{
+		P:     common.MarshalSigned(proof.P),
+		Q:     common.MarshalSigned(proof.Q),
+		A:     common.MarshalSigned(proof.A),
+		B:     common.MarshalSigned(proof.B),
+		T:     common.MarshalSigned(proof.T),
+		Sigma: common.MarshalSigned(proof.Sigma),
+		Z1:    common.MarshalSigned(proof.Z1),
+		Z2:    common.MarshalSigned(proof.Z2),
+		W1:    common.MarshalSigned(proof.W1),
+		W2:    common.MarshalSigned(proof.W2),
+		V:     common.MarshalSigned(proof.V),
+	}
+}
+
+func (proof *RecoveryRound2Message2_FactorProof) unmarshal() *paillier.FactorProof {
+	return &paillier.FactorProof{
+		P:     common.UnmarshalSigned(proof.GetP()),
+		Q:     common.UnmarshalSigned(proof.GetQ()),
+		A:     common.UnmarshalSigned(proof.GetA()),
+		B:     common.UnmarshalSigned(proof.GetB()),
+		T:     common.UnmarshalSigned(proof.GetT()),
+		Sigma: common.UnmarshalSigned(proof.GetSigma()),
+		Z1:    common.UnmarshalSigned(proof.GetZ1()),
+		Z2:    common.UnmarshalSigned(proof.GetZ2()),
+		W1:    common.UnmarshalSigned(proof.GetW1()),
+		W2:    common.UnmarshalSigned(proof.GetW2()),
+		V:     common.UnmarshalSigned(proof.GetV()),
+	}
+}
+
+func (proof *RecoveryRound2Message2_FactorProof) ValidateBasic() bool {
+	return proof != nil &&
+		common.NonEmptyBytes(proof.GetP()) &&
+		common.NonEmptyBytes(proof.GetQ()) &&
+		common.NonEmptyBytes(proof.GetA()) &&
+		common.NonEmptyBytes(proof.GetB()) &&
+		common.NonEmptyBytes(proof.GetT()) &&
+		common.NonEmptyBytes(proof.GetSigma()) &&
+		common.NonEmptyBytes(proof.GetZ1()) &&
+		common.NonEmptyBytes(proof.GetZ2()) &&
+		common.NonEmptyBytes(proof.GetW1()) &&
+		common.NonEmptyBytes(proof.GetW2()) &&
+		common.NonEmptyBytes(proof.GetV())
+}
@@ -0,0 +1,162 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package recovery
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+const (
+	paillierBitsLen = 2048
+)
+
+// round 1 splits the weighted share of each helper among the helpers, and announces the new Paillier key of the target
+func newRound1(params *tss.Parameters, save *keygen.LocalPartySaveData, temp *localTempData, out chan<- tss.Message, end chan<- keygen.LocalPartySaveData) tss.Round {
+	return &round1{
+		&base{params, save, temp, out, end, make([]bool, len(params.Parties().IDs())), false, 1}}
+}
+
+func (round *round1) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	round.number = 1
+	round.started = true
+	round.resetOK()
+
+	if round.PartyID().Index == round.temp.target {
+		return round.startTarget()
+	}
+	return round.startHelper()
+}
+
+// startHelper splits wi = λi * xi, where λi is the Lagrange coefficient at the ShareID of the target, into one random part per helper
+func (round *round1) startHelper() *tss.Error {
+	ec := round.Params().EC()
+	Ps := round.Parties().IDs()
+	Pi := round.PartyID()
+	i := Pi.Index
+	modQ := common.ModInt(ec.Params().N)
+
+	helpers := round.helpers()
+	wi := modQ.Mul(round.lagrange(i), round.save.Xi)
+	shares := make([]*big.Int, len(helpers))
+	commitments := make([]*crypto.ECPoint, len(helpers))
+	last := wi
+	for h := range helpers {
+		if h < len(helpers)-1 {
+			shares[h] = common.GetRandomPositiveIntWithRand(round.Rand(), ec.Params().N)
+			last = modQ.Sub(last, shares[h])
+		} else {
+			shares[h] = last
+		}
+		commitments[h] = crypto.ScalarBaseMult(ec, shares[h])
+	}
+	round.temp.shares = shares
+	round.temp.commitments[i] = commitments
+
+	// BROADCAST the commitments to the split along with the public save data
+	r1msg1, err := NewRecoveryRound1Message1(Pi, commitments, round.save)
+	if err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err), Pi)
+	}
+	round.temp.recoveryRound1Message1s[i] = r1msg1
+	if err := tss.SendMessage(round.Params(), round.out, r1msg1); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
+
+	// P2P send each part to its helper
+	for h, j := range helpers {
+		r1msg3 := NewRecoveryRound1Message3(Ps[j], Pi, shares[h])
+		if j == i {
+			round.temp.recoveryRound1Message3s[j] = r1msg3
+			continue
+		}
+		if err := tss.SendMessage(round.Params(), round.out, r1msg3); err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+		}
+	}
+	return nil
+}
+
+// startTarget prepares the new Paillier key and ring-Pedersen parameters of the target and proves them
+func (round *round1) startTarget() *tss.Error {
+	Pi := round.PartyID()
+	i := Pi.Index
+
+	preParams := round.temp.preParams
+	if preParams == nil {
+		ctx, cancel := context.WithTimeout(round.Context(), round.SafePrimeGenTimeout())
+		defer cancel()
+		var err error
+		preParams, err = keygen.GeneratePreParamsWithRand(ctx, round.Rand(), round.Params().Logger(), round.Concurrency())
+		if err != nil {
+			return round.WrapError(fmt.Errorf("%w: pre-params generation failed", tss.ErrInternal), Pi)
+		}
+		round.temp.preParams = preParams
+	}
+
+	round.temp.skTilde = preParams.NTildeKey()
+	proofs := preParams.Prove(round.Rand(), round.SessionID(), round.Params().Metrics())
+
+	// BROADCAST the Paillier key and ring-Pedersen parameters with their proofs
+	r1msg2 := NewRecoveryRound1Message2(Pi, &preParams.PaillierSK.PublicKey, preParams.NTildei, preParams.H1i, preParams.H2i,
+		proofs.DLNProof1, proofs.DLNProof2, proofs.ModProof, proofs.ModProofTilde)
+	round.temp.recoveryRound1Message2s[i] = r1msg2
+	if err := tss.SendMessage(round.Params(), round.out, r1msg2); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
+	return nil
+}
+
+func (round *round1) CanAccept(msg tss.ParsedMessage) bool {
+	switch msg.Content().(type) {
+	case *RecoveryRound1Message1, *RecoveryRound1Message2:
+		return msg.IsBroadcast()
+	case *RecoveryRound1Message3:
+		return !msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round1) Update() (bool, *tss.Error) {
+	isTarget := round.PartyID().Index == round.temp.target
+	for j := range round.ok {
+		if round.ok[j] {
+			continue
+		}
+		var msgs []tss.ParsedMessage
+		switch {
+		case j == round.temp.target:
+			msgs = []tss.ParsedMessage{round.temp.recoveryRound1Message2s[j]}
+		case isTarget:
+			msgs = []tss.ParsedMessage{round.temp.recoveryRound1Message1s[j]}
+		default:
+			msgs = []tss.ParsedMessage{round.temp.recoveryRound1Message1s[j], round.temp.recoveryRound1Message3s[j]}
+		}
+		for _, msg := range msgs {
+			if msg == nil || !round.CanAccept(msg) {
+				return false, nil
+			}
+		}
+		// proof checks are in round 2
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round1) NextRound() tss.Round {
+	round.started = false
+	return &round2{round}
+}
@@ -0,0 +1,350 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package recovery
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func (round *round2) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	round.number = 2
+	round.started = true
+	round.resetOK()
+
+	if round.PartyID().Index == round.temp.target {
+		return round.startTarget()
+	}
+	return round.startHelper()
+}
+
+// startHelper checks the messages of round 1 and sends the sum of the parts this helper received to the target
+func (round *round2) startHelper() *tss.Error {
+	ec := round.Params().EC()
+	Ps := round.Parties().IDs()
+	Pi := round.PartyID()
+	i := Pi.Index
+	T := round.temp.target
+	modQ := common.ModInt(ec.Params().N)
+
+	// 1. the other helpers must hold the same public save data
+	{
+		own := round.temp.recoveryRound1Message1s[i].Content().(*RecoveryRound1Message1)
+		culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+		for _, j := range round.helpers() {
+			r1msg1 := round.temp.recoveryRound1Message1s[j].Content().(*RecoveryRound1Message1)
+			if j != i && !own.SameSaveData(r1msg1) {
+				culprits = append(culprits, Ps[j])
+			}
+		}
+		if len(culprits) > 0 {
+			return round.WrapError(fmt.Errorf("%w: the public save data does not match ours", tss.ErrInvalidMessage), culprits...)
+		}
+	}
+
+	// 2. check the commitments and the parts received from the other helpers
+	if err := round.verifyCommitments(); err != nil {
+		return err
+	}
+	sigma := big.NewInt(0)
+	{
+		var multiErr error
+		culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+		h := helperPos(i, T)
+		for _, j := range round.helpers() {
+			share := round.temp.recoveryRound1Message3s[j].Content().(*RecoveryRound1Message3).UnmarshalShare()
+			if !crypto.ScalarBaseMult(ec, share).Equals(round.temp.commitments[j][h]) {
+				multiErr = multierror.Append(multiErr, tss.ErrBadShare)
+				culprits = append(culprits, Ps[j])
+				continue
+			}
+			sigma = modQ.Add(sigma, share)
+		}
+		if len(culprits) > 0 {
+			return round.WrapError(multiErr, culprits...)
+		}
+	}
+
+	// 3. check the new Paillier key and ring-Pedersen parameters of the target, which must stay unique
+	r1msg2 := round.temp.recoveryRound1Message2s[T].Content().(*RecoveryRound1Message2)
+	NT, NTildeT, H1T, H2T := r1msg2.UnmarshalPaillierPK().N, r1msg2.UnmarshalNTilde(), r1msg2.UnmarshalH1(), r1msg2.UnmarshalH2()
+	if NT.BitLen() != paillierBitsLen || NTildeT.BitLen() != paillierBitsLen {
+		return round.WrapError(fmt.Errorf("%w: got a modulus with insufficient bits", tss.ErrInvalidMessage), Ps[T])
+	}
+	if H1T.Cmp(H2T) == 0 {
+		return round.WrapError(fmt.Errorf("%w: h1j and h2j were equal for this party", tss.ErrInvalidMessage), Ps[T])
+	}
+	if err := round.verifyPaillierProofs(r1msg2); err != nil {
+		return err
+	}
+	h1H2Map := map[string]struct{}{
+		hex.EncodeToString(H1T.Bytes()): {},
+		hex.EncodeToString(H2T.Bytes()): {},
+	}
+	for k := range round.save.Ks {
+		if k == round.temp.keyIdx[T] {
+			continue
+		}
+		_, h1Found := h1H2Map[hex.EncodeToString(round.save.H1j[k].Bytes())]
+		_, h2Found := h1H2Map[hex.EncodeToString(round.save.H2j[k].Bytes())]
+		if h1Found || h2Found {
+			return round.WrapError(fmt.Errorf("%w: this h1j or h2j was already used by another party", tss.ErrInvalidMessage), Ps[T])
+		}
+	}
+
+	// 4. p2p send sigma to the target
+	r2msg1 := NewRecoveryRound2Message1(Ps[T], Pi, sigma)
+	if err := tss.SendMessage(round.Params(), round.out, r2msg1); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
+	return nil
+}
+
+// startTarget takes the public save data held by most helpers, checks the commitments and proves the factors of its new moduli
+func (round *round2) startTarget() *tss.Error {
+	ec := round.Params().EC()
+	Ps := round.Parties().IDs()
+	Pi := round.PartyID()
+	i := Pi.Index
+
+	// 1. group the helpers by the public save data they sent; those outside the largest group are blamed
+	helpers := round.helpers()
+	var chosen keygen.LocalPartySaveData
+	{
+		var multiErr error
+		culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+		groups := make([][]int, 0, len(helpers))
+		for _, j := range helpers {
+			r1msg1 := round.temp.recoveryRound1Message1s[j].Content().(*RecoveryRound1Message1)
+			save, err := r1msg1.UnmarshalSaveData(ec)
+			if err == nil {
+				_, err = indexesInKey(ec.Params().N, save.Ks, Ps, i, round.temp.enrol)
+			}
+			if err != nil {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("%w: bad public save data: %v", tss.ErrInvalidMessage, err))
+				culprits = append(culprits, Ps[j])
+				continue
+			}
+			found := false
+			for g, group := range groups {
+				if round.temp.recoveryRound1Message1s[group[0]].Content().(*RecoveryRound1Message1).SameSaveData(r1msg1) {
+					groups[g], found = append(group, j), true
+					break
+				}
+			}
+			if !found {
+				groups = append(groups, []int{j})
+			}
+		}
+		largest := -1
+		for g, group := range groups {
+			if largest < 0 || len(groups[largest]) < len(group) {
+				largest = g
+			}
+		}
+		for g, group := range groups {
+			if g == largest {
+				continue
+			}
+			for _, j := range group {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("%w: the public save data does not match that of most helpers", tss.ErrInvalidMessage))
+				culprits = append(culprits, Ps[j])
+			}
+		}
+		if len(culprits) > 0 {
+			return round.WrapError(multiErr, culprits...)
+		}
+		var err error
+		chosen, err = round.temp.recoveryRound1Message1s[groups[largest][0]].Content().(*RecoveryRound1Message1).UnmarshalSaveData(ec)
+		if err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+		}
+	}
+
+	// 2. build the save data with the new Paillier key and ring-Pedersen parameters of this party; Xi and the Xj of
+	// a new party are set in round 3
+	keyIdx, err := indexesInKey(round.Params().EC().Params().N, chosen.Ks, Ps, i, round.temp.enrol)
+	if err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
+	round.temp.keyIdx = keyIdx
+	if round.temp.enrol {
+		chosen.Ks = append(chosen.Ks, Pi.KeyInt())
+		chosen.BigXj = append(chosen.BigXj, nil)
+		chosen.PaillierPKs = append(chosen.PaillierPKs, nil)
+		chosen.NTildej = append(chosen.NTildej, nil)
+		chosen.H1j, chosen.H2j = append(chosen.H1j, nil), append(chosen.H2j, nil)
+	}
+	preParams := round.temp.preParams
+	chosen.LocalPreParams = *preParams
+	chosen.ShareID = Pi.KeyInt()
+	chosen.PaillierPKs[keyIdx[i]] = &preParams.PaillierSK.PublicKey
+	chosen.NTildej[keyIdx[i]] = preParams.NTildei
+	chosen.H1j[keyIdx[i]], chosen.H2j[keyIdx[i]] = preParams.H1i, preParams.H2i
+	*round.save = chosen
+
+	h1H2Map := make(map[string]struct{}, len(chosen.Ks)*2)
+	for k := range chosen.Ks {
+		h1JHex, h2JHex := hex.EncodeToString(chosen.H1j[k].Bytes()), hex.EncodeToString(chosen.H2j[k].Bytes())
+		_, h1Found := h1H2Map[h1JHex]
+		_, h2Found := h1H2Map[h2JHex]
+		if h1Found || h2Found {
+			return round.WrapError(fmt.Errorf("%w: this h1j or h2j was already used by another party", tss.ErrInconsistentResult))
+		}
+		h1H2Map[h1JHex], h1H2Map[h2JHex] = struct{}{}, struct{}{}
+	}
+
+	// 3. check the commitments of the helpers
+	if err := round.verifyCommitments(); err != nil {
+		return err
+	}
+
+	// 4. p2p send the proofs that Ni and NTildei have no small factors to each helper
+	for _, j := range helpers {
+		NTildej, H1j, H2j := chosen.NTildej[keyIdx[j]], chosen.H1j[keyIdx[j]], chosen.H2j[keyIdx[j]]
+		start := time.Now()
+		facProof := preParams.PaillierSK.FactorProofWithRand(round.Rand(), round.SessionID(), NTildej, H1j, H2j)
+		round.Params().Metrics().RecordProof(tss.ProofPaillierFactor, tss.ProofGeneration, start)
+		start = time.Now()
+		facProofTilde := round.temp.skTilde.FactorProofWithRand(round.Rand(), round.SessionID(), NTildej, H1j, H2j)
+		round.Params().Metrics().RecordProof(tss.ProofPaillierFactor, tss.ProofGeneration, start)
+		r2msg2 := NewRecoveryRound2Message2(Ps[j], Pi, facProof, facProofTilde)
+		if err := tss.SendMessage(round.Params(), round.out, r2msg2); err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+		}
+	}
+	return nil
+}
+
+// verifyCommitments checks that the commitments of each helper j add up to λj * Xj
+func (round *round2) verifyCommitments() *tss.Error {
+	ec := round.Params().EC()
+	Ps := round.Parties().IDs()
+	helpers := round.helpers()
+	var multiErr error
+	culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+	for _, j := range helpers {
+		r1msg1 := round.temp.recoveryRound1Message1s[j].Content().(*RecoveryRound1Message1)
+		commitments, err := r1msg1.UnmarshalCommitments(ec)
+		if err != nil || len(commitments) != len(helpers) {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("%w: bad commitments", tss.ErrInvalidMessage))
+			culprits = append(culprits, Ps[j])
+			continue
+		}
+		sum := commitments[0]
+		for _, D := range commitments[1:] {
+			if sum, err = sum.Add(D); err != nil {
+				break
+			}
+		}
+		if err != nil || !sum.Equals(round.save.BigXj[round.temp.keyIdx[j]].ScalarMult(round.lagrange(j))) {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("%w: the commitments do not add up to the weighted Xj", tss.ErrInvalidMessage))
+			culprits = append(culprits, Ps[j])
+			continue
+		}
+		round.temp.commitments[j] = commitments
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(multiErr, culprits...)
+	}
+	return nil
+}
+
+// verifyPaillierProofs checks the DLN and mod proofs of the target
+func (round *round2) verifyPaillierProofs(r1msg2 *RecoveryRound1Message2) *tss.Error {
+	verifier := keygen.NewProofVerifierWithContext(round.Context(), round.Concurrency())
+	verifier.SetMetrics(round.Params().Metrics())
+
+	// each verification writes its own flag: DLN proofs 1 and 2, then the mod proofs of N and NTilde
+	var failed [4]bool
+	NT, NTildeT, H1T, H2T := r1msg2.UnmarshalPaillierPK().N, r1msg2.UnmarshalNTilde(), r1msg2.UnmarshalH1(), r1msg2.UnmarshalH2()
+	wg := new(sync.WaitGroup)
+	onDone := func(k int) func(bool) {
+		return func(isValid bool) {
+			failed[k] = !isValid
+			wg.Done()
+		}
+	}
+	wg.Add(4)
+	verifier.VerifyDLNProof1(round.SessionID(), r1msg2, H1T, H2T, NTildeT, onDone(0))
+	verifier.VerifyDLNProof2(round.SessionID(), r1msg2, H2T, H1T, NTildeT, onDone(1))
+	verifier.VerifyModProof(round.SessionID(), r1msg2, NT, onDone(2))
+	verifier.VerifyModProofTilde(round.SessionID(), r1msg2, NTildeT, onDone(3))
+	wg.Wait()
+	// abandoned verifications are not the prover's fault
+	if err := round.Context().Err(); err != nil {
+		return round.WrapError(err)
+	}
+	PT := round.Parties().IDs()[round.temp.target]
+	switch {
+	case failed[0] || failed[1]:
+		return round.WrapError(tss.NewProofError(tss.ProofDLN, nil), PT)
+	case failed[2] || failed[3]:
+		return round.WrapError(tss.NewProofError(tss.ProofPaillierMod, nil), PT)
+	}
+	return nil
+}
+
+func (round *round2) CanAccept(msg tss.ParsedMessage) bool {
+	switch msg.Content().(type) {
+	case *RecoveryRound2Message1, *RecoveryRound2Message2:
+		return !msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round2) Update() (bool, *tss.Error) {
+	isTarget := round.PartyID().Index == round.temp.target
+	for j := range round.ok {
+		if round.ok[j] {
+			continue
+		}
+		var msg tss.ParsedMessage
+		switch {
+		case isTarget && j != round.temp.target:
+			msg = round.temp.recoveryRound2Message1s[j]
+		case !isTarget && j == round.temp.target:
+			msg = round.temp.recoveryRound2Message2s[j]
+		default:
+			// nothing is expected from the other helpers, or from itself
+			round.ok[j] = true
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		// proof checks are in round 3
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round2) NextRound() tss.Round {
+	round.started = false
+	return &round3{round}
+}
+
+// helperPos returns the position of helper j among the helpers, which skip the target at index target
+func helperPos(j, target int) int {
+	if j < target {
+		return j
+	}
+	return j - 1
+}
@@ -0,0 +1,170 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package recovery
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func (round *round3) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	round.number = 3
+	round.started = true
+	round.resetOK()
+
+	if round.PartyID().Index == round.temp.target {
+		return round.finishTarget()
+	}
+	return round.finishHelper()
+}
+
+// finishTarget checks each sigma against the commitments of the helpers and adds them up to the share of the target
+func (round *round3) finishTarget() *tss.Error {
+	ec := round.Params().EC()
+	Ps := round.Parties().IDs()
+	i := round.PartyID().Index
+	modQ := common.ModInt(ec.Params().N)
+	for j := range Ps {
+		round.ok[j] = true
+	}
+
+	// 1. sigma_j*G must equal the sum of the commitments of the helpers to the parts sent to helper j
+	xi := big.NewInt(0)
+	{
+		var multiErr error
+		culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+		for _, j := range round.helpers() {
+			h := helperPos(j, i)
+			var sum *crypto.ECPoint
+			var err error
+			for _, m := range round.helpers() {
+				if sum == nil {
+					sum = round.temp.commitments[m][h]
+				} else if sum, err = sum.Add(round.temp.commitments[m][h]); err != nil {
+					break
+				}
+			}
+			sigma := round.temp.recoveryRound2Message1s[j].Content().(*RecoveryRound2Message1).UnmarshalSigma()
+			if err != nil || !crypto.ScalarBaseMult(ec, sigma).Equals(sum) {
+				multiErr = multierror.Append(multiErr, tss.ErrBadShare)
+				culprits = append(culprits, Ps[j])
+				continue
+			}
+			xi = modQ.Add(xi, sigma)
+		}
+		if len(culprits) > 0 {
+			return round.WrapError(multiErr, culprits...)
+		}
+	}
+	bigXT, err := round.targetBigX()
+	if err != nil {
+		return round.WrapError(fmt.Errorf("%w: adding the commitments: %v", tss.ErrPointNotOnCurve, err))
+	}
+	kT := round.temp.keyIdx[i]
+	if !round.temp.enrol && !bigXT.Equals(round.save.BigXj[kT]) {
+		return round.WrapError(fmt.Errorf("%w: the commitments do not match the Xj of this party", tss.ErrInconsistentResult))
+	}
+	if !crypto.ScalarBaseMult(ec, xi).Equals(bigXT) {
+		return round.WrapError(fmt.Errorf("%w: the share does not match its commitment", tss.ErrInconsistentResult))
+	}
+
+	// 2. SAVE the share
+	round.save.Xi = xi
+	round.save.BigXj[kT] = bigXT
+	round.end <- *round.save
+
+	return nil
+}
+
+// finishHelper checks the factor proofs of the target and saves its new entries
+func (round *round3) finishHelper() *tss.Error {
+	Ps := round.Parties().IDs()
+	i := round.PartyID().Index
+	T := round.temp.target
+	for j := range Ps {
+		round.ok[j] = true
+	}
+
+	// 1. verify that NT and NTildeT have no small factors
+	r1msg2 := round.temp.recoveryRound1Message2s[T].Content().(*RecoveryRound1Message2)
+	r2msg2 := round.temp.recoveryRound2Message2s[T].Content().(*RecoveryRound2Message2)
+	NT, NTildeT := r1msg2.UnmarshalPaillierPK().N, r1msg2.UnmarshalNTilde()
+	ki := round.temp.keyIdx[i]
+	NTildei, H1i, H2i := round.save.NTildej[ki], round.save.H1j[ki], round.save.H2j[ki]
+	start := time.Now()
+	ok, err := r2msg2.UnmarshalFactorProof().FactorVerify(round.SessionID(), NT, NTildei, H1i, H2i)
+	if err == nil && ok {
+		ok, err = r2msg2.UnmarshalFactorProofTilde().FactorVerify(round.SessionID(), NTildeT, NTildei, H1i, H2i)
+	}
+	round.Params().Metrics().RecordProof(tss.ProofPaillierFactor, tss.ProofVerification, start)
+	if err != nil || !ok {
+		return round.WrapError(tss.NewProofError(tss.ProofPaillierFactor, err), Ps[T])
+	}
+
+	// 2. SAVE the new Paillier key and ring-Pedersen parameters of the target, and the ShareID and Xj of a new party;
+	// the shares are unchanged
+	kT := round.temp.keyIdx[T]
+	if round.temp.enrol {
+		bigXT, err := round.targetBigX()
+		if err != nil {
+			return round.WrapError(fmt.Errorf("%w: adding the commitments: %v", tss.ErrPointNotOnCurve, err))
+		}
+		round.save.Ks = append(round.save.Ks, Ps[T].KeyInt())
+		round.save.BigXj = append(round.save.BigXj, bigXT)
+		round.save.PaillierPKs = append(round.save.PaillierPKs, nil)
+		round.save.NTildej = append(round.save.NTildej, nil)
+		round.save.H1j, round.save.H2j = append(round.save.H1j, nil), append(round.save.H2j, nil)
+	}
+	round.save.PaillierPKs[kT] = r1msg2.UnmarshalPaillierPK()
+	round.save.NTildej[kT] = NTildeT
+	round.save.H1j[kT], round.save.H2j[kT] = r1msg2.UnmarshalH1(), r1msg2.UnmarshalH2()
+	round.end <- *round.save
+
+	return nil
+}
+
+// targetBigX adds up the commitments of all helpers, which gives the Xj of the target as the sum of λj * Xj
+func (round *round3) targetBigX() (*crypto.ECPoint, error) {
+	var sum *crypto.ECPoint
+	for _, j := range round.helpers() {
+		for _, D := range round.temp.commitments[j] {
+			if sum == nil {
+				sum = D
+				continue
+			}
+			var err error
+			if sum, err = sum.Add(D); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return sum, nil
+}
+
+func (round *round3) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *round3) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *round3) NextRound() tss.Round {
+	return nil // finished!
+}
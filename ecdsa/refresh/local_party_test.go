@@ -0,0 +1,176 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package refresh
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/ecdsa/signing"
+	"github.com/bnb-chain/tss-lib/test"
+	"github.com/bnb-chain/tss-lib/test/adversary"
+	"github.com/bnb-chain/tss-lib/test/netsim"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+const (
+	testParticipants = test.TestParticipants
+	testThreshold    = test.TestThreshold
+)
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+}
+
+// runRefresh refreshes `keys` and returns the results in the order of `pIDs`. Parties with an entry in
+// `preParams` replace their Paillier key with it.
+func runRefresh(t *testing.T, keys []keygen.LocalPartySaveData, pIDs tss.SortedPartyIDs, preParams map[int]keygen.LocalPreParams) []keygen.LocalPartySaveData {
+	p2pCtx := tss.NewPeerContext(pIDs)
+	outCh := make(chan tss.Message, len(pIDs)*len(pIDs))
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+
+	net := netsim.New(1)
+	for i, pID := range pIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(pIDs), testThreshold)
+		var P tss.Party
+		if pp, ok := preParams[i]; ok {
+			P = NewLocalParty(params, keys[i], outCh, endCh, pp)
+		} else {
+			P = NewLocalParty(params, keys[i], outCh, endCh)
+		}
+		assert.NoError(t, net.Register(P))
+	}
+	results, err := net.Run(context.Background(), outCh, time.Second)
+	assert.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, netsim.Finished, r.Outcome, r.String())
+	}
+
+	refreshed := make([]keygen.LocalPartySaveData, len(pIDs))
+	for range pIDs {
+		save := <-endCh
+		index, err := save.OriginalIndex()
+		assert.NoError(t, err)
+		refreshed[index] = save
+	}
+	return refreshed
+}
+
+func TestE2E(t *testing.T) {
+	setUp("info")
+
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	refreshed := runRefresh(t, keys, pIDs, nil)
+
+	shares := make(vss.Shares, 0, len(pIDs))
+	for i, save := range refreshed {
+		assert.True(t, save.ECDSAPub.Equals(keys[i].ECDSAPub), "the public key should not change")
+		assert.Equal(t, keys[i].Ks, save.Ks, "Ks should not change")
+		assert.NotEqual(t, 0, save.Xi.Cmp(keys[i].Xi), "the share should be refreshed")
+		assert.Equal(t, keys[i].PaillierSK.N, save.PaillierSK.N, "the Paillier key should be kept")
+		for j := range pIDs {
+			assert.True(t, save.BigXj[j].Equals(refreshed[j].BigXj[j]), "the parties should agree on Xj")
+			assert.Equal(t, keys[i].NTildej[j], save.NTildej[j])
+		}
+		assert.True(t, save.BigXj[i].Equals(crypto.ScalarBaseMult(tss.S256(), save.Xi)), "Xi should match the refreshed share")
+		shares = append(shares, &vss.Share{Threshold: testThreshold, ID: save.ShareID, Share: save.Xi})
+	}
+	x, err := shares[:testThreshold+1].ReConstruct(tss.S256())
+	assert.NoError(t, err)
+	assert.True(t, crypto.ScalarBaseMult(tss.S256(), x).Equals(keys[0].ECDSAPub), "the refreshed shares should reconstruct the private key")
+}
+
+func TestE2ERotatePaillier(t *testing.T) {
+	setUp("info")
+
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	// parties 0 and 1 swap their pre-params, which keeps every h1j and h2j unique
+	refreshed := runRefresh(t, keys, pIDs, map[int]keygen.LocalPreParams{
+		0: keys[1].LocalPreParams,
+		1: keys[0].LocalPreParams,
+	})
+	for _, save := range refreshed {
+		assert.Equal(t, keys[1].PaillierSK.N, save.PaillierPKs[0].N)
+		assert.Equal(t, keys[0].PaillierSK.N, save.PaillierPKs[1].N)
+		assert.Equal(t, keys[1].NTildei, save.NTildej[0])
+		assert.Equal(t, keys[0].NTildei, save.NTildej[1])
+		assert.Equal(t, keys[2].NTildei, save.NTildej[2])
+	}
+	assert.Equal(t, keys[1].PaillierSK.N, refreshed[0].PaillierSK.N)
+	assert.True(t, refreshed[0].ValidateWithProof())
+
+	// sign with the refreshed keys, including the parties with new Paillier keys
+	signers := refreshed[:testThreshold+1]
+	signPIDs := pIDs[:testThreshold+1]
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	outCh := make(chan tss.Message, len(signPIDs)*len(signPIDs))
+	endCh := make(chan common.SignatureData, len(signPIDs))
+	msg := big.NewInt(42)
+	net := netsim.New(1)
+	for i, pID := range signPIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(signPIDs), testThreshold)
+		assert.NoError(t, net.Register(signing.NewLocalParty(msg, params, signers[i], outCh, endCh)))
+	}
+	results, err := net.Run(context.Background(), outCh, time.Second)
+	assert.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, netsim.Finished, r.Outcome, r.String())
+	}
+	sig := (<-endCh).Signature // R || S
+	pk := ecdsa.PublicKey{Curve: tss.S256(), X: keys[0].ECDSAPub.X(), Y: keys[0].ECDSAPub.Y()}
+	ok := ecdsa.Verify(&pk, msg.Bytes(), new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:]))
+	assert.True(t, ok, "ecdsa verify must pass with the refreshed keys")
+}
+
+func TestE2ECheater(t *testing.T) {
+	setUp("info")
+
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	p2pCtx := tss.NewPeerContext(pIDs)
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+	newParams := func(i int) *tss.Parameters {
+		return tss.NewParameters(tss.S256(), p2pCtx, pIDs[i], len(pIDs), testThreshold)
+	}
+
+	for _, tc := range []struct {
+		corruption adversary.Corruption
+		cause      error
+	}{
+		{adversary.Corruption{MessageType: adversary.MessageType(&RefreshRound2Message{}), Corrupt: adversary.TamperField("share")}, tss.ErrBadShare},
+		{adversary.Corruption{MessageType: adversary.MessageType(&RefreshRound1Message{}), Corrupt: adversary.TamperField("modproof.w")}, &tss.ProofError{Proof: tss.ProofPaillierMod}},
+		{adversary.Corruption{MessageType: adversary.MessageType(&RefreshRound2Message{}), Corrupt: adversary.TamperField("facproof.z1")}, &tss.ProofError{Proof: tss.ProofPaillierFactor}},
+	} {
+		// the cheater also replaces its Paillier key, with that of party 1 which keeps it
+		results, err := adversary.Run(len(pIDs), 0, newParams, func(i int, params *tss.Parameters, out chan<- tss.Message) tss.Party {
+			switch i {
+			case 0:
+				return NewLocalParty(params, keys[i], out, endCh, keys[1].LocalPreParams)
+			case 1:
+				return NewLocalParty(params, keys[i], out, endCh, keys[0].LocalPreParams)
+			}
+			return NewLocalParty(params, keys[i], out, endCh)
+		}, tc.corruption)
+		assert.NoError(t, err)
+		assert.NoError(t, adversary.CheckBlamed(results, pIDs[0], tc.cause))
+	}
+}
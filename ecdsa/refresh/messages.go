@@ -0,0 +1,261 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package refresh
+
+import (
+	"crypto/elliptic"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/dlnproof"
+	"github.com/bnb-chain/tss-lib/crypto/paillier"
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// These messages were generated from Protocol Buffers definitions into ecdsa-refresh.pb.go
+// The following messages are registered on the Protocol Buffers "wire"
+
+var (
+	// Ensure that refresh messages implement ValidateBasic
+	_ = []tss.MessageContent{
+		(*RefreshRound1Message)(nil),
+		(*RefreshRound2Message)(nil),
+	}
+)
+
+// ----- //
+
+// NewRefreshRound1Message makes the round 1 message. The Paillier arguments are nil unless the party replaces
+// its Paillier key and ring-Pedersen parameters.
+func NewRefreshRound1Message(
+	from *tss.PartyID,
+	vs vss.Vs,
+	paillierPK *paillier.PublicKey,
+	nTildeI, h1I, h2I *big.Int,
+	dlnProof1, dlnProof2 *dlnproof.Proof,
+	modProof, modProofTilde *paillier.ModProof,
+) (tss.ParsedMessage, error) {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	vsFlat, err := crypto.FlattenECPoints(vs)
+	if err != nil {
+		return nil, err
+	}
+	content := &RefreshRound1Message{
+		Vs:            common.BigIntsToBytes(vsFlat),
+		Dlnproof_1:    newDLNProof(dlnProof1),
+		Dlnproof_2:    newDLNProof(dlnProof2),
+		Modproof:      newModProof(modProof),
+		ModproofTilde: newModProof(modProofTilde),
+	}
+	if paillierPK != nil {
+		content.PaillierN = paillierPK.N.Bytes()
+		content.NTilde = nTildeI.Bytes()
+		content.H1 = h1I.Bytes()
+		content.H2 = h2I.Bytes()
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg), nil
+}
+
+func (m *RefreshRound1Message) ValidateBasic() bool {
+	if m == nil || !common.NonEmptyMultiBytes(m.GetVs()) || len(m.GetVs())%2 != 0 {
+		return false
+	}
+	if !m.RotatesPaillier() {
+		return m.GetDlnproof_1() == nil && m.GetDlnproof_2() == nil && m.GetModproof() == nil && m.GetModproofTilde() == nil
+	}
+	return common.NonEmptyBytes(m.GetNTilde()) &&
+		common.NonEmptyBytes(m.GetH1()) &&
+		common.NonEmptyBytes(m.GetH2()) &&
+		m.GetDlnproof_1().ValidateBasic() &&
+		m.GetDlnproof_2().ValidateBasic() &&
+		m.GetModproof().ValidateBasic() &&
+		m.GetModproofTilde().ValidateBasic()
+}
+
+// RotatesPaillier reports whether the sender replaces its Paillier key and ring-Pedersen parameters
+func (m *RefreshRound1Message) RotatesPaillier() bool {
+	return common.NonEmptyBytes(m.GetPaillierN())
+}
+
+func (m *RefreshRound1Message) UnmarshalVs(ec elliptic.Curve) (vss.Vs, error) {
+	return crypto.UnFlattenECPoints(ec, common.MultiBytesToBigInts(m.GetVs()))
+}
+
+func (m *RefreshRound1Message) UnmarshalPaillierPK() *paillier.PublicKey {
+	return &paillier.PublicKey{N: new(big.Int).SetBytes(m.GetPaillierN())}
+}
+
+func (m *RefreshRound1Message) UnmarshalNTilde() *big.Int {
+	return new(big.Int).SetBytes(m.GetNTilde())
+}
+
+func (m *RefreshRound1Message) UnmarshalH1() *big.Int {
+	return new(big.Int).SetBytes(m.GetH1())
+}
+
+func (m *RefreshRound1Message) UnmarshalH2() *big.Int {
+	return new(big.Int).SetBytes(m.GetH2())
+}
+
+func (m *RefreshRound1Message) UnmarshalDLNProof1() (*dlnproof.Proof, error) {
+	p := m.GetDlnproof_1()
+	return dlnproof.UnmarshalDLNProof(p.GetAlpha(), p.GetT())
+}
+
+func (m *RefreshRound1Message) UnmarshalDLNProof2() (*dlnproof.Proof, error) {
+	p := m.GetDlnproof_2()
+	return dlnproof.UnmarshalDLNProof(p.GetAlpha(), p.GetT())
+}
+
+func (m *RefreshRound1Message) UnmarshalModProof() (*paillier.ModProof, error) {
+	p := m.GetModproof()
+	return paillier.UnmarshalModProof(p.GetW(), p.GetX(), p.GetA(), p.GetB(), p.GetZ())
+}
+
+func (m *RefreshRound1Message) UnmarshalModProofTilde() (*paillier.ModProof, error) {
+	p := m.GetModproofTilde()
+	return paillier.UnmarshalModProof(p.GetW(), p.GetX(), p.GetA(), p.GetB(), p.GetZ())
+}
+
+func newDLNProof(proof *dlnproof.Proof) *RefreshRound1Message_DLNProof {
+	if proof == nil {
+		return nil
+	}
+	return &RefreshRound1Message_DLNProof{
+		Alpha: common.BigIntsToBytes(proof.Alpha[:]),
+		T:     common.BigIntsToBytes(proof.T[:]),
+	}
+}
+
+func newModProof(proof *paillier.ModProof) *RefreshRound1Message_ModProof {
+	if proof == nil {
+		return nil
+	}
+	return &RefreshRound1Message_ModProof{
+		W: proof.W.Bytes(),
+		X: common.BigIntsToBytes(proof.X[:]),
+		A: proof.A[:],
+		B: proof.B[:],
+		Z: common.BigIntsToBytes(proof.Z[:]),
+	}
+}
+
+func (p *RefreshRound1Message_DLNProof) ValidateBasic() bool {
+	return p != nil &&
+		common.NonEmptyMultiBytes(p.GetAlpha(), dlnproof.Iterations) &&
+		common.NonEmptyMultiBytes(p.GetT(), dlnproof.Iterations)
+}
+
+func (p *RefreshRound1Message_ModProof) ValidateBasic() bool {
+	return p != nil &&
+		common.NonEmptyBytes(p.GetW()) &&
+		common.NonEmptyMultiBytes(p.GetX(), paillier.PARAM_M) &&
+		common.NonEmptyBools(p.GetA(), paillier.PARAM_M) &&
+		common.NonEmptyBools(p.GetB(), paillier.PARAM_M) &&
+		common.NonEmptyMultiBytes(p.GetZ(), paillier.PARAM_M)
+}
+
+// ----- //
+
+// NewRefreshRound2Message makes the round 2 message. The factor proofs are nil unless the sender replaces
+// its Paillier key, and in the message a party stores for itself.
+func NewRefreshRound2Message(
+	to, from *tss.PartyID,
+	share *vss.Share,
+	proof, proofTilde *paillier.FactorProof,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	content := &RefreshRound2Message{
+		Share:         share.Share.Bytes(),
+		Facproof:      newFactorProof(proof),
+		FacproofTilde: newFactorProof(proofTilde),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *RefreshRound2Message) ValidateBasic() bool {
+	if m == nil || !common.NonEmptyBytes(m.GetShare()) {
+		return false
+	}
+	if m.GetFacproof() == nil && m.GetFacproofTilde() == nil {
+		return true
+	}
+	return m.GetFacproof().ValidateBasic() && m.GetFacproofTilde().ValidateBasic()
+}
+
+func (m *RefreshRound2Message) UnmarshalShare() *big.Int {
+	return new(big.Int).SetBytes(m.GetShare())
+}
+
+func (m *RefreshRound2Message) UnmarshalFactorProof() *paillier.FactorProof {
+	return m.GetFacproof().unmarshal()
+}
+
+func (m *RefreshRound2Message) UnmarshalFactorProofTilde() *paillier.FactorProof {
+	return m.GetFacproofTilde().unmarshal()
+}
+
+func newFactorProof(proof *paillier.FactorProof) *RefreshRound2Message_FactorProof {
+	if proof == nil {
+		return nil
+	}
+	return &RefreshRound2Message_FactorProof{
+		P:     common.MarshalSigned(proof.P),
+		Q:     common.MarshalSigned(proof.Q),
+		A:     common.MarshalSigned(proof.A),
+		B:     common.MarshalSigned(proof.B),
+		T:     common.MarshalSigned(proof.T),
+		Sigma: common.MarshalSigned(proof.Sigma),
+		Z1:    common.MarshalSigned(proof.Z1),
+		Z2:    common.MarshalSigned(proof.Z2),
+		W1:    common.MarshalSigned(proof.W1),
+		W2:    common.MarshalSigned(proof.W2),
+		V:     common.MarshalSigned(proof.V),
+	}
+}
+
+func (proof *RefreshRound2Message_FactorProof) unmarshal() *paillier.FactorProof {
+	return &paillier.FactorProof{
+		P:     common.UnmarshalSigned(proof.GetP()),
+		Q:     common.UnmarshalSigned(proof.GetQ()),
+		A:     common.UnmarshalSigned(proof.GetA()),
+		B:     common.UnmarshalSigned(proof.GetB()),
+		T:     common.UnmarshalSigned(proof.GetT()),
+		Sigma: common.UnmarshalSigned(proof.GetSigma()),
+		Z1:    common.UnmarshalSigned(proof.GetZ1()),
+		Z2:    common.UnmarshalSigned(proof.GetZ2()),
+		W1:    common.UnmarshalSigned(proof.GetW1()),
+		W2:    common.UnmarshalSigned(proof.GetW2()),
+		V:     common.UnmarshalSigned(proof.GetV()),
+	}
+}
+
+func (proof *RefreshRound2Message_FactorProof) ValidateBasic() bool {
+	return proof != nil &&
+		common.NonEmptyBytes(proof.GetP()) &&
+		common.NonEmptyBytes(proof.GetQ()) &&
+		common.NonEmptyBytes(proof.GetA()) &&
+		common.NonEmptyBytes(proof.GetB()) &&
+		common.NonEmptyBytes(proof.GetT()) &&
+		common.NonEmptyBytes(proof.GetSigma()) &&
+		common.NonEmptyBytes(proof.GetZ1()) &&
+		common.NonEmptyBytes(proof.GetZ2()) &&
+		common.NonEmptyBytes(proof.GetW1()) &&
+		common.NonEmptyBytes(proof.GetW2()) &&
+		common.NonEmptyBytes(proof.GetV())
+}
@@ -0,0 +1,98 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package refresh
+
+import (
+	"fmt"
+
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+const (
+	paillierBitsLen = 2048
+)
+
+// round 1 shares zero among the parties and announces the new Paillier key of this party, if any
+func newRound1(params *tss.Parameters, save *keygen.LocalPartySaveData, temp *localTempData, out chan<- tss.Message, end chan<- keygen.LocalPartySaveData) tss.Round {
+	return &round1{
+		&base{params, save, temp, out, end, make([]bool, len(params.Parties().IDs())), false, 1}}
+}
+
+func (round *round1) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	round.number = 1
+	round.started = true
+	round.resetOK()
+
+	Pi := round.PartyID()
+	i := Pi.Index
+
+	// 1. share zero to refresh the key shares
+	vs, shares, err := vss.CreateZeroSharing(round.Rand(), round.Params().EC(), round.Threshold(), round.save.Ks)
+	if err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err), Pi)
+	}
+	round.temp.vs = vs
+	round.temp.shares = shares
+
+	// 2. if pre-params were given, replace the Paillier key and ring-Pedersen parameters and prove them
+	var r1msg tss.ParsedMessage
+	if preParams := round.temp.preParams; preParams != nil {
+		round.save.LocalPreParams = *preParams
+		round.save.PaillierPKs[i] = &preParams.PaillierSK.PublicKey
+		round.save.NTildej[i] = preParams.NTildei
+		round.save.H1j[i], round.save.H2j[i] = preParams.H1i, preParams.H2i
+
+		round.temp.skTilde = preParams.NTildeKey()
+		proofs := preParams.Prove(round.Rand(), round.SessionID(), round.Params().Metrics())
+
+		r1msg, err = NewRefreshRound1Message(Pi, vs, &preParams.PaillierSK.PublicKey, preParams.NTildei, preParams.H1i, preParams.H2i,
+			proofs.DLNProof1, proofs.DLNProof2, proofs.ModProof, proofs.ModProofTilde)
+	} else {
+		r1msg, err = NewRefreshRound1Message(Pi, vs, nil, nil, nil, nil, nil, nil, nil, nil)
+	}
+	if err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err), Pi)
+	}
+
+	// BROADCAST the zero sharing commitments and the Paillier key with its proofs
+	round.temp.refreshRound1Messages[i] = r1msg
+	if err := tss.SendMessage(round.Params(), round.out, r1msg); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
+	return nil
+}
+
+func (round *round1) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*RefreshRound1Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round1) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.refreshRound1Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		// proof checks are in round 2
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round1) NextRound() tss.Round {
+	round.started = false
+	return &round2{round}
+}
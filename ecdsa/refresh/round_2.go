@@ -0,0 +1,196 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package refresh
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func (round *round2) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	round.number = 2
+	round.started = true
+	round.resetOK()
+
+	ec := round.Params().EC()
+	Ps := round.Parties().IDs()
+	PIdx := round.PartyID().Index
+
+	// 1. check the zero sharing commitments and the new Paillier keys of the other parties
+	round.temp.Vsj[PIdx] = round.temp.vs
+	round.temp.rotated[PIdx] = round.temp.preParams != nil
+	{
+		var multiErr error
+		culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+		for j, Pj := range Ps {
+			if j == PIdx {
+				continue
+			}
+			r1msg := round.temp.refreshRound1Messages[j].Content().(*RefreshRound1Message)
+			PjVs, err := r1msg.UnmarshalVs(ec)
+			if err != nil || len(PjVs) != round.Threshold() {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("%w: bad zero sharing commitments", tss.ErrInvalidMessage))
+				culprits = append(culprits, Pj)
+				continue
+			}
+			round.temp.Vsj[j] = PjVs
+			if !r1msg.RotatesPaillier() {
+				continue
+			}
+			Nj, NTildej, H1j, H2j := r1msg.UnmarshalPaillierPK().N, r1msg.UnmarshalNTilde(), r1msg.UnmarshalH1(), r1msg.UnmarshalH2()
+			if Nj.BitLen() != paillierBitsLen || NTildej.BitLen() != paillierBitsLen {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("%w: got a modulus with insufficient bits", tss.ErrInvalidMessage))
+				culprits = append(culprits, Pj)
+				continue
+			}
+			if H1j.Cmp(H2j) == 0 {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("%w: h1j and h2j were equal for this party", tss.ErrInvalidMessage))
+				culprits = append(culprits, Pj)
+				continue
+			}
+			round.temp.rotated[j] = true
+		}
+		if len(culprits) > 0 {
+			return round.WrapError(multiErr, culprits...)
+		}
+	}
+	if err := round.verifyPaillierProofs(); err != nil {
+		return err
+	}
+
+	// 2. save the new Paillier keys and ring-Pedersen parameters, which must stay unique
+	h1H2Map := make(map[string]struct{}, len(Ps)*2)
+	for j, Pj := range Ps {
+		if j != PIdx && round.temp.rotated[j] {
+			r1msg := round.temp.refreshRound1Messages[j].Content().(*RefreshRound1Message)
+			round.save.PaillierPKs[j] = r1msg.UnmarshalPaillierPK()
+			round.save.NTildej[j] = r1msg.UnmarshalNTilde()
+			round.save.H1j[j], round.save.H2j[j] = r1msg.UnmarshalH1(), r1msg.UnmarshalH2()
+		}
+		h1JHex, h2JHex := hex.EncodeToString(round.save.H1j[j].Bytes()), hex.EncodeToString(round.save.H2j[j].Bytes())
+		_, h1Found := h1H2Map[h1JHex]
+		_, h2Found := h1H2Map[h2JHex]
+		if h1Found || h2Found {
+			return round.WrapError(fmt.Errorf("%w: this h1j or h2j was already used by another party", tss.ErrInvalidMessage), Pj)
+		}
+		h1H2Map[h1JHex], h1H2Map[h2JHex] = struct{}{}, struct{}{}
+	}
+
+	// 3. p2p send the zero share ij to Pj, with the proofs that a new Ni and NTildei have no small factors
+	for j, Pj := range Ps {
+		if j == PIdx {
+			// do not send to this Pj, but store for round 3
+			round.temp.refreshRound2Messages[j] = NewRefreshRound2Message(Pj, round.PartyID(), round.temp.shares[j], nil, nil)
+			continue
+		}
+		var r2msg tss.ParsedMessage
+		if preParams := round.temp.preParams; preParams != nil {
+			NTildej, H1j, H2j := round.save.NTildej[j], round.save.H1j[j], round.save.H2j[j]
+			start := time.Now()
+			facProof := preParams.PaillierSK.FactorProofWithRand(round.Rand(), round.SessionID(), NTildej, H1j, H2j)
+			round.Params().Metrics().RecordProof(tss.ProofPaillierFactor, tss.ProofGeneration, start)
+			start = time.Now()
+			facProofTilde := round.temp.skTilde.FactorProofWithRand(round.Rand(), round.SessionID(), NTildej, H1j, H2j)
+			round.Params().Metrics().RecordProof(tss.ProofPaillierFactor, tss.ProofGeneration, start)
+			r2msg = NewRefreshRound2Message(Pj, round.PartyID(), round.temp.shares[j], facProof, facProofTilde)
+		} else {
+			r2msg = NewRefreshRound2Message(Pj, round.PartyID(), round.temp.shares[j], nil, nil)
+		}
+		if err := tss.SendMessage(round.Params(), round.out, r2msg); err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+		}
+	}
+	return nil
+}
+
+// verifyPaillierProofs checks the DLN and mod proofs of the parties that replaced their Paillier key
+func (round *round2) verifyPaillierProofs() *tss.Error {
+	Ps := round.Parties().IDs()
+	verifier := keygen.NewProofVerifierWithContext(round.Context(), round.Concurrency())
+	verifier.SetMetrics(round.Params().Metrics())
+
+	// each verification writes its own flag: DLN proofs 1 and 2, then the mod proofs of N and NTilde
+	failed := make([][4]bool, len(Ps))
+	wg := new(sync.WaitGroup)
+	for j := range Ps {
+		if j == round.PartyID().Index || !round.temp.rotated[j] {
+			continue
+		}
+		r1msg := round.temp.refreshRound1Messages[j].Content().(*RefreshRound1Message)
+		Nj, NTildej, H1j, H2j := r1msg.UnmarshalPaillierPK().N, r1msg.UnmarshalNTilde(), r1msg.UnmarshalH1(), r1msg.UnmarshalH2()
+		_j := j
+		onDone := func(k int) func(bool) {
+			return func(isValid bool) {
+				failed[_j][k] = !isValid
+				wg.Done()
+			}
+		}
+		wg.Add(4)
+		verifier.VerifyDLNProof1(round.SessionID(), r1msg, H1j, H2j, NTildej, onDone(0))
+		verifier.VerifyDLNProof2(round.SessionID(), r1msg, H2j, H1j, NTildej, onDone(1))
+		verifier.VerifyModProof(round.SessionID(), r1msg, Nj, onDone(2))
+		verifier.VerifyModProofTilde(round.SessionID(), r1msg, NTildej, onDone(3))
+	}
+	wg.Wait()
+	// abandoned verifications are not the provers' fault
+	if err := round.Context().Err(); err != nil {
+		return round.WrapError(err)
+	}
+	var multiErr error
+	culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+	for j, Pj := range Ps {
+		switch {
+		case failed[j][0] || failed[j][1]:
+			multiErr = multierror.Append(multiErr, tss.NewProofError(tss.ProofDLN, nil))
+		case failed[j][2] || failed[j][3]:
+			multiErr = multierror.Append(multiErr, tss.NewProofError(tss.ProofPaillierMod, nil))
+		default:
+			continue
+		}
+		culprits = append(culprits, Pj)
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(multiErr, culprits...)
+	}
+	return nil
+}
+
+func (round *round2) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*RefreshRound2Message); ok {
+		return !msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round2) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.refreshRound2Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		// proof checks are in round 3
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round2) NextRound() tss.Round {
+	round.started = false
+	return &round3{round}
+}
@@ -0,0 +1,143 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package refresh
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func (round *round3) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	round.number = 3
+	round.started = true
+	round.resetOK()
+
+	ec := round.Params().EC()
+	Ps := round.Parties().IDs()
+	PIdx := round.PartyID().Index
+
+	// 1. verify the zero shares of the other parties, and the factor proofs of those that replaced their Paillier key
+	xi := new(big.Int).Set(round.save.Xi)
+	{
+		var multiErr error
+		culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+		for j, Pj := range Ps {
+			round.ok[j] = true
+			r2msg := round.temp.refreshRound2Messages[j].Content().(*RefreshRound2Message)
+			if j == PIdx {
+				xi.Add(xi, r2msg.UnmarshalShare())
+				continue
+			}
+			PjShare := vss.Share{
+				Threshold: round.Threshold(),
+				ID:        round.PartyID().KeyInt(),
+				Share:     r2msg.UnmarshalShare(),
+			}
+			if !PjShare.VerifyZeroSharing(ec, round.Threshold(), round.temp.Vsj[j]) {
+				multiErr = multierror.Append(multiErr, tss.ErrBadShare)
+				culprits = append(culprits, Pj)
+				continue
+			}
+			if err := round.verifyFactorProofs(j, r2msg); err != nil {
+				multiErr = multierror.Append(multiErr, err)
+				culprits = append(culprits, Pj)
+				continue
+			}
+			xi.Add(xi, PjShare.Share)
+		}
+		if len(culprits) > 0 {
+			return round.WrapError(multiErr, culprits...)
+		}
+	}
+
+	// 2. sum the zero sharing commitments of all parties, then compute the refreshed Xj of each Pj
+	Vc := make(vss.Vs, round.Threshold())
+	copy(Vc, round.temp.Vsj[PIdx])
+	for j := range Ps {
+		if j == PIdx {
+			continue
+		}
+		for c := range Vc {
+			var err error
+			if Vc[c], err = Vc[c].Add(round.temp.Vsj[j][c]); err != nil {
+				return round.WrapError(fmt.Errorf("%w: adding the zero sharing commitments: %v", tss.ErrPointNotOnCurve, err))
+			}
+		}
+	}
+	bigXj := make([]*crypto.ECPoint, len(Ps))
+	for m, Pm := range Ps {
+		Vm, err := vss.ZeroSharingCommitment(ec, Vc, Pm.KeyInt())
+		if err == nil {
+			bigXj[m], err = round.save.BigXj[m].Add(Vm)
+		}
+		if err != nil {
+			return round.WrapError(fmt.Errorf("%w: refreshing BigXj: %v", tss.ErrPointNotOnCurve, err))
+		}
+	}
+	xi.Mod(xi, ec.Params().N)
+	if !crypto.ScalarBaseMult(ec, xi).Equals(bigXj[PIdx]) {
+		return round.WrapError(fmt.Errorf("%w: the refreshed share does not match its commitment", tss.ErrInconsistentResult))
+	}
+
+	// 3. SAVE the refreshed share; Ks and ECDSAPub are unchanged
+	round.save.Xi = xi
+	round.save.BigXj = bigXj
+
+	round.end <- *round.save
+
+	return nil
+}
+
+// verifyFactorProofs checks that a new Nj and NTildej of Pj have no small factors
+func (round *round3) verifyFactorProofs(j int, r2msg *RefreshRound2Message) error {
+	if !round.temp.rotated[j] {
+		if r2msg.GetFacproof() != nil {
+			return fmt.Errorf("%w: unexpected factor proof", tss.ErrInvalidMessage)
+		}
+		return nil
+	}
+	if r2msg.GetFacproof() == nil {
+		return tss.NewProofError(tss.ProofPaillierFactor, nil)
+	}
+	i := round.PartyID().Index
+	Nj, NTildej := round.save.PaillierPKs[j].N, round.save.NTildej[j]
+	NTildei, H1i, H2i := round.save.NTildej[i], round.save.H1j[i], round.save.H2j[i]
+	start := time.Now()
+	ok, err := r2msg.UnmarshalFactorProof().FactorVerify(round.SessionID(), Nj, NTildei, H1i, H2i)
+	if err == nil && ok {
+		ok, err = r2msg.UnmarshalFactorProofTilde().FactorVerify(round.SessionID(), NTildej, NTildei, H1i, H2i)
+	}
+	round.Params().Metrics().RecordProof(tss.ProofPaillierFactor, tss.ProofVerification, start)
+	if err != nil || !ok {
+		return tss.NewProofError(tss.ProofPaillierFactor, err)
+	}
+	return nil
+}
+
+func (round *round3) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *round3) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *round3) NextRound() tss.Round {
+	return nil // finished!
+}
@@ -7,10 +7,10 @@
 package resharing
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 
-	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
 	cmt "github.com/bnb-chain/tss-lib/crypto/commitments"
 	"github.com/bnb-chain/tss-lib/crypto/paillier"
@@ -80,7 +80,7 @@ func NewLocalParty(
 		subset = keygen.BuildLocalSaveDataSubset(key, params.OldParties().IDs())
 	}
 	p := &LocalParty{
-		BaseParty: new(tss.BaseParty),
+		BaseParty: tss.NewBaseParty(params.Parameters),
 		params:    params,
 		temp:      localTempData{},
 		input:     subset,
@@ -112,14 +112,18 @@ func (p *LocalParty) Start() *tss.Error {
 	return tss.BaseStart(p, TaskName)
 }
 
+func (p *LocalParty) StartWithContext(ctx context.Context) *tss.Error {
+	return tss.BaseStartWithContext(ctx, p, TaskName)
+}
+
 func (p *LocalParty) Update(msg tss.ParsedMessage) (ok bool, err *tss.Error) {
 	return tss.BaseUpdate(p, msg, TaskName)
 }
 
 func (p *LocalParty) UpdateFromBytes(wireBytes []byte, from *tss.PartyID, isBroadcast bool) (bool, *tss.Error) {
-	msg, err := tss.ParseWireMessage(wireBytes, from, isBroadcast)
+	msg, err := p.ParseWireMessage(wireBytes, from, isBroadcast)
 	if err != nil {
-		return false, p.WrapError(err)
+		return false, err
 	}
 	return p.Update(msg)
 }
@@ -137,7 +141,7 @@ func (p *LocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 		maxFromIdx = len(p.params.OldParties().IDs()) - 1
 	}
 	if maxFromIdx < msg.GetFrom().Index {
-		return false, p.WrapError(fmt.Errorf("received msg with a sender index too great (%d <= %d)",
+		return false, p.WrapError(fmt.Errorf("%w: received msg with a sender index too great (%d <= %d)", tss.ErrInvalidMessage,
 			maxFromIdx, msg.GetFrom().Index), msg.GetFrom())
 	}
 	return true, nil
@@ -148,32 +152,30 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	if ok, err := p.ValidateMessage(msg); !ok || err != nil {
 		return ok, err
 	}
-	fromPIdx := msg.GetFrom().Index
 
 	// switch/case is necessary to store any messages beyond current round
-	// this does not handle message replays. we expect the caller to apply replay and spoofing protection.
+	// a sender may deliver each message only once; identical re-deliveries are ignored. spoofing protection is left to the caller.
 	switch msg.Content().(type) {
 	case *DGRound1Message:
-		p.temp.dgRound1Messages[fromPIdx] = msg
+		return tss.StoreMessageOnce(p, p.temp.dgRound1Messages, msg)
 	case *DGRound2Message1:
-		p.temp.dgRound2Message1s[fromPIdx] = msg
+		return tss.StoreMessageOnce(p, p.temp.dgRound2Message1s, msg)
 	case *DGRound2Message2:
-		p.temp.dgRound2Message2s[fromPIdx] = msg
+		return tss.StoreMessageOnce(p, p.temp.dgRound2Message2s, msg)
 	case *DGRound3Message1:
-		p.temp.dgRound3Message1s[fromPIdx] = msg
+		return tss.StoreMessageOnce(p, p.temp.dgRound3Message1s, msg)
 	case *DGRound3Message2:
-		p.temp.dgRound3Message2s[fromPIdx] = msg
+		return tss.StoreMessageOnce(p, p.temp.dgRound3Message2s, msg)
 	case *DGRound4Message1:
-		p.temp.dgRound4Message1s[fromPIdx] = msg
+		return tss.StoreMessageOnce(p, p.temp.dgRound4Message1s, msg)
 	case *DGRound4Message2:
-		p.temp.dgRound4Message2s[fromPIdx] = msg
+		return tss.StoreMessageOnce(p, p.temp.dgRound4Message2s, msg)
 	case *DGRound5Message:
-		p.temp.dgRound5Messages[fromPIdx] = msg
+		return tss.StoreMessageOnce(p, p.temp.dgRound5Messages, msg)
 	default: // unrecognised message, just ignore!
-		common.Logger.Warningf("unrecognised message ignored: %v", msg)
+		p.params.Logger().Warningf("unrecognised message ignored: %s", msg.Type())
 		return false, nil
 	}
-	return true, nil
 }
 
 func (p *LocalParty) PartyID() *tss.PartyID {
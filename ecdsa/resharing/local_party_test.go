@@ -7,7 +7,12 @@
 package resharing_test
 
 import (
+	"bytes"
+	"context"
 	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
 	"fmt"
 	"math/big"
 	"runtime"
@@ -23,6 +28,7 @@ import (
 	. "github.com/bnb-chain/tss-lib/ecdsa/resharing"
 	"github.com/bnb-chain/tss-lib/ecdsa/signing"
 	"github.com/bnb-chain/tss-lib/test"
+	"github.com/bnb-chain/tss-lib/test/netsim"
 	"github.com/bnb-chain/tss-lib/tss"
 )
 
@@ -231,3 +237,207 @@ signing:
 		}
 	}
 }
+
+func TestE2EEncryptedP2PMessages(t *testing.T) {
+	setUp("info")
+
+	threshold, newThreshold := testThreshold, 1
+
+	oldKeys, oldPIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 1)
+	assert.NoError(t, err, "should load keygen fixtures")
+	fixtures, _, err := keygen.LoadKeygenTestFixtures(3)
+	assert.NoError(t, err, "should load keygen fixtures")
+	newPIDs := tss.GenerateTestPartyIDs(len(fixtures))
+	oldP2PCtx, newP2PCtx := tss.NewPeerContext(oldPIDs), tss.NewPeerContext(newPIDs)
+
+	keys := make(map[*tss.PartyID]ed25519.PrivateKey)
+	for _, pID := range append(append(tss.SortedPartyIDs(nil), oldPIDs...), newPIDs...) {
+		pub, key, err := ed25519.GenerateKey(rand.Reader)
+		assert.NoError(t, err)
+		pID.SetIdentityKey(pub)
+		keys[pID] = key
+	}
+	newParams := func(pID *tss.PartyID) *tss.ReSharingParameters {
+		params := tss.NewReSharingParameters(tss.S256(), oldP2PCtx, newP2PCtx, pID, testParticipants, threshold, len(newPIDs), newThreshold)
+		params.SetIdentityKey(keys[pID])
+		params.SetP2PEncryption(true)
+		return params
+	}
+
+	bothCommitteesPax := len(oldPIDs) + len(newPIDs)
+	sent := make(chan tss.Message, bothCommitteesPax*bothCommitteesPax)
+	outCh := make(chan tss.Message, bothCommitteesPax*bothCommitteesPax)
+	endCh := make(chan keygen.LocalPartySaveData, bothCommitteesPax)
+
+	net := netsim.New(1)
+	for j, pID := range oldPIDs {
+		assert.NoError(t, net.RegisterOldCommittee(NewLocalParty(newParams(pID), oldKeys[j], sent, endCh)))
+	}
+	newCommittee := make([]*LocalParty, 0, len(newPIDs))
+	for j, pID := range newPIDs {
+		save := keygen.NewLocalPartySaveData(len(newPIDs))
+		save.LocalPreParams = fixtures[j].LocalPreParams
+		P := NewLocalParty(newParams(pID), save, sent, endCh).(*LocalParty)
+		newCommittee = append(newCommittee, P)
+		assert.NoError(t, net.Register(P))
+	}
+
+	// the relay sees every message on its way to the network
+	var shares int32
+	go func() {
+		for msg := range sent {
+			if _, ok := msg.(tss.ParsedMessage).Content().(*DGRound3Message1); ok {
+				atomic.AddInt32(&shares, 1)
+				bz, _, err := msg.WireBytes()
+				assert.NoError(t, err)
+				share := msg.WireMsg().GetMessage().GetValue()
+				assert.False(t, bytes.Contains(bz, share), "the share should be sent encrypted")
+
+				// a tampered ciphertext is rejected without blaming the sender, who may not have sent it
+				tampered := append([]byte{}, bz...)
+				tampered[len(tampered)-1] ^= 1
+				_, err2 := newCommittee[msg.GetTo()[0].Index].UpdateFromBytes(tampered, msg.GetFrom(), false)
+				if assert.NotNil(t, err2) {
+					assert.True(t, errors.Is(err2, tss.ErrDecryptionFailed))
+					assert.Empty(t, err2.Culprits())
+				}
+			}
+			outCh <- msg
+		}
+	}()
+	results, err := net.Run(context.Background(), outCh, 0)
+	close(sent)
+	assert.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, netsim.Finished, r.Outcome, r.String())
+	}
+	assert.Equal(t, int32(len(oldPIDs)*len(newPIDs)), atomic.LoadInt32(&shares))
+
+	// the old committee does not start without the identity keys of the new committee
+	newPIDs[0].IdentityKey = nil
+	P := NewLocalParty(newParams(oldPIDs[0]), oldKeys[0], outCh, endCh)
+	if err := P.Start(); assert.NotNil(t, err, "a party should not start without the keys to encrypt to the new committee") {
+		assert.Contains(t, err.Error(), "identity key")
+	}
+}
+
+func TestE2EResumeFromSnapshot(t *testing.T) {
+	setUp("info")
+
+	threshold, newThreshold := testThreshold, 1
+
+	oldKeys, oldPIDs, err := keygen.LoadKeygenTestFixtures(testThreshold + 1)
+	assert.NoError(t, err, "should load keygen fixtures")
+	fixtures, _, err := keygen.LoadKeygenTestFixtures(3)
+	assert.NoError(t, err, "should load keygen fixtures")
+	newPIDs := tss.GenerateTestPartyIDs(len(fixtures))
+	oldP2PCtx, newP2PCtx := tss.NewPeerContext(oldPIDs), tss.NewPeerContext(newPIDs)
+
+	bothCommitteesPax := len(oldPIDs) + len(newPIDs)
+	errCh := make(chan *tss.Error, bothCommitteesPax*bothCommitteesPax)
+	outCh := make(chan tss.Message, bothCommitteesPax*bothCommitteesPax)
+	endCh := make(chan keygen.LocalPartySaveData, bothCommitteesPax)
+
+	newParams := func(pID *tss.PartyID) *tss.ReSharingParameters {
+		params := tss.NewReSharingParameters(tss.S256(), oldP2PCtx, newP2PCtx, pID, testParticipants, threshold, len(newPIDs), newThreshold)
+		params.SetSessionID([]byte("ecdsa-resharing-resume"))
+		return params
+	}
+	newOldParty := func(j int) *LocalParty {
+		return NewLocalParty(newParams(oldPIDs[j]), oldKeys[j], outCh, endCh).(*LocalParty)
+	}
+	newNewParty := func(j int) *LocalParty {
+		save := keygen.NewLocalPartySaveData(len(newPIDs))
+		save.LocalPreParams = fixtures[j].LocalPreParams
+		return NewLocalParty(newParams(newPIDs[j]), save, outCh, endCh).(*LocalParty)
+	}
+	oldCommittee := make([]*LocalParty, 0, len(oldPIDs))
+	newCommittee := make([]*LocalParty, 0, len(newPIDs))
+	for j := range oldPIDs {
+		oldCommittee = append(oldCommittee, newOldParty(j))
+	}
+	for j := range newPIDs {
+		newCommittee = append(newCommittee, newNewParty(j))
+	}
+	for _, P := range append(append([]*LocalParty(nil), newCommittee...), oldCommittee...) {
+		if err := P.Start(); err != nil {
+			assert.FailNow(t, err.Error())
+		}
+	}
+
+	snapshotKey, otherKey := make([]byte, 32), make([]byte, 32)
+	otherKey[0] = 1
+	oldResumes, newResumes := 0, 0
+	// the first party of each committee is restarted from a snapshot before every message it receives
+	deliver := func(committee []*LocalParty, j int, newParty func(int) *LocalParty, resumes *int, msg tss.Message) {
+		P := committee[j]
+		if j == 0 && P.PartyID() != msg.GetFrom() {
+			snapshot, err := P.Snapshot(snapshotKey)
+			if !assert.Nil(t, err) {
+				return
+			}
+			err = newParty(0).Resume(otherKey, snapshot)
+			assert.True(t, errors.Is(err, tss.ErrInvalidSnapshot))
+			P = newParty(0)
+			if err := P.Resume(snapshotKey, snapshot); !assert.Nil(t, err) {
+				return
+			}
+			committee[0] = P
+			(*resumes)++
+		}
+		test.SharedPartyUpdater(P, msg, errCh)
+	}
+
+	var newKeys []keygen.LocalPartySaveData
+	for ended := 0; ended < bothCommitteesPax; {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			// the recipients in both committees are listed with the old committee first
+			oldDest, newDest := msg.GetTo(), msg.GetTo()
+			switch {
+			case msg.IsToOldAndNewCommittees():
+				oldDest, newDest = oldDest[:len(oldCommittee)], newDest[len(oldCommittee):]
+			case msg.IsToOldCommittee():
+				newDest = nil
+			default:
+				oldDest = nil
+			}
+			for _, dest := range oldDest {
+				deliver(oldCommittee, dest.Index, newOldParty, &oldResumes, msg)
+			}
+			for _, dest := range newDest {
+				deliver(newCommittee, dest.Index, newNewParty, &newResumes, msg)
+			}
+		case save := <-endCh:
+			// old committee members that aren't receiving a share have their Xi zeroed
+			if save.Xi != nil {
+				newKeys = append(newKeys, save)
+			}
+			ended++
+		}
+	}
+	// the old committee hears from the new one in rounds 2, 4 and 5;
+	// the new committee hears from the old one once in round 1 and twice in round 3, and from each other once in rounds 2 and 5
+	// and twice in round 4
+	assert.Equal(t, len(newPIDs)*3, oldResumes)
+	assert.Equal(t, len(oldPIDs)*3+(len(newPIDs)-1)*4, newResumes)
+
+	if !assert.Len(t, newKeys, len(newPIDs)) {
+		return
+	}
+	for _, key := range newKeys {
+		assert.True(t, key.ECDSAPub.Equals(oldKeys[0].ECDSAPub), "the public key should not change")
+		index, err := key.OriginalIndex()
+		if assert.NoError(t, err) {
+			assert.True(t, key.BigXj[index].Equals(crypto.ScalarBaseMult(tss.S256(), key.Xi)), "ensure BigX_j == g^x_j")
+		}
+	}
+
+	// a finished party has nothing to snapshot
+	for _, P := range []*LocalParty{oldCommittee[0], newCommittee[0]} {
+		_, err2 := P.Snapshot(snapshotKey)
+		assert.True(t, errors.Is(err2, tss.ErrInvalidSnapshot))
+	}
+}
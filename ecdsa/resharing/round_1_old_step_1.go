@@ -7,7 +7,6 @@
 package resharing
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/bnb-chain/tss-lib/crypto"
@@ -26,7 +25,7 @@ func newRound1(params *tss.ReSharingParameters, input, save *keygen.LocalPartySa
 
 func (round *round1) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 	round.number = 1
 	round.started = true
@@ -44,23 +43,23 @@ func (round *round1) Start() *tss.Error {
 	// 1. PrepareForSigning() -> w_i
 	xi, ks, bigXj := round.input.Xi, round.input.Ks, round.input.BigXj
 	if round.Threshold()+1 > len(ks) {
-		return round.WrapError(fmt.Errorf("t+1=%d is not satisfied by the key count of %d", round.Threshold()+1, len(ks)), round.PartyID())
+		return round.WrapError(fmt.Errorf("%w: t+1=%d is not satisfied by the key count of %d", tss.ErrInternal, round.Threshold()+1, len(ks)), round.PartyID())
 	}
 	newKs := round.NewParties().IDs().Keys()
 	wi, _ := signing.PrepareForSigning(round.Params().EC(), i, len(round.OldParties().IDs()), xi, ks, bigXj)
 
 	// 2.
-	vi, shares, err := vss.Create(round.Params().EC(), round.NewThreshold(), wi, newKs)
+	vi, shares, err := vss.CreateWithRand(round.Rand(), round.Params().EC(), round.NewThreshold(), wi, newKs)
 	if err != nil {
-		return round.WrapError(err, round.PartyID())
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err), round.PartyID())
 	}
 
 	// 3.
 	flatVis, err := crypto.FlattenECPoints(vi)
 	if err != nil {
-		return round.WrapError(err, round.PartyID())
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err), round.PartyID())
 	}
-	vCmt := commitments.NewHashCommitment(flatVis...)
+	vCmt := commitments.NewHashCommitmentWithRand(round.Rand(), flatVis...)
 
 	// 4. populate temp data
 	round.temp.VD = vCmt.D
@@ -71,7 +70,9 @@ func (round *round1) Start() *tss.Error {
 		round.NewParties().IDs().Exclude(round.PartyID()), round.PartyID(),
 		round.input.ECDSAPub, vCmt.C)
 	round.temp.dgRound1Messages[i] = r1msg
-	round.out <- r1msg
+	if err := tss.SendMessage(round.Params(), round.out, r1msg); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
 
 	return nil
 }
@@ -103,12 +104,12 @@ func (round *round1) Update() (bool, *tss.Error) {
 		r1msg := round.temp.dgRound1Messages[0].Content().(*DGRound1Message)
 		candidate, err := r1msg.UnmarshalECDSAPub(round.Params().EC())
 		if err != nil {
-			return false, round.WrapError(errors.New("unable to unmarshal the ecdsa pub key"), msg.GetFrom())
+			return false, round.WrapError(fmt.Errorf("%w: unable to unmarshal the ecdsa pub key", tss.ErrInvalidMessage), msg.GetFrom())
 		}
 		if round.save.ECDSAPub != nil &&
 			!candidate.Equals(round.save.ECDSAPub) {
 			// uh oh - anomaly!
-			return false, round.WrapError(errors.New("ecdsa pub key did not match what we received previously"), msg.GetFrom())
+			return false, round.WrapError(fmt.Errorf("%w: ecdsa pub key did not match what we received previously", tss.ErrInvalidMessage), msg.GetFrom())
 		}
 		round.save.ECDSAPub = candidate
 	}
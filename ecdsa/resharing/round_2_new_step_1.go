@@ -7,18 +7,17 @@
 package resharing
 
 import (
-	"errors"
-	"math/big"
+	"context"
+	"fmt"
+	"time"
 
-	"github.com/bnb-chain/tss-lib/crypto/dlnproof"
-	"github.com/bnb-chain/tss-lib/crypto/paillier"
 	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
 	"github.com/bnb-chain/tss-lib/tss"
 )
 
 func (round *round2) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 	round.number = 2
 	round.started = true
@@ -36,7 +35,9 @@ func (round *round2) Start() *tss.Error {
 	r2msg1 := NewDGRound2Message2(
 		round.OldParties().IDs().Exclude(round.PartyID()), round.PartyID())
 	round.temp.dgRound2Message2s[i] = r2msg1
-	round.out <- r2msg1
+	if err := tss.SendMessage(round.Params(), round.out, r2msg1); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
 
 	// 1.
 	// generate Paillier public key E_i, private key and proof
@@ -46,48 +47,28 @@ func (round *round2) Start() *tss.Error {
 	var preParams *keygen.LocalPreParams
 	if round.save.LocalPreParams.Validate() && !round.save.LocalPreParams.ValidateWithProof() {
 		return round.WrapError(
-			errors.New("`optionalPreParams` failed to validate; it might have been generated with an older version of tss-lib"))
+			fmt.Errorf("%w: `optionalPreParams` failed to validate; it might have been generated with an older version of tss-lib", tss.ErrInternal))
 	} else if round.save.LocalPreParams.ValidateWithProof() {
 		preParams = &round.save.LocalPreParams
 	} else {
 		var err error
-		preParams, err = keygen.GeneratePreParams(round.SafePrimeGenTimeout(), round.Concurrency())
+		ctx, cancel := context.WithTimeout(round.Context(), round.SafePrimeGenTimeout())
+		defer cancel()
+		preParams, err = keygen.GeneratePreParamsWithRand(ctx, round.Rand(), round.Params().Logger(), round.Concurrency())
 		if err != nil {
-			return round.WrapError(errors.New("pre-params generation failed"), Pi)
+			return round.WrapError(fmt.Errorf("%w: pre-params generation failed", tss.ErrInternal), Pi)
 		}
 	}
 	round.save.LocalPreParams = *preParams
 	round.save.NTildej[i] = preParams.NTildei
 	round.save.H1j[i], round.save.H2j[i] = preParams.H1i, preParams.H2i
 
-	// generate the dlnproofs for resharing
-	h1i, h2i, alpha, beta, p, q, NTildei :=
-		preParams.H1i,
-		preParams.H2i,
-		preParams.Alpha,
-		preParams.Beta,
-		preParams.P,
-		preParams.Q,
-		preParams.NTildei
-	dlnProof1 := dlnproof.NewDLNProof(h1i, h2i, alpha, p, q, NTildei)
-	dlnProof2 := dlnproof.NewDLNProof(h2i, h1i, beta, p, q, NTildei)
-
-	modProof := preParams.PaillierSK.ModProof()
-
-	// NTildei = (2p+1) * (2q+1)
-	// phi(NTildei) = ((2p+1) - 1) * ((2q+1) - 1) = 2p * 2q
-	pp := new(big.Int).Add(p, p)
-	qq := new(big.Int).Add(q, q)
-	phiNTilde := new(big.Int).Mul(pp, qq)
-	// As per paillier.go
-	gcdTilde := new(big.Int).GCD(nil, nil, pp, qq)
-	lambdaNTilde := new(big.Int).Div(phiNTilde, gcdTilde)
-	pkTilde := &paillier.PublicKey{N: NTildei}
-	skTilde := &paillier.PrivateKey{PublicKey: *pkTilde, LambdaN: lambdaNTilde, PhiN: phiNTilde}
-
-	modProofTilde := skTilde.ModProof()
-
-	paillierPf := preParams.PaillierSK.Proof(Pi.KeyInt(), round.save.ECDSAPub)
+	// generate the dlnproofs and modproofs for resharing
+	proofs := preParams.Prove(round.Rand(), round.SessionID(), round.Params().Metrics())
+
+	start := time.Now()
+	paillierPf := preParams.PaillierSK.Proof(round.SessionID(), Pi.KeyInt(), round.save.ECDSAPub)
+	round.Params().Metrics().RecordProof(tss.ProofPaillierKey, tss.ProofGeneration, start)
 	r2msg2, err := NewDGRound2Message1(
 		round.NewParties().IDs().Exclude(round.PartyID()), round.PartyID(),
 		&preParams.PaillierSK.PublicKey,
@@ -95,16 +76,18 @@ func (round *round2) Start() *tss.Error {
 		preParams.NTildei,
 		preParams.H1i,
 		preParams.H2i,
-		dlnProof1,
-		dlnProof2,
-		modProof,
-		modProofTilde,
+		proofs.DLNProof1,
+		proofs.DLNProof2,
+		proofs.ModProof,
+		proofs.ModProofTilde,
 	)
 	if err != nil {
-		return round.WrapError(err, Pi)
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err), Pi)
 	}
 	round.temp.dgRound2Message1s[i] = r2msg2
-	round.out <- r2msg2
+	if err := tss.SendMessage(round.Params(), round.out, r2msg2); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
 
 	// for this P: SAVE de-commitments, paillier keys for round 2
 	round.save.PaillierSK = preParams.PaillierSK
@@ -112,7 +95,7 @@ func (round *round2) Start() *tss.Error {
 	round.save.NTildej[i] = preParams.NTildei
 	round.save.H1j[i], round.save.H2j[i] = preParams.H1i, preParams.H2i
 
-	round.temp.skTilde = skTilde
+	round.temp.skTilde = preParams.NTildeKey()
 
 	return nil
 }
@@ -171,7 +154,7 @@ func (round *round2) Update() (bool, *tss.Error) {
 			round.newOK[j] = true
 		}
 	} else {
-		return false, round.WrapError(errors.New("this party is not in the old or the new committee"), round.PartyID())
+		return false, round.WrapError(fmt.Errorf("%w: this party is not in the old or the new committee", tss.ErrInternal), round.PartyID())
 	}
 	return true, nil
 }
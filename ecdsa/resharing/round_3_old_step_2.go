@@ -7,14 +7,14 @@
 package resharing
 
 import (
-	"errors"
+	"fmt"
 
 	"github.com/bnb-chain/tss-lib/tss"
 )
 
 func (round *round3) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 	round.number = 3
 	round.started = true
@@ -34,7 +34,9 @@ func (round *round3) Start() *tss.Error {
 		share := round.temp.NewShares[j]
 		r3msg1 := NewDGRound3Message1(Pj, round.PartyID(), share)
 		round.temp.dgRound3Message1s[i] = r3msg1
-		round.out <- r3msg1
+		if err := tss.SendMessage(round.Params(), round.out, r3msg1); err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+		}
 	}
 
 	vDeCmt := round.temp.VD
@@ -42,7 +44,9 @@ func (round *round3) Start() *tss.Error {
 		round.NewParties().IDs().Exclude(round.PartyID()), round.PartyID(),
 		vDeCmt)
 	round.temp.dgRound3Message2s[i] = r3msg2
-	round.out <- r3msg2
+	if err := tss.SendMessage(round.Params(), round.out, r3msg2); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
 
 	return nil
 }
@@ -8,11 +8,10 @@ package resharing
 
 import (
 	"encoding/hex"
-	"errors"
+	"fmt"
 	"math/big"
 	"sync"
-
-	errors2 "github.com/pkg/errors"
+	"time"
 
 	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
@@ -24,7 +23,7 @@ import (
 
 func (round *round4) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 	round.number = 4
 	round.started = true
@@ -37,12 +36,13 @@ func (round *round4) Start() *tss.Error {
 		return nil
 	}
 
-	common.Logger.Debugf(
+	round.Params().Logger().Debugf(
 		"%s Setting up DLN verification with concurrency level of %d",
 		round.PartyID(),
 		round.Concurrency(),
 	)
-	verifier := keygen.NewProofVerifier(round.Concurrency())
+	verifier := keygen.NewProofVerifierWithContext(round.Context(), round.Concurrency())
+	verifier.SetMetrics(round.Params().Metrics())
 
 	Pi := round.PartyID()
 	i := Pi.Index
@@ -63,64 +63,76 @@ func (round *round4) Start() *tss.Error {
 			r2msg1.UnmarshalH1(),
 			r2msg1.UnmarshalH2()
 		if H1j.Cmp(H2j) == 0 {
-			return round.WrapError(errors.New("h1j and h2j were equal for this party"), msg.GetFrom())
+			return round.WrapError(fmt.Errorf("%w: h1j and h2j were equal for this party", tss.ErrInvalidMessage), msg.GetFrom())
 		}
 		h1JHex, h2JHex := hex.EncodeToString(H1j.Bytes()), hex.EncodeToString(H2j.Bytes())
 		if _, found := h1H2Map[h1JHex]; found {
-			return round.WrapError(errors.New("this h1j was already used by another party"), msg.GetFrom())
+			return round.WrapError(fmt.Errorf("%w: this h1j was already used by another party", tss.ErrInvalidMessage), msg.GetFrom())
 		}
 		if _, found := h1H2Map[h2JHex]; found {
-			return round.WrapError(errors.New("this h2j was already used by another party"), msg.GetFrom())
+			return round.WrapError(fmt.Errorf("%w: this h2j was already used by another party", tss.ErrInvalidMessage), msg.GetFrom())
 		}
 		h1H2Map[h1JHex], h1H2Map[h2JHex] = struct{}{}, struct{}{}
 		wg.Add(5)
 		go func(j int, msg tss.ParsedMessage, r2msg1 *DGRound2Message1) {
-			if ok, err := r2msg1.UnmarshalPaillierProof().Verify(paiPK.N, msg.GetFrom().KeyInt(), round.save.ECDSAPub); err != nil || !ok {
+			start := time.Now()
+			ok, err := r2msg1.UnmarshalPaillierProof().Verify(round.SessionID(), paiPK.N, msg.GetFrom().KeyInt(), round.save.ECDSAPub)
+			round.Params().Metrics().RecordProof(tss.ProofPaillierKey, tss.ProofVerification, start)
+			if err != nil || !ok {
 				paiProofCulprits[j] = msg.GetFrom()
-				common.Logger.Warningf("paillier verify failed for party %s", msg.GetFrom(), err)
+				round.Params().Logger().Warningf("paillier verify failed for party %s: %v", msg.GetFrom(), err)
 			}
 			wg.Done()
 		}(j, msg, r2msg1)
 		_j := j
 		_msg := msg
-		verifier.VerifyDLNProof1(r2msg1, H1j, H2j, NTildej, func(isValid bool) {
+		verifier.VerifyDLNProof1(round.SessionID(), r2msg1, H1j, H2j, NTildej, func(isValid bool) {
 			if !isValid {
 				dlnProof1FailCulprits[_j] = _msg.GetFrom()
-				common.Logger.Warningf("dln proof 1 verify failed for party %s", _msg.GetFrom())
+				round.Params().Logger().Warningf("dln proof 1 verify failed for party %s", _msg.GetFrom())
 			}
 			wg.Done()
 		})
-		verifier.VerifyDLNProof2(r2msg1, H2j, H1j, NTildej, func(isValid bool) {
+		verifier.VerifyDLNProof2(round.SessionID(), r2msg1, H2j, H1j, NTildej, func(isValid bool) {
 			if !isValid {
 				dlnProof2FailCulprits[_j] = _msg.GetFrom()
-				common.Logger.Warningf("dln proof 2 verify failed for party %s", _msg.GetFrom())
+				round.Params().Logger().Warningf("dln proof 2 verify failed for party %s", _msg.GetFrom())
 			}
 			wg.Done()
 		})
-		verifier.VerifyModProof(r2msg1, paiPK.N, func(isValid bool) {
+		verifier.VerifyModProof(round.SessionID(), r2msg1, paiPK.N, func(isValid bool) {
 			if !isValid {
 				modProofFailCulprits[_j] = _msg.GetFrom()
-				common.Logger.Warningf("mod proof verify failed for party %s", _msg.GetFrom())
+				round.Params().Logger().Warningf("mod proof verify failed for party %s", _msg.GetFrom())
 			}
 			wg.Done()
 		})
-		verifier.VerifyModProofTilde(r2msg1, NTildej, func(isValid bool) {
+		verifier.VerifyModProofTilde(round.SessionID(), r2msg1, NTildej, func(isValid bool) {
 			if !isValid {
 				modProofFailCulprits[_j] = _msg.GetFrom()
-				common.Logger.Warningf("mod proof tilde verify failed for party %s", _msg.GetFrom())
+				round.Params().Logger().Warningf("mod proof tilde verify failed for party %s", _msg.GetFrom())
 			}
 			wg.Done()
 		})
 	}
 	wg.Wait()
-	for _, culprit := range append(append(paiProofCulprits, dlnProof1FailCulprits...), dlnProof2FailCulprits...) {
+	// abandoned verifications are not the provers' fault
+	if err := round.Context().Err(); err != nil {
+		return round.WrapError(err)
+	}
+	for _, culprit := range paiProofCulprits {
 		if culprit != nil {
-			return round.WrapError(errors.New("dln proof verification failed"), culprit)
+			return round.WrapError(tss.NewProofError(tss.ProofPaillierKey, nil), culprit)
+		}
+	}
+	for _, culprit := range append(dlnProof1FailCulprits, dlnProof2FailCulprits...) {
+		if culprit != nil {
+			return round.WrapError(tss.NewProofError(tss.ProofDLN, nil), culprit)
 		}
 	}
 	for _, culprit := range append(modProofFailCulprits, modProofTildeFailCulprits...) {
 		if culprit != nil {
-			return round.WrapError(errors.New("mod proof verification failed"), culprit)
+			return round.WrapError(tss.NewProofError(tss.ProofPaillierMod, nil), culprit)
 		}
 	}
 	// save NTilde_j, h1_j, h2_j received in NewCommitteeStep1 here
@@ -152,11 +164,11 @@ func (round *round4) Start() *tss.Error {
 		ok, flatVs := vCmtDeCmt.DeCommit()
 		if !ok || len(flatVs) != (round.NewThreshold()+1)*2 { // they're points so * 2
 			// TODO collect culprits and return a list of them as per convention
-			return round.WrapError(errors.New("de-commitment of v_j0..v_jt failed"), round.Parties().IDs()[j])
+			return round.WrapError(fmt.Errorf("%w: v_j0..v_jt", tss.ErrBadDeCommitment), round.Parties().IDs()[j])
 		}
 		vj, err := crypto.UnFlattenECPoints(round.Params().EC(), flatVs)
 		if err != nil {
-			return round.WrapError(err, round.Parties().IDs()[j])
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrPointNotOnCurve, err), round.Parties().IDs()[j])
 		}
 		vjc[j] = vj
 
@@ -169,7 +181,7 @@ func (round *round4) Start() *tss.Error {
 		}
 		if ok := sharej.Verify(round.Params().EC(), round.NewThreshold(), vj); !ok {
 			// TODO collect culprits and return a list of them as per convention
-			return round.WrapError(errors.New("share from old committee did not pass Verify()"), round.Parties().IDs()[j])
+			return round.WrapError(fmt.Errorf("%w: share from old committee", tss.ErrBadShare), round.Parties().IDs()[j])
 		}
 
 		// 9.
@@ -184,14 +196,14 @@ func (round *round4) Start() *tss.Error {
 		for j := 1; j <= len(vjc)-1; j++ {
 			Vc[c], err = Vc[c].Add(vjc[j][c])
 			if err != nil {
-				return round.WrapError(errors2.Wrapf(err, "Vc[c].Add(vjc[j][c])"))
+				return round.WrapError(fmt.Errorf("%w: Vc[c].Add(vjc[j][c]): %v", tss.ErrPointNotOnCurve, err))
 			}
 		}
 	}
 
 	// 14.
 	if !Vc[0].Equals(round.save.ECDSAPub) {
-		return round.WrapError(errors.New("assertion failed: V_0 != y"), round.PartyID())
+		return round.WrapError(fmt.Errorf("%w: V_0 != y", tss.ErrInconsistentResult), round.PartyID())
 	}
 
 	// 15-19.
@@ -214,7 +226,7 @@ func (round *round4) Start() *tss.Error {
 		newBigXjs[j] = newBigXj
 	}
 	if len(paiProofCulprits) > 0 {
-		return round.WrapError(errors2.Wrapf(err, "newBigXj.Add(Vc[c].ScalarMult(z))"), paiProofCulprits...)
+		return round.WrapError(fmt.Errorf("%w: newBigXj.Add(Vc[c].ScalarMult(z)): %v", tss.ErrPointNotOnCurve, err), paiProofCulprits...)
 	}
 
 	for j, Pj := range round.NewParties().IDs() {
@@ -225,11 +237,17 @@ func (round *round4) Start() *tss.Error {
 
 		// Add factor proofs
 		H1j, H2j, NTildej := round.save.H1j[j], round.save.H2j[j], round.save.NTildej[j]
-		facProof := round.save.LocalPreParams.PaillierSK.FactorProof(NTildej, H1j, H2j)
-		facProofTilde := round.temp.skTilde.FactorProof(NTildej, H1j, H2j)
+		start := time.Now()
+		facProof := round.save.LocalPreParams.PaillierSK.FactorProofWithRand(round.Rand(), round.SessionID(), NTildej, H1j, H2j)
+		round.Params().Metrics().RecordProof(tss.ProofPaillierFactor, tss.ProofGeneration, start)
+		start = time.Now()
+		facProofTilde := round.temp.skTilde.FactorProofWithRand(round.Rand(), round.SessionID(), NTildej, H1j, H2j)
+		round.Params().Metrics().RecordProof(tss.ProofPaillierFactor, tss.ProofGeneration, start)
 
 		r4msg1 := NewDGRound4Message1(Pj, Pi, facProof, facProofTilde)
-		round.out <- r4msg1
+		if err := tss.SendMessage(round.Params(), round.out, r4msg1); err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+		}
 	}
 
 	round.temp.newXi = newXi
@@ -239,7 +257,9 @@ func (round *round4) Start() *tss.Error {
 	// Send an "ACK" message to both committees to signal that we're ready to save our data
 	r4msg := NewDGRound4Message2(round.OldAndNewParties(), Pi)
 	round.temp.dgRound4Message2s[i] = r4msg
-	round.out <- r4msg
+	if err := tss.SendMessage(round.Params(), round.out, r4msg); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
 
 	return nil
 }
@@ -283,7 +303,7 @@ func (round *round4) Update() (bool, *tss.Error) {
 			round.newOK[j] = true
 		}
 	} else {
-		return false, round.WrapError(errors.New("this party is not in the old or the new committee"), round.PartyID())
+		return false, round.WrapError(fmt.Errorf("%w: this party is not in the old or the new committee", tss.ErrInternal), round.PartyID())
 	}
 	return true, nil
 }
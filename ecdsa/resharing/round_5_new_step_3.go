@@ -7,7 +7,8 @@
 package resharing
 
 import (
-	"errors"
+	"fmt"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 
@@ -17,7 +18,7 @@ import (
 
 func (round *round5) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 	round.number = 5
 	round.started = true
@@ -59,21 +60,21 @@ func (round *round5) Start() *tss.Error {
 			pkN := pk.N
 			NTilde := round.save.LocalPreParams.NTildei
 			H1i, H2i := round.save.LocalPreParams.H1i, round.save.LocalPreParams.H2i
-			ok, err := FacProof.FactorVerify(pkN, NTilde, H1i, H2i)
-			if err != nil {
-				ch <- proofOut{err}
-			}
-			if !ok {
-				ch <- proofOut{errors.New("factor proof verify failed")}
+			start := time.Now()
+			ok, err := FacProof.FactorVerify(round.SessionID(), pkN, NTilde, H1i, H2i)
+			round.Params().Metrics().RecordProof(tss.ProofPaillierFactor, tss.ProofVerification, start)
+			if err != nil || !ok {
+				ch <- proofOut{tss.NewProofError(tss.ProofPaillierFactor, err)}
+				return
 			}
 			FacProofTilde := r4msg1.UnmarshalFactorProofTilde()
 			NTildej := round.save.NTildej[j]
-			ok, err = FacProofTilde.FactorVerify(NTildej, NTilde, H1i, H2i)
-			if err != nil {
-				ch <- proofOut{err}
-			}
-			if !ok {
-				ch <- proofOut{errors.New("factor proof verify failed")}
+			start = time.Now()
+			ok, err = FacProofTilde.FactorVerify(round.SessionID(), NTildej, NTilde, H1i, H2i)
+			round.Params().Metrics().RecordProof(tss.ProofPaillierFactor, tss.ProofVerification, start)
+			if err != nil || !ok {
+				ch <- proofOut{tss.NewProofError(tss.ProofPaillierFactor, err)}
+				return
 			}
 			// (9) handled above
 			ch <- proofOut{nil}
@@ -106,7 +107,9 @@ func (round *round5) Start() *tss.Error {
 
 	r5msg := NewDGRound5Message(round.OldAndNewParties(), Pi)
 	round.temp.dgRound5Messages[i] = r5msg
-	round.out <- r5msg
+	if err := tss.SendMessage(round.Params(), round.out, r5msg); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
 	return nil
 }
 
@@ -131,7 +134,7 @@ func (round *round5) Update() (bool, *tss.Error) {
 			round.newOK[j] = true
 		}
 	} else {
-		return false, round.WrapError(errors.New("this party is not in the old or the new committee"), round.PartyID())
+		return false, round.WrapError(fmt.Errorf("%w: this party is not in the old or the new committee", tss.ErrInternal), round.PartyID())
 	}
 	return true, nil
 }
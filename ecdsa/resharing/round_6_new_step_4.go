@@ -1,14 +1,14 @@
 package resharing
 
 import (
-	"errors"
+	"fmt"
 
 	"github.com/bnb-chain/tss-lib/tss"
 )
 
 func (round *round6) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 	round.number = 6
 	round.started = true
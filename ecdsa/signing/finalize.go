@@ -8,7 +8,6 @@ package signing
 
 import (
 	"crypto/ecdsa"
-	"errors"
 	"fmt"
 	"math/big"
 
@@ -18,12 +17,33 @@ import (
 
 func (round *finalization) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 	round.number = 10
 	round.started = true
 	round.resetOK()
 
+	return round.finalize()
+}
+
+func (round *finalization) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *finalization) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *finalization) NextRound() tss.Round {
+	return nil // finished!
+}
+
+// ----- //
+
+// finalize sums the signature shares si of round 9 into the signature of the message and checks it against the public key
+func (round *base) finalize() *tss.Error {
 	sumS := round.temp.si
 	modN := common.ModInt(round.Params().EC().Params().N)
 
@@ -70,7 +90,7 @@ func (round *finalization) Start() *tss.Error {
 	}
 	ok := ecdsa.Verify(&pk, round.temp.m.Bytes(), round.temp.rx, sumS)
 	if !ok {
-		return round.WrapError(fmt.Errorf("signature verification failed"))
+		return round.WrapError(fmt.Errorf("%w: signature verification failed", tss.ErrInconsistentResult))
 	}
 
 	round.end <- *round.data
@@ -78,20 +98,6 @@ func (round *finalization) Start() *tss.Error {
 	return nil
 }
 
-func (round *finalization) CanAccept(msg tss.ParsedMessage) bool {
-	// not expecting any incoming messages in this round
-	return false
-}
-
-func (round *finalization) Update() (bool, *tss.Error) {
-	// not expecting any incoming messages in this round
-	return false, nil
-}
-
-func (round *finalization) NextRound() tss.Round {
-	return nil // finished!
-}
-
 func padToLengthBytesInPlace(src []byte, length int) []byte {
 	oriLen := len(src)
 	if oriLen < length {
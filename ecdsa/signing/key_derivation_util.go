@@ -5,9 +5,9 @@ package signing
 import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
+	"fmt"
 	"math/big"
 
-	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
 	"github.com/bnb-chain/tss-lib/crypto/ckd"
 	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
@@ -21,16 +21,14 @@ func UpdatePublicKeyAndAdjustBigXj(keyDerivationDelta *big.Int, keys []keygen.Lo
 	for k := range keys {
 		keys[k].ECDSAPub, err = crypto.NewECPoint(ec, extendedChildPk.X, extendedChildPk.Y)
 		if err != nil {
-			common.Logger.Errorf("error creating new extended child public key")
-			return err
+			return fmt.Errorf("error creating new extended child public key: %w", err)
 		}
 		// Suppose X_j has shamir shares X_j0,     X_j1,     ..., X_jn
 		// So X_j + D has shamir shares  X_j0 + D, X_j1 + D, ..., X_jn + D
 		for j := range keys[k].BigXj {
 			keys[k].BigXj[j], err = keys[k].BigXj[j].Add(gDelta)
 			if err != nil {
-				common.Logger.Errorf("error in delta operation")
-				return err
+				return fmt.Errorf("error in delta operation: %w", err)
 			}
 		}
 	}
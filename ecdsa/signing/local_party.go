@@ -7,7 +7,7 @@
 package signing
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"math/big"
 
@@ -34,8 +34,9 @@ type (
 		data common.SignatureData
 
 		// outbound messaging
-		out chan<- tss.Message
-		end chan<- common.SignatureData
+		out    chan<- tss.Message
+		end    chan<- common.SignatureData
+		preEnd chan<- *PreSignatureData
 	}
 
 	localMessageStore struct {
@@ -54,6 +55,11 @@ type (
 	localTempData struct {
 		localMessageStore
 
+		// presigning runs rounds 1-9 without the message and ends with a presignature;
+		// online signing starts from a presignature and runs a single round
+		preSigning,
+		online bool
+
 		// temp data (thrown away after sign) / round 1
 		w,
 		m,
@@ -114,7 +120,7 @@ func NewLocalPartyWithKDD(
 ) tss.Party {
 	partyCount := len(params.Parties().IDs())
 	p := &LocalParty{
-		BaseParty: new(tss.BaseParty),
+		BaseParty: tss.NewBaseParty(params),
 		params:    params,
 		keys:      keygen.BuildLocalSaveDataSubset(key, params.Parties().IDs()),
 		temp:      localTempData{},
@@ -148,20 +154,34 @@ func NewLocalPartyWithKDD(
 }
 
 func (p *LocalParty) FirstRound() tss.Round {
-	return newRound1(p.params, &p.keys, &p.data, &p.temp, p.out, p.end)
+	if p.temp.online {
+		return newOnlineRound(p.params, &p.keys, &p.data, &p.temp, p.out, p.end)
+	}
+	return newRound1(p.params, &p.keys, &p.data, &p.temp, p.out, p.end, p.preEnd)
 }
 
 func (p *LocalParty) Start() *tss.Error {
-	return tss.BaseStart(p, TaskName, func(round tss.Round) *tss.Error {
-		round1, ok := round.(*round1)
-		if !ok {
-			return round.WrapError(errors.New("unable to Start(). party is in an unexpected round"))
-		}
-		if err := round1.prepare(); err != nil {
-			return round.WrapError(err)
-		}
-		return nil
-	})
+	return tss.BaseStart(p, TaskName, prepareRound1)
+}
+
+func (p *LocalParty) StartWithContext(ctx context.Context) *tss.Error {
+	return tss.BaseStartWithContext(ctx, p, TaskName, prepareRound1)
+}
+
+func prepareRound1(round tss.Round) *tss.Error {
+	var err error
+	switch round := round.(type) {
+	case *round1:
+		err = round.prepare()
+	case *onlineRound:
+		err = round.prepare()
+	default:
+		err = fmt.Errorf("%w: unable to Start(). party is in an unexpected round", tss.ErrInternal)
+	}
+	if err != nil {
+		return round.WrapError(err)
+	}
+	return nil
 }
 
 func (p *LocalParty) Update(msg tss.ParsedMessage) (ok bool, err *tss.Error) {
@@ -169,9 +189,9 @@ func (p *LocalParty) Update(msg tss.ParsedMessage) (ok bool, err *tss.Error) {
 }
 
 func (p *LocalParty) UpdateFromBytes(wireBytes []byte, from *tss.PartyID, isBroadcast bool) (bool, *tss.Error) {
-	msg, err := tss.ParseWireMessage(wireBytes, from, isBroadcast)
+	msg, err := p.ParseWireMessage(wireBytes, from, isBroadcast)
 	if err != nil {
-		return false, p.WrapError(err)
+		return false, err
 	}
 	return p.Update(msg)
 }
@@ -182,7 +202,7 @@ func (p *LocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	}
 	// check that the message's "from index" will fit into the array
 	if maxFromIdx := len(p.params.Parties().IDs()) - 1; maxFromIdx < msg.GetFrom().Index {
-		return false, p.WrapError(fmt.Errorf("received msg with a sender index too great (%d <= %d)",
+		return false, p.WrapError(fmt.Errorf("%w: received msg with a sender index too great (%d <= %d)", tss.ErrInvalidMessage,
 			maxFromIdx, msg.GetFrom().Index), msg.GetFrom())
 	}
 	return true, nil
@@ -193,36 +213,34 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	if ok, err := p.ValidateMessage(msg); !ok || err != nil {
 		return ok, err
 	}
-	fromPIdx := msg.GetFrom().Index
 
 	// switch/case is necessary to store any messages beyond current round
-	// this does not handle message replays. we expect the caller to apply replay and spoofing protection.
+	// a sender may deliver each message only once; identical re-deliveries are ignored. spoofing protection is left to the caller.
 	switch msg.Content().(type) {
 	case *SignRound1Message1:
-		p.temp.signRound1Message1s[fromPIdx] = msg
+		return tss.StoreMessageOnce(p, p.temp.signRound1Message1s, msg)
 	case *SignRound1Message2:
-		p.temp.signRound1Message2s[fromPIdx] = msg
+		return tss.StoreMessageOnce(p, p.temp.signRound1Message2s, msg)
 	case *SignRound2Message:
-		p.temp.signRound2Messages[fromPIdx] = msg
+		return tss.StoreMessageOnce(p, p.temp.signRound2Messages, msg)
 	case *SignRound3Message:
-		p.temp.signRound3Messages[fromPIdx] = msg
+		return tss.StoreMessageOnce(p, p.temp.signRound3Messages, msg)
 	case *SignRound4Message:
-		p.temp.signRound4Messages[fromPIdx] = msg
+		return tss.StoreMessageOnce(p, p.temp.signRound4Messages, msg)
 	case *SignRound5Message:
-		p.temp.signRound5Messages[fromPIdx] = msg
+		return tss.StoreMessageOnce(p, p.temp.signRound5Messages, msg)
 	case *SignRound6Message:
-		p.temp.signRound6Messages[fromPIdx] = msg
+		return tss.StoreMessageOnce(p, p.temp.signRound6Messages, msg)
 	case *SignRound7Message:
-		p.temp.signRound7Messages[fromPIdx] = msg
+		return tss.StoreMessageOnce(p, p.temp.signRound7Messages, msg)
 	case *SignRound8Message:
-		p.temp.signRound8Messages[fromPIdx] = msg
+		return tss.StoreMessageOnce(p, p.temp.signRound8Messages, msg)
 	case *SignRound9Message:
-		p.temp.signRound9Messages[fromPIdx] = msg
+		return tss.StoreMessageOnce(p, p.temp.signRound9Messages, msg)
 	default: // unrecognised message, just ignore!
-		common.Logger.Warningf("unrecognised message ignored: %v", msg)
+		p.params.Logger().Warningf("unrecognised message ignored: %s", msg.Type())
 		return false, nil
 	}
-	return true, nil
 }
 
 func (p *LocalParty) PartyID() *tss.PartyID {
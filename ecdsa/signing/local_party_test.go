@@ -7,20 +7,27 @@
 package signing
 
 import (
+	"context"
 	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"runtime"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/ipfs/go-log"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/ecdsa/encoding"
 	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
 	"github.com/bnb-chain/tss-lib/test"
+	"github.com/bnb-chain/tss-lib/test/adversary"
+	"github.com/bnb-chain/tss-lib/test/netsim"
 	"github.com/bnb-chain/tss-lib/tss"
 )
 
@@ -59,6 +66,7 @@ func TestE2EConcurrent(t *testing.T) {
 	// init the parties
 	for i := 0; i < len(signPIDs); i++ {
 		params := tss.NewParameters(tss.S256(), p2pCtx, signPIDs[i], len(signPIDs), threshold)
+		params.SetSessionID([]byte("ecdsa-signing-e2e"))
 
 		P := NewLocalParty(big.NewInt(42), params, keys[i], outCh, endCh).(*LocalParty)
 		parties = append(parties, P)
@@ -239,6 +247,369 @@ signing:
 	}
 }
 
+func TestCheaterWithForgedProofBobIsBlamed(t *testing.T) {
+	setUp("info")
+	threshold := testThreshold
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	endCh := make(chan common.SignatureData, len(signPIDs))
+	newParams := func(i int) *tss.Parameters {
+		return tss.NewParameters(tss.S256(), p2pCtx, signPIDs[i], len(signPIDs), threshold)
+	}
+
+	// party 0 forges the MtA range proof it sends as Bob to every other party
+	corruption := adversary.Corruption{
+		MessageType: adversary.MessageType(&SignRound2Message{}),
+		Corrupt:     adversary.TamperField("proof_bob"),
+	}
+	results, err := adversary.Run(len(signPIDs), 0, newParams, func(i int, params *tss.Parameters, out chan<- tss.Message) tss.Party {
+		return NewLocalParty(big.NewInt(42), params, keys[i], out, endCh)
+	}, corruption)
+	assert.NoError(t, err)
+	assert.NoError(t, adversary.CheckBlamed(results, signPIDs[0], &tss.ProofError{Proof: tss.ProofMtABob}))
+}
+
+// preSign runs presigning with `keys` and returns the presignatures of the parties
+func preSign(t *testing.T, keys []keygen.LocalPartySaveData, signPIDs tss.SortedPartyIDs) []*PreSignatureData {
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	outCh := make(chan tss.Message, len(signPIDs)*len(signPIDs))
+	preEndChs := make([]chan *PreSignatureData, len(signPIDs))
+	net := netsim.New(1)
+	for i, pID := range signPIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(signPIDs), testThreshold)
+		preEndChs[i] = make(chan *PreSignatureData, 1)
+		assert.NoError(t, net.Register(NewLocalPreSigningParty(params, keys[i], outCh, preEndChs[i])))
+	}
+	results, err := net.Run(context.Background(), outCh, time.Second)
+	assert.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, netsim.Finished, r.Outcome, r.String())
+	}
+	preSigs := make([]*PreSignatureData, len(signPIDs))
+	for i, ch := range preEndChs {
+		preSigs[i] = <-ch
+	}
+	return preSigs
+}
+
+func TestE2EPreSigning(t *testing.T) {
+	setUp("info")
+	threshold := testThreshold
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	p2pCtx := tss.NewPeerContext(signPIDs)
+
+	// PHASE: presigning, ahead of the message
+	preSigs := preSign(t, keys, signPIDs)
+
+	// presignatures are stored until a message is to be signed
+	for i, preSig := range preSigs {
+		bz, err := preSig.Export()
+		assert.NoError(t, err)
+		_, err = preSig.Export()
+		assert.Error(t, err, "an exported presignature should have moved out of memory")
+		preSigs[i], err = ImportPreSignatureData(bz)
+		assert.NoError(t, err)
+	}
+
+	// PHASE: online signing, in a single round
+	msg := big.NewInt(42)
+	outCh := make(chan tss.Message, len(signPIDs)*len(signPIDs))
+	endCh := make(chan common.SignatureData, len(signPIDs))
+	net := netsim.New(1)
+	parties := make([]*LocalParty, 0, len(signPIDs))
+	for i, pID := range signPIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(signPIDs), threshold)
+		P := NewLocalPartyWithPreSignature(msg, params, preSigs[i], outCh, endCh).(*LocalParty)
+		parties = append(parties, P)
+		assert.NoError(t, net.Register(P))
+	}
+	results, err := net.Run(context.Background(), outCh, time.Second)
+	assert.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, netsim.Finished, r.Outcome, r.String())
+	}
+	assert.Len(t, endCh, len(signPIDs))
+	sig := &parties[0].data
+	pk := ecdsa.PublicKey{
+		Curve: tss.EC(),
+		X:     keys[0].ECDSAPub.X(),
+		Y:     keys[0].ECDSAPub.Y(),
+	}
+	ok := ecdsa.Verify(&pk, msg.Bytes(), new(big.Int).SetBytes(sig.R), new(big.Int).SetBytes(sig.S))
+	assert.True(t, ok, "ecdsa verify must pass")
+
+	// a presignature is single-use, and so are its copies
+	params := tss.NewParameters(tss.S256(), p2pCtx, signPIDs[0], len(signPIDs), threshold)
+	copied := *preSigs[0]
+	for _, preSig := range []*PreSignatureData{preSigs[0], &copied} {
+		P := NewLocalPartyWithPreSignature(big.NewInt(43), params, preSig, outCh, endCh)
+		err = P.Start()
+		if assert.Error(t, err, "a used presignature should be rejected") {
+			assert.True(t, errors.Is(err, tss.ErrInternal))
+		}
+	}
+	_, err = preSigs[0].Export()
+	assert.Error(t, err, "a used presignature should not be exported")
+}
+
+func TestNilPreSignatureFailsToStart(t *testing.T) {
+	signPIDs := tss.GenerateTestPartyIDs(testThreshold + 1)
+	params := tss.NewParameters(tss.S256(), tss.NewPeerContext(signPIDs), signPIDs[0], len(signPIDs), testThreshold)
+	var P tss.Party
+	assert.NotPanics(t, func() {
+		P = NewLocalPartyWithPreSignature(big.NewInt(42), params, nil, make(chan tss.Message, 1), nil)
+	})
+	err := P.Start()
+	if assert.Error(t, err, "a party without a presignature should not start") {
+		assert.True(t, errors.Is(err, tss.ErrInternal))
+	}
+}
+
+func TestPreSignatureJSONHasNoSecret(t *testing.T) {
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	preSig := newPreSignatureData(keys[0].Ks, keys[0].ECDSAPub, keys[0].ECDSAPub, big.NewInt(1), big.NewInt(2))
+
+	// the secret shares are only ever stored deliberately, with Export
+	bz, err := json.Marshal(preSig)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(bz), "Ki")
+	decoded := new(PreSignatureData)
+	assert.NoError(t, json.Unmarshal(bz, decoded))
+	params := tss.NewParameters(tss.S256(), tss.NewPeerContext(signPIDs), signPIDs[0], len(signPIDs), testThreshold)
+	err = NewLocalPartyWithPreSignature(big.NewInt(42), params, decoded, make(chan tss.Message, 1), nil).Start()
+	if assert.Error(t, err, "a presignature read from JSON should have no shares to sign with") {
+		assert.True(t, errors.Is(err, tss.ErrInternal))
+	}
+	_, err = ImportPreSignatureData(bz)
+	assert.Error(t, err)
+}
+
+func TestE2EPreSigningBadShareNamesNoCulprit(t *testing.T) {
+	setUp("info")
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	preSigs := preSign(t, keys, signPIDs)
+
+	// party 0 sends a bad signature share online, which the others can only see in the signature that does not verify
+	endCh := make(chan common.SignatureData, len(signPIDs))
+	newParams := func(i int) *tss.Parameters {
+		return tss.NewParameters(tss.S256(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+	}
+	corruption := adversary.Corruption{
+		MessageType: adversary.MessageType(&SignRound9Message{}),
+		Corrupt:     adversary.TamperField("s"),
+	}
+	results, err := adversary.Run(len(signPIDs), 0, newParams, func(i int, params *tss.Parameters, out chan<- tss.Message) tss.Party {
+		return NewLocalPartyWithPreSignature(big.NewInt(42), params, preSigs[i], out, endCh)
+	}, corruption)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, results)
+	for _, r := range results {
+		if assert.Equal(t, netsim.Errored, r.Outcome, r.String()) {
+			assert.True(t, errors.Is(r.Err, tss.ErrInconsistentResult), r.String())
+			assert.Empty(t, r.Err.Culprits())
+		}
+	}
+}
+
+func TestE2EResumeFromSnapshot(t *testing.T) {
+	setUp("info")
+	threshold := testThreshold
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	parties := make([]*LocalParty, 0, len(signPIDs))
+
+	errCh := make(chan *tss.Error, len(signPIDs)*len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs)*len(signPIDs))
+	endCh := make(chan common.SignatureData, len(signPIDs))
+
+	msg := big.NewInt(200)
+	newParty := func(i int) *LocalParty {
+		params := tss.NewParameters(tss.S256(), p2pCtx, signPIDs[i], len(signPIDs), threshold)
+		params.SetSessionID([]byte("ecdsa-signing-resume"))
+		return NewLocalParty(msg, params, keys[i], outCh, endCh).(*LocalParty)
+	}
+	for i := range signPIDs {
+		P := newParty(i)
+		parties = append(parties, P)
+		if err := P.Start(); err != nil {
+			assert.FailNow(t, err.Error())
+		}
+	}
+
+	snapshotKey, otherKey := make([]byte, 32), make([]byte, 32)
+	otherKey[0] = 1
+	resumes := 0
+	deliver := func(P *LocalParty, msg tss.Message) {
+		if P.PartyID().Index == 0 {
+			// restart P[0] from a snapshot before every message it receives
+			snapshot, err := P.Snapshot(snapshotKey)
+			if !assert.Nil(t, err) {
+				return
+			}
+			err = newParty(0).Resume(otherKey, snapshot)
+			assert.True(t, errors.Is(err, tss.ErrInvalidSnapshot))
+			P = newParty(0)
+			if err := P.Resume(snapshotKey, snapshot); !assert.Nil(t, err) {
+				return
+			}
+			parties[0] = P
+			resumes++
+		}
+		test.SharedPartyUpdater(P, msg, errCh)
+	}
+
+	var ended int
+	for ended < len(signPIDs) {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			if dest := msg.GetTo(); dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index != msg.GetFrom().Index {
+						deliver(P, msg)
+					}
+				}
+			} else {
+				deliver(parties[dest[0].Index], msg)
+			}
+		case <-endCh:
+			ended++
+		}
+	}
+	// a message to each other party in rounds 1 and 2, and a broadcast in each of the 9 rounds but round 2
+	assert.Equal(t, (len(signPIDs)-1)*10, resumes)
+
+	sig := &parties[0].data
+	pk := ecdsa.PublicKey{
+		Curve: tss.EC(),
+		X:     keys[0].ECDSAPub.X(),
+		Y:     keys[0].ECDSAPub.Y(),
+	}
+	ok := ecdsa.Verify(&pk, msg.Bytes(), new(big.Int).SetBytes(sig.R), new(big.Int).SetBytes(sig.S))
+	assert.True(t, ok, "ecdsa verify must pass")
+
+	// a finished party has nothing to snapshot
+	_, err2 := parties[0].Snapshot(snapshotKey)
+	assert.True(t, errors.Is(err2, tss.ErrInvalidSnapshot))
+}
+
+func TestSnapshotKeepsSigningMode(t *testing.T) {
+	setUp("info")
+	threshold := testThreshold
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	params := tss.NewParameters(tss.S256(), p2pCtx, signPIDs[0], len(signPIDs), threshold)
+	outCh := make(chan tss.Message, len(signPIDs)*len(signPIDs))
+	endCh := make(chan common.SignatureData, 1)
+	preEndCh := make(chan *PreSignatureData, 1)
+
+	P := NewLocalPreSigningParty(params, keys[0], outCh, preEndCh).(*LocalParty)
+	if err := P.Start(); !assert.Nil(t, err) {
+		return
+	}
+	key := make([]byte, 32)
+	snapshot, err2 := P.Snapshot(key)
+	if !assert.Nil(t, err2) {
+		return
+	}
+
+	// a presigning snapshot resumed by a signing party would sign the message 0
+	err2 = NewLocalParty(big.NewInt(42), params, keys[0], outCh, endCh).(*LocalParty).Resume(key, snapshot)
+	if assert.NotNil(t, err2, "a snapshot should not be resumed in another mode") {
+		assert.True(t, errors.Is(err2, tss.ErrInvalidSnapshot))
+	}
+	err2 = NewLocalPreSigningParty(params, keys[0], outCh, preEndCh).(*LocalParty).Resume(key, snapshot)
+	assert.Nil(t, err2, "a snapshot should be resumed in the same mode")
+}
+
+func TestSeededRandomReproducesRun(t *testing.T) {
+	setUp("info")
+	threshold := testThreshold
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	run := func() [][]byte {
+		p2pCtx := tss.NewPeerContext(signPIDs)
+		outCh := make(chan tss.Message, len(signPIDs)*len(signPIDs))
+		endCh := make(chan common.SignatureData, len(signPIDs))
+		net := netsim.New(1)
+		parties := make([]*LocalParty, 0, len(signPIDs))
+		for i, pID := range signPIDs {
+			params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(signPIDs), threshold)
+			params.SetRand(test.NewSeededReader([]byte(fmt.Sprintf("party-%d", i))))
+			P := NewLocalParty(big.NewInt(42), params, keys[i], outCh, endCh).(*LocalParty)
+			parties = append(parties, P)
+			assert.NoError(t, net.Register(P))
+		}
+		results, err := net.Run(context.Background(), outCh, time.Second)
+		assert.NoError(t, err)
+		for _, r := range results {
+			assert.Equal(t, netsim.Finished, r.Outcome, r.String())
+		}
+		sigs := make([][]byte, len(parties))
+		for i, P := range parties {
+			sigs[i] = P.data.GetSignature()
+		}
+		return sigs
+	}
+
+	// the MtA responses of round 2 draw randomness from several goroutines
+	first, second := run(), run()
+	for i := range signPIDs {
+		assert.NotEmpty(t, first[i])
+		assert.Equal(t, first[i], second[i], "party %d should produce the same signature", i)
+	}
+}
+
+func TestE2EPublicKeyRecovery(t *testing.T) {
+	setUp("info")
+	threshold := testThreshold
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	outCh := make(chan tss.Message, len(signPIDs)*len(signPIDs))
+	endCh := make(chan common.SignatureData, len(signPIDs))
+	net := netsim.New(1)
+	parties := make([]*LocalParty, 0, len(signPIDs))
+	for i, pID := range signPIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(signPIDs), threshold)
+		P := NewLocalParty(big.NewInt(42), params, keys[i], outCh, endCh).(*LocalParty)
+		parties = append(parties, P)
+		assert.NoError(t, net.Register(P))
+	}
+	results, err := net.Run(context.Background(), outCh, time.Second)
+	assert.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, netsim.Finished, r.Outcome, r.String())
+	}
+
+	// the recovery id must give back the public key
+	pk := ecdsa.PublicKey{
+		Curve: tss.EC(),
+		X:     keys[0].ECDSAPub.X(),
+		Y:     keys[0].ECDSAPub.Y(),
+	}
+	assert.NoError(t, encoding.CheckPublicKey(&parties[0].data, &pk), "public key recovery must pass")
+}
+
 func TestFillTo32BytesInPlace(t *testing.T) {
 	s := big.NewInt(123456789)
 	normalizedS := padToLengthBytesInPlace(s.Bytes(), 32)
@@ -0,0 +1,311 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+type (
+	// PreSignatureData is the share of a party in a presignature, made ahead of the message to sign.
+	// It is secret and single-use: signing two messages with the same presignature reveals the private key.
+	// Its secret shares are taken by the first party it is given to, and are left out when it is encoded as JSON;
+	// a presignature is stored with Export and read back with ImportPreSignatureData.
+	PreSignatureData struct {
+		// the original indexes of the signing parties; online signing must run with the same parties
+		Ks []*big.Int
+
+		ECDSAPub *crypto.ECPoint // y
+		R        *crypto.ECPoint // R = k^-1 * G
+
+		secret *preSignatureSecret
+	}
+
+	// preSignatureSecret holds the additive shares of k and sigma = k * x until they are taken
+	preSignatureSecret struct {
+		mtx        sync.Mutex
+		ki, sigmaI *big.Int
+	}
+
+	// exportedPreSignature is the encoding of a presignature made by Export
+	exportedPreSignature struct {
+		Ks         []*big.Int
+		ECDSAPub   *crypto.ECPoint
+		R          *crypto.ECPoint
+		Ki, SigmaI *big.Int
+	}
+
+	// round 9 of presigning, which checks U = T and ends with a presignature instead of revealing si
+	preSignFinalization struct {
+		*round8
+	}
+
+	// the single round of online signing with a presignature
+	onlineRound struct {
+		*base
+	}
+	onlineFinalization struct {
+		*onlineRound
+	}
+)
+
+var (
+	_ tss.Round = (*preSignFinalization)(nil)
+	_ tss.Round = (*onlineRound)(nil)
+	_ tss.Round = (*onlineFinalization)(nil)
+)
+
+// NewLocalPreSigningParty returns a party that runs the rounds of signing that do not depend on the message, and sends
+// its share of the presignature to `end`. The presignature is consistent with the public key when it is made; a party
+// then signs a message with NewLocalPartyWithPreSignature in a single round.
+func NewLocalPreSigningParty(
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	out chan<- tss.Message,
+	end chan<- *PreSignatureData,
+) tss.Party {
+	// rounds 5-9 check the presignature as they would check a signature of 0, since si = 0 * ki + r * sigmai
+	p := NewLocalParty(big.NewInt(0), params, key, out, nil).(*LocalParty)
+	p.temp.preSigning = true
+	p.preEnd = end
+	return p
+}
+
+// NewLocalPartyWithPreSignature returns a party that signs `msg` with `preSig` in a single round, together with the
+// parties that made the presignature. The secret shares of `preSig` move into the party, and a party given a presignature
+// whose shares were already taken or exported fails to start.
+// Should the signature not verify, the run fails with a tss.ErrInconsistentResult that names no culprit: the signature
+// shares of the online round are not checked one by one, as in the last round of signing with NewLocalParty, so a party
+// that sends a bad share cannot be told apart. The signing protocol of ecdsa/cggmp identifies such a party.
+func NewLocalPartyWithPreSignature(
+	msg *big.Int,
+	params *tss.Parameters,
+	preSig *PreSignatureData,
+	out chan<- tss.Message,
+	end chan<- common.SignatureData,
+) tss.Party {
+	partyCount := len(params.Parties().IDs())
+	p := &LocalParty{
+		BaseParty: tss.NewBaseParty(params),
+		params:    params,
+		temp:      localTempData{},
+		data:      common.SignatureData{},
+		out:       out,
+		end:       end,
+	}
+	// msgs init
+	p.temp.signRound9Messages = make([]tss.ParsedMessage, partyCount)
+	// temp data init
+	p.temp.online = true
+	p.temp.m = msg
+	if preSig != nil {
+		p.keys = keygen.LocalPartySaveData{Ks: preSig.Ks, ECDSAPub: preSig.ECDSAPub}
+		p.temp.k, p.temp.sigma = preSig.take()
+		if preSig.R != nil {
+			p.temp.bigR, p.temp.rx, p.temp.ry = preSig.R, preSig.R.X(), preSig.R.Y()
+		}
+	}
+	return p
+}
+
+// Export returns the encoding of `preSig` with its secret shares, for it to be stored until a message is to be signed.
+// The shares move out of `preSig`, which can then no longer be used to sign. The caller takes over the single-use
+// guarantee of the stored presignature: it must be deleted from storage when it is read back with ImportPreSignatureData.
+func (preSig *PreSignatureData) Export() ([]byte, error) {
+	ki, sigmaI := preSig.take()
+	if ki == nil || sigmaI == nil {
+		return nil, errors.New("the presignature is incomplete or has already been used")
+	}
+	return json.Marshal(&exportedPreSignature{
+		Ks:       preSig.Ks,
+		ECDSAPub: preSig.ECDSAPub,
+		R:        preSig.R,
+		Ki:       ki,
+		SigmaI:   sigmaI,
+	})
+}
+
+// ImportPreSignatureData reads back a presignature stored with Export
+func ImportPreSignatureData(bz []byte) (*PreSignatureData, error) {
+	exported := new(exportedPreSignature)
+	if err := json.Unmarshal(bz, exported); err != nil {
+		return nil, err
+	}
+	if exported.Ki == nil || exported.SigmaI == nil {
+		return nil, errors.New("the presignature has no secret shares")
+	}
+	return newPreSignatureData(exported.Ks, exported.ECDSAPub, exported.R, exported.Ki, exported.SigmaI), nil
+}
+
+func newPreSignatureData(Ks []*big.Int, ECDSAPub, R *crypto.ECPoint, ki, sigmaI *big.Int) *PreSignatureData {
+	return &PreSignatureData{
+		Ks:       Ks,
+		ECDSAPub: ECDSAPub,
+		R:        R,
+		secret:   &preSignatureSecret{ki: ki, sigmaI: sigmaI},
+	}
+}
+
+// take returns the secret shares of the presignature and clears them, so that they are only ever returned once.
+// Copies of a PreSignatureData share its secret, so taking the shares from one also clears them from the others.
+func (preSig *PreSignatureData) take() (ki, sigmaI *big.Int) {
+	if preSig.secret == nil {
+		return nil, nil
+	}
+	preSig.secret.mtx.Lock()
+	defer preSig.secret.mtx.Unlock()
+	ki, sigmaI = preSig.secret.ki, preSig.secret.sigmaI
+	preSig.secret.ki, preSig.secret.sigmaI = nil, nil
+	return
+}
+
+// ----- //
+
+func (round *preSignFinalization) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	round.number = 9
+	round.started = true
+	round.resetOK()
+
+	if err := round.checkUT(); err != nil {
+		return err
+	}
+	for j := range round.ok {
+		round.ok[j] = true
+	}
+	preSig := newPreSignatureData(round.key.Ks, round.key.ECDSAPub, round.temp.bigR, round.temp.k, round.temp.sigma)
+	round.temp.k, round.temp.sigma, round.temp.si = zero, zero, zero
+	round.preEnd <- preSig
+	return nil
+}
+
+func (round *preSignFinalization) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *preSignFinalization) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *preSignFinalization) NextRound() tss.Round {
+	return nil // finished!
+}
+
+// ----- //
+
+func newOnlineRound(params *tss.Parameters, key *keygen.LocalPartySaveData, data *common.SignatureData, temp *localTempData, out chan<- tss.Message, end chan<- common.SignatureData) tss.Round {
+	return &onlineRound{
+		&base{params, key, data, temp, out, end, nil, make([]bool, len(params.Parties().IDs())), false, 1}}
+}
+
+func (round *onlineRound) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	if round.temp.m.Cmp(round.Params().EC().Params().N) >= 0 {
+		return round.WrapError(fmt.Errorf("%w: hashed message is not valid", tss.ErrInternal))
+	}
+	round.number = 1
+	round.started = true
+	round.resetOK()
+
+	// si = m * ki + r * sigmai, GG18Spec (5A) Fig. 8
+	modN := common.ModInt(round.Params().EC().Params().N)
+	round.temp.si = modN.Add(modN.Mul(round.temp.m, round.temp.k), modN.Mul(round.temp.rx, round.temp.sigma))
+	round.temp.k, round.temp.sigma = zero, zero
+
+	i := round.PartyID().Index
+	round.ok[i] = true
+	r1msg := NewSignRound9Message(round.PartyID(), round.temp.si)
+	round.temp.signRound9Messages[i] = r1msg
+	if err := tss.SendMessage(round.Params(), round.out, r1msg); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
+	return nil
+}
+
+func (round *onlineRound) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.signRound9Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *onlineRound) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*SignRound9Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *onlineRound) NextRound() tss.Round {
+	round.started = false
+	return &onlineFinalization{round}
+}
+
+// prepare checks that the presignature is unused and was made by the parties of this run
+func (round *onlineRound) prepare() error {
+	if round.temp.k == nil || round.temp.sigma == nil || round.temp.bigR == nil || round.key.ECDSAPub == nil {
+		return fmt.Errorf("%w: the presignature is incomplete or has already been used", tss.ErrInternal)
+	}
+	Ps := round.Parties().IDs()
+	if len(round.key.Ks) != len(Ps) {
+		return fmt.Errorf("%w: the presignature was made by %d parties, not %d", tss.ErrInternal, len(round.key.Ks), len(Ps))
+	}
+	for j, Pj := range Ps {
+		if round.key.Ks[j] == nil || round.key.Ks[j].Cmp(Pj.KeyInt()) != 0 {
+			return fmt.Errorf("%w: the presignature was made by other parties", tss.ErrInternal)
+		}
+	}
+	return nil
+}
+
+// ----- //
+
+func (round *onlineFinalization) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	round.number = 2
+	round.started = true
+	round.resetOK()
+
+	return round.finalize()
+}
+
+func (round *onlineFinalization) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *onlineFinalization) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *onlineFinalization) NextRound() tss.Round {
+	return nil // finished!
+}
@@ -7,9 +7,9 @@
 package signing
 
 import (
-	"errors"
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
@@ -24,14 +24,14 @@ var (
 )
 
 // round 1 represents round 1 of the signing part of the GG18 ECDSA TSS spec (Gennaro, Goldfeder; 2018)
-func newRound1(params *tss.Parameters, key *keygen.LocalPartySaveData, data *common.SignatureData, temp *localTempData, out chan<- tss.Message, end chan<- common.SignatureData) tss.Round {
+func newRound1(params *tss.Parameters, key *keygen.LocalPartySaveData, data *common.SignatureData, temp *localTempData, out chan<- tss.Message, end chan<- common.SignatureData, preEnd chan<- *PreSignatureData) tss.Round {
 	return &round1{
-		&base{params, key, data, temp, out, end, make([]bool, len(params.Parties().IDs())), false, 1}}
+		&base{params, key, data, temp, out, end, preEnd, make([]bool, len(params.Parties().IDs())), false, 1}}
 }
 
 func (round *round1) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 
 	// Spec requires calculate H(M) here,
@@ -39,18 +39,18 @@ func (round *round1) Start() *tss.Error {
 	// if this big.Int is not belongs to Zq, the client might not comply with common rule (for ECDSA):
 	// https://github.com/btcsuite/btcd/blob/c26ffa870fd817666a857af1bf6498fabba1ffe3/btcec/signature.go#L263
 	if round.temp.m.Cmp(round.Params().EC().Params().N) >= 0 {
-		return round.WrapError(errors.New("hashed message is not valid"))
+		return round.WrapError(fmt.Errorf("%w: hashed message is not valid", tss.ErrInternal))
 	}
 
 	round.number = 1
 	round.started = true
 	round.resetOK()
 
-	k := common.GetRandomPositiveInt(round.Params().EC().Params().N)
-	gamma := common.GetRandomPositiveInt(round.Params().EC().Params().N)
+	k := common.GetRandomPositiveIntWithRand(round.Rand(), round.Params().EC().Params().N)
+	gamma := common.GetRandomPositiveIntWithRand(round.Rand(), round.Params().EC().Params().N)
 
 	pointGamma := crypto.ScalarBaseMult(round.Params().EC(), gamma)
-	cmt := commitments.NewHashCommitment(pointGamma.X(), pointGamma.Y())
+	cmt := commitments.NewHashCommitmentWithRand(round.Rand(), pointGamma.X(), pointGamma.Y())
 	round.temp.k = k
 	round.temp.gamma = gamma
 	round.temp.pointGamma = pointGamma
@@ -63,18 +63,24 @@ func (round *round1) Start() *tss.Error {
 		if j == i {
 			continue
 		}
-		cA, pi, err := mta.AliceInit(round.Params().EC(), round.key.PaillierPKs[i], k, round.key.NTildej[j], round.key.H1j[j], round.key.H2j[j])
+		start := time.Now()
+		cA, pi, err := mta.AliceInitWithRand(round.Rand(), round.SessionID(), round.Params().EC(), round.key.PaillierPKs[i], k, round.key.NTildej[j], round.key.H1j[j], round.key.H2j[j])
+		round.Params().Metrics().RecordProof(tss.ProofMtARangeAlice, tss.ProofGeneration, start)
 		if err != nil {
-			return round.WrapError(fmt.Errorf("failed to init mta: %v", err))
+			return round.WrapError(fmt.Errorf("%w: failed to init mta: %v", tss.ErrInternal, err))
 		}
 		r1msg1 := NewSignRound1Message1(Pj, round.PartyID(), cA, pi)
 		round.temp.cis[j] = cA
-		round.out <- r1msg1
+		if err := tss.SendMessage(round.Params(), round.out, r1msg1); err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+		}
 	}
 
 	r1msg2 := NewSignRound1Message2(round.PartyID(), cmt.C)
 	round.temp.signRound1Message2s[i] = r1msg2
-	round.out <- r1msg2
+	if err := tss.SendMessage(round.Params(), round.out, r1msg2); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
 
 	return nil
 }
@@ -131,7 +137,7 @@ func (round *round1) prepare() error {
 	}
 
 	if round.Threshold()+1 > len(ks) {
-		return fmt.Errorf("t+1=%d is not satisfied by the key count of %d", round.Threshold()+1, len(ks))
+		return fmt.Errorf("%w: t+1=%d is not satisfied by the key count of %d", tss.ErrInternal, round.Threshold()+1, len(ks))
 	}
 	wi, bigWs := PrepareForSigning(round.Params().EC(), i, len(ks), xi, ks, bigXs)
 
@@ -7,9 +7,12 @@
 package signing
 
 import (
-	"errors"
+	"fmt"
+	"math/big"
 	"sync"
+	"time"
 
+	"github.com/hashicorp/go-multierror"
 	errorspkg "github.com/pkg/errors"
 
 	"github.com/bnb-chain/tss-lib/crypto/mta"
@@ -18,7 +21,7 @@ import (
 
 func (round *round2) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 	round.number = 2
 	round.started = true
@@ -27,6 +30,11 @@ func (round *round2) Start() *tss.Error {
 	i := round.PartyID().Index
 	round.ok[i] = true
 
+	// the goroutines draw from their own sources, derived in order, so that a seeded Rand reproduces the round
+	rands, err := round.SubRands(len(round.Parties().IDs()) * 2)
+	if err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
 	errChs := make(chan *tss.Error, (len(round.Parties().IDs())-1)*2)
 	wg := sync.WaitGroup{}
 	wg.Add((len(round.Parties().IDs()) - 1) * 2)
@@ -40,27 +48,31 @@ func (round *round2) Start() *tss.Error {
 			r1msg := round.temp.signRound1Message1s[j].Content().(*SignRound1Message1)
 			rangeProofAliceJ, err := r1msg.UnmarshalRangeProofAlice()
 			if err != nil {
-				errChs <- round.WrapError(errorspkg.Wrapf(err, "UnmarshalRangeProofAlice failed"), Pj)
+				errChs <- round.WrapError(fmt.Errorf("%w: UnmarshalRangeProofAlice failed: %v", tss.ErrInvalidMessage, err), Pj)
+				return
+			}
+			if !round.verifyRangeProofAlice(j, rangeProofAliceJ, r1msg.UnmarshalC()) {
+				errChs <- round.WrapError(tss.NewProofError(tss.ProofMtARangeAlice, nil), Pj)
 				return
 			}
-			beta, c1ji, _, pi1ji, err := mta.BobMid(
+			start := time.Now()
+			beta, c1ji, _, pi1ji, err := mta.BobRespondWithRand(
+				rands[2*j],
+				round.SessionID(),
 				round.Parameters.EC(),
 				round.key.PaillierPKs[j],
-				rangeProofAliceJ,
 				round.temp.gamma,
 				r1msg.UnmarshalC(),
 				round.key.NTildej[j],
 				round.key.H1j[j],
-				round.key.H2j[j],
-				round.key.NTildej[i],
-				round.key.H1j[i],
-				round.key.H2j[i])
+				round.key.H2j[j])
+			round.Params().Metrics().RecordProof(tss.ProofMtABob, tss.ProofGeneration, start)
 			// should be thread safe as these are pre-allocated
 			round.temp.betas[j] = beta
 			round.temp.c1jis[j] = c1ji
 			round.temp.pi1jis[j] = pi1ji
 			if err != nil {
-				errChs <- round.WrapError(err, Pj)
+				errChs <- round.WrapError(fmt.Errorf("%w: %v", tss.ErrInvalidMessage, err), Pj)
 			}
 		}(j, Pj)
 		// Bob_mid_wc
@@ -69,39 +81,45 @@ func (round *round2) Start() *tss.Error {
 			r1msg := round.temp.signRound1Message1s[j].Content().(*SignRound1Message1)
 			rangeProofAliceJ, err := r1msg.UnmarshalRangeProofAlice()
 			if err != nil {
-				errChs <- round.WrapError(errorspkg.Wrapf(err, "UnmarshalRangeProofAlice failed"), Pj)
+				errChs <- round.WrapError(fmt.Errorf("%w: UnmarshalRangeProofAlice failed: %v", tss.ErrInvalidMessage, err), Pj)
+				return
+			}
+			if !round.verifyRangeProofAlice(j, rangeProofAliceJ, r1msg.UnmarshalC()) {
+				errChs <- round.WrapError(tss.NewProofError(tss.ProofMtARangeAlice, nil), Pj)
 				return
 			}
-			v, c2ji, _, pi2ji, err := mta.BobMidWC(
+			start := time.Now()
+			v, c2ji, _, pi2ji, err := mta.BobRespondWCWithRand(
+				rands[2*j+1],
+				round.SessionID(),
 				round.Parameters.EC(),
 				round.key.PaillierPKs[j],
-				rangeProofAliceJ,
 				round.temp.w,
 				r1msg.UnmarshalC(),
 				round.key.NTildej[j],
 				round.key.H1j[j],
 				round.key.H2j[j],
-				round.key.NTildej[i],
-				round.key.H1j[i],
-				round.key.H2j[i],
 				round.temp.bigWs[i])
+			round.Params().Metrics().RecordProof(tss.ProofMtABobWC, tss.ProofGeneration, start)
 			round.temp.vs[j] = v
 			round.temp.c2jis[j] = c2ji
 			round.temp.pi2jis[j] = pi2ji
 			if err != nil {
-				errChs <- round.WrapError(err, Pj)
+				errChs <- round.WrapError(fmt.Errorf("%w: %v", tss.ErrInvalidMessage, err), Pj)
 			}
 		}(j, Pj)
 	}
 	// consume error channels; wait for goroutines
 	wg.Wait()
 	close(errChs)
+	var multiErr error
 	culprits := make([]*tss.PartyID, 0, len(round.Parties().IDs()))
 	for err := range errChs {
+		multiErr = multierror.Append(multiErr, err.Cause())
 		culprits = append(culprits, err.Culprits()...)
 	}
 	if len(culprits) > 0 {
-		return round.WrapError(errors.New("failed to calculate Bob_mid or Bob_mid_wc"), culprits...)
+		return round.WrapError(errorspkg.Wrapf(multiErr, "failed to calculate Bob_mid or Bob_mid_wc"), culprits...)
 	}
 	// create and send messages
 	for j, Pj := range round.Parties().IDs() {
@@ -110,11 +128,21 @@ func (round *round2) Start() *tss.Error {
 		}
 		r2msg := NewSignRound2Message(
 			Pj, round.PartyID(), round.temp.c1jis[j], round.temp.pi1jis[j], round.temp.c2jis[j], round.temp.pi2jis[j])
-		round.out <- r2msg
+		if err := tss.SendMessage(round.Params(), round.out, r2msg); err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+		}
 	}
 	return nil
 }
 
+// verifyRangeProofAlice verifies the range proof that Pj sent with its ciphertext cA, the first step of Bob_mid
+func (round *round2) verifyRangeProofAlice(j int, pf *mta.RangeProofAlice, cA *big.Int) bool {
+	i := round.PartyID().Index
+	start := time.Now()
+	defer round.Params().Metrics().RecordProof(tss.ProofMtARangeAlice, tss.ProofVerification, start)
+	return pf.Verify(round.SessionID(), round.Params().EC(), round.key.PaillierPKs[j], round.key.NTildej[i], round.key.H1j[i], round.key.H2j[i], cA)
+}
+
 func (round *round2) Update() (bool, *tss.Error) {
 	for j, msg := range round.temp.signRound2Messages {
 		if round.ok[j] {
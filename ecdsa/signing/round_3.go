@@ -7,10 +7,12 @@
 package signing
 
 import (
-	"errors"
+	"fmt"
 	"math/big"
 	"sync"
+	"time"
 
+	"github.com/hashicorp/go-multierror"
 	errorspkg "github.com/pkg/errors"
 
 	"github.com/bnb-chain/tss-lib/common"
@@ -20,7 +22,7 @@ import (
 
 func (round *round3) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 	round.number = 3
 	round.started = true
@@ -44,10 +46,12 @@ func (round *round3) Start() *tss.Error {
 			r2msg := round.temp.signRound2Messages[j].Content().(*SignRound2Message)
 			proofBob, err := r2msg.UnmarshalProofBob()
 			if err != nil {
-				errChs <- round.WrapError(errorspkg.Wrapf(err, "UnmarshalProofBob failed"), Pj)
+				errChs <- round.WrapError(fmt.Errorf("%w: UnmarshalProofBob failed: %v", tss.ErrInvalidMessage, err), Pj)
 				return
 			}
+			start := time.Now()
 			alphaIj, err := mta.AliceEnd(
+				round.SessionID(),
 				round.Params().EC(),
 				round.key.PaillierPKs[i],
 				proofBob,
@@ -57,9 +61,10 @@ func (round *round3) Start() *tss.Error {
 				new(big.Int).SetBytes(r2msg.GetC1()),
 				round.key.NTildej[i],
 				round.key.PaillierSK)
+			round.Params().Metrics().RecordProof(tss.ProofMtABob, tss.ProofVerification, start)
 			alphas[j] = alphaIj
 			if err != nil {
-				errChs <- round.WrapError(err, Pj)
+				errChs <- round.WrapError(tss.NewProofError(tss.ProofMtABob, err), Pj)
 			}
 		}(j, Pj)
 		// Alice_end_wc
@@ -68,10 +73,12 @@ func (round *round3) Start() *tss.Error {
 			r2msg := round.temp.signRound2Messages[j].Content().(*SignRound2Message)
 			proofBobWC, err := r2msg.UnmarshalProofBobWC(round.Parameters.EC())
 			if err != nil {
-				errChs <- round.WrapError(errorspkg.Wrapf(err, "UnmarshalProofBobWC failed"), Pj)
+				errChs <- round.WrapError(fmt.Errorf("%w: UnmarshalProofBobWC failed: %v", tss.ErrInvalidMessage, err), Pj)
 				return
 			}
+			start := time.Now()
 			uIj, err := mta.AliceEndWC(
+				round.SessionID(),
 				round.Params().EC(),
 				round.key.PaillierPKs[i],
 				proofBobWC,
@@ -82,9 +89,10 @@ func (round *round3) Start() *tss.Error {
 				round.key.H1j[i],
 				round.key.H2j[i],
 				round.key.PaillierSK)
+			round.Params().Metrics().RecordProof(tss.ProofMtABobWC, tss.ProofVerification, start)
 			us[j] = uIj
 			if err != nil {
-				errChs <- round.WrapError(err, Pj)
+				errChs <- round.WrapError(tss.NewProofError(tss.ProofMtABobWC, err), Pj)
 			}
 		}(j, Pj)
 	}
@@ -92,12 +100,14 @@ func (round *round3) Start() *tss.Error {
 	// consume error channels; wait for goroutines
 	wg.Wait()
 	close(errChs)
+	var multiErr error
 	culprits := make([]*tss.PartyID, 0, len(round.Parties().IDs()))
 	for err := range errChs {
+		multiErr = multierror.Append(multiErr, err.Cause())
 		culprits = append(culprits, err.Culprits()...)
 	}
 	if len(culprits) > 0 {
-		return round.WrapError(errors.New("failed to calculate Alice_end or Alice_end_wc"), culprits...)
+		return round.WrapError(errorspkg.Wrapf(multiErr, "failed to calculate Alice_end or Alice_end_wc"), culprits...)
 	}
 
 	modN := common.ModInt(round.Params().EC().Params().N)
@@ -116,7 +126,9 @@ func (round *round3) Start() *tss.Error {
 	round.temp.sigma = sigma
 	r3msg := NewSignRound3Message(round.PartyID(), thelta)
 	round.temp.signRound3Messages[round.PartyID().Index] = r3msg
-	round.out <- r3msg
+	if err := tss.SendMessage(round.Params(), round.out, r3msg); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
 
 	return nil
 }
@@ -7,10 +7,9 @@
 package signing
 
 import (
-	"errors"
+	"fmt"
 	"math/big"
-
-	errors2 "github.com/pkg/errors"
+	"time"
 
 	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto/schnorr"
@@ -19,7 +18,7 @@ import (
 
 func (round *round4) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 	round.number = 4
 	round.started = true
@@ -41,14 +40,18 @@ func (round *round4) Start() *tss.Error {
 
 	// compute the multiplicative inverse thelta mod q
 	thetaInverse = modN.ModInverse(thetaInverse)
-	piGamma, err := schnorr.NewZKProof(round.temp.gamma, round.temp.pointGamma)
+	start := time.Now()
+	piGamma, err := schnorr.NewZKProofWithRand(round.Rand(), round.SessionID(), round.temp.gamma, round.temp.pointGamma)
+	round.Params().Metrics().RecordProof(tss.ProofSchnorr, tss.ProofGeneration, start)
 	if err != nil {
-		return round.WrapError(errors2.Wrapf(err, "NewZKProof(gamma, bigGamma)"))
+		return round.WrapError(fmt.Errorf("%w: NewZKProof(gamma, bigGamma): %v", tss.ErrInternal, err))
 	}
 	round.temp.thetaInverse = thetaInverse
 	r4msg := NewSignRound4Message(round.PartyID(), round.temp.deCommit, piGamma)
 	round.temp.signRound4Messages[round.PartyID().Index] = r4msg
-	round.out <- r4msg
+	if err := tss.SendMessage(round.Params(), round.out, r4msg); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
 
 	return nil
 }
@@ -7,9 +7,8 @@
 package signing
 
 import (
-	"errors"
-
-	errors2 "github.com/pkg/errors"
+	"fmt"
+	"time"
 
 	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
@@ -19,7 +18,7 @@ import (
 
 func (round *round5) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 	round.number = 5
 	round.started = true
@@ -36,23 +35,25 @@ func (round *round5) Start() *tss.Error {
 		cmtDeCmt := commitments.HashCommitDecommit{C: SCj, D: SDj}
 		ok, bigGammaJ := cmtDeCmt.DeCommit()
 		if !ok || len(bigGammaJ) != 2 {
-			return round.WrapError(errors.New("commitment verify failed"), Pj)
+			return round.WrapError(tss.ErrBadDeCommitment, Pj)
 		}
 		bigGammaJPoint, err := crypto.NewECPoint(round.Params().EC(), bigGammaJ[0], bigGammaJ[1])
 		if err != nil {
-			return round.WrapError(errors2.Wrapf(err, "NewECPoint(bigGammaJ)"), Pj)
+			return round.WrapError(fmt.Errorf("%w: NewECPoint(bigGammaJ): %v", tss.ErrPointNotOnCurve, err), Pj)
 		}
 		proof, err := r4msg.UnmarshalZKProof(round.Params().EC())
 		if err != nil {
-			return round.WrapError(errors.New("failed to unmarshal bigGamma proof"), Pj)
+			return round.WrapError(fmt.Errorf("%w: failed to unmarshal bigGamma proof: %v", tss.ErrInvalidMessage, err), Pj)
 		}
-		ok = proof.Verify(bigGammaJPoint)
+		start := time.Now()
+		ok = proof.Verify(round.SessionID(), bigGammaJPoint)
+		round.Params().Metrics().RecordProof(tss.ProofSchnorr, tss.ProofVerification, start)
 		if !ok {
-			return round.WrapError(errors.New("failed to prove bigGamma"), Pj)
+			return round.WrapError(tss.NewProofError(tss.ProofSchnorr, nil), Pj)
 		}
 		R, err = R.Add(bigGammaJPoint)
 		if err != nil {
-			return round.WrapError(errors2.Wrapf(err, "R.Add(bigGammaJ)"), Pj)
+			return round.WrapError(fmt.Errorf("%w: R.Add(bigGammaJ): %v", tss.ErrPointNotOnCurve, err), Pj)
 		}
 	}
 
@@ -63,24 +64,28 @@ func (round *round5) Start() *tss.Error {
 	ry := R.Y()
 	si := modN.Add(modN.Mul(round.temp.m, round.temp.k), modN.Mul(rx, round.temp.sigma))
 
-	// clear temp.w and temp.k from memory, lint ignore
+	// clear temp.w and temp.k from memory, lint ignore; a presignature keeps k for signing online
 	round.temp.w = zero
-	round.temp.k = zero
+	if !round.temp.preSigning {
+		round.temp.k = zero
+	}
 
-	li := common.GetRandomPositiveInt(N)  // li
-	roI := common.GetRandomPositiveInt(N) // pi
+	li := common.GetRandomPositiveIntWithRand(round.Rand(), N)  // li
+	roI := common.GetRandomPositiveIntWithRand(round.Rand(), N) // pi
 	rToSi := R.ScalarMult(si)
 	liPoint := crypto.ScalarBaseMult(round.Params().EC(), li)
 	bigAi := crypto.ScalarBaseMult(round.Params().EC(), roI)
 	bigVi, err := rToSi.Add(liPoint)
 	if err != nil {
-		return round.WrapError(errors2.Wrapf(err, "rToSi.Add(li)"))
+		return round.WrapError(fmt.Errorf("%w: rToSi.Add(li): %v", tss.ErrPointNotOnCurve, err))
 	}
 
-	cmt := commitments.NewHashCommitment(bigVi.X(), bigVi.Y(), bigAi.X(), bigAi.Y())
+	cmt := commitments.NewHashCommitmentWithRand(round.Rand(), bigVi.X(), bigVi.Y(), bigAi.X(), bigAi.Y())
 	r5msg := NewSignRound5Message(round.PartyID(), cmt.C)
 	round.temp.signRound5Messages[round.PartyID().Index] = r5msg
-	round.out <- r5msg
+	if err := tss.SendMessage(round.Params(), round.out, r5msg); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
 
 	round.temp.li = li
 	round.temp.bigAi = bigAi
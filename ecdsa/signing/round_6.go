@@ -7,9 +7,8 @@
 package signing
 
 import (
-	"errors"
-
-	errors2 "github.com/pkg/errors"
+	"fmt"
+	"time"
 
 	"github.com/bnb-chain/tss-lib/crypto/schnorr"
 	"github.com/bnb-chain/tss-lib/tss"
@@ -17,24 +16,30 @@ import (
 
 func (round *round6) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 	round.number = 6
 	round.started = true
 	round.resetOK()
 
-	piAi, err := schnorr.NewZKProof(round.temp.roi, round.temp.bigAi)
+	start := time.Now()
+	piAi, err := schnorr.NewZKProofWithRand(round.Rand(), round.SessionID(), round.temp.roi, round.temp.bigAi)
+	round.Params().Metrics().RecordProof(tss.ProofSchnorr, tss.ProofGeneration, start)
 	if err != nil {
-		return round.WrapError(errors2.Wrapf(err, "NewZKProof(roi, bigAi)"))
+		return round.WrapError(fmt.Errorf("%w: NewZKProof(roi, bigAi): %v", tss.ErrInternal, err))
 	}
-	piV, err := schnorr.NewZKVProof(round.temp.bigVi, round.temp.bigR, round.temp.si, round.temp.li)
+	start = time.Now()
+	piV, err := schnorr.NewZKVProofWithRand(round.Rand(), round.SessionID(), round.temp.bigVi, round.temp.bigR, round.temp.si, round.temp.li)
+	round.Params().Metrics().RecordProof(tss.ProofSchnorrVariant, tss.ProofGeneration, start)
 	if err != nil {
-		return round.WrapError(errors2.Wrapf(err, "NewZKVProof(bigVi, bigR, si, li)"))
+		return round.WrapError(fmt.Errorf("%w: NewZKVProof(bigVi, bigR, si, li): %v", tss.ErrInternal, err))
 	}
 
 	r6msg := NewSignRound6Message(round.PartyID(), round.temp.DPower, piAi, piV)
 	round.temp.signRound6Messages[round.PartyID().Index] = r6msg
-	round.out <- r6msg
+	if err := tss.SendMessage(round.Params(), round.out, r6msg); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
 	return nil
 }
 
@@ -7,10 +7,9 @@
 package signing
 
 import (
-	"errors"
+	"fmt"
 	"math/big"
-
-	errors2 "github.com/pkg/errors"
+	"time"
 
 	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
@@ -20,7 +19,7 @@ import (
 
 func (round *round7) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 	round.number = 7
 	round.started = true
@@ -38,26 +37,38 @@ func (round *round7) Start() *tss.Error {
 		cmtDeCmt := commitments.HashCommitDecommit{C: cj, D: dj}
 		ok, values := cmtDeCmt.DeCommit()
 		if !ok || len(values) != 4 {
-			return round.WrapError(errors.New("de-commitment for bigVj and bigAj failed"), Pj)
+			return round.WrapError(fmt.Errorf("%w: bigVj and bigAj", tss.ErrBadDeCommitment), Pj)
 		}
 		bigVjX, bigVjY, bigAjX, bigAjY := values[0], values[1], values[2], values[3]
 		bigVj, err := crypto.NewECPoint(round.Params().EC(), bigVjX, bigVjY)
 		if err != nil {
-			return round.WrapError(errors2.Wrapf(err, "NewECPoint(bigVj)"), Pj)
+			return round.WrapError(fmt.Errorf("%w: NewECPoint(bigVj): %v", tss.ErrPointNotOnCurve, err), Pj)
 		}
 		bigVjs[j] = bigVj
 		bigAj, err := crypto.NewECPoint(round.Params().EC(), bigAjX, bigAjY)
 		if err != nil {
-			return round.WrapError(errors2.Wrapf(err, "NewECPoint(bigAj)"), Pj)
+			return round.WrapError(fmt.Errorf("%w: NewECPoint(bigAj): %v", tss.ErrPointNotOnCurve, err), Pj)
 		}
 		bigAjs[j] = bigAj
 		pijA, err := r6msg.UnmarshalZKProof(round.Params().EC())
-		if err != nil || !pijA.Verify(bigAj) {
-			return round.WrapError(errors.New("schnorr verify for Aj failed"), Pj)
+		if err != nil {
+			return round.WrapError(fmt.Errorf("%w: failed to unmarshal the schnorr proof for Aj: %v", tss.ErrInvalidMessage, err), Pj)
+		}
+		start := time.Now()
+		ok = pijA.Verify(round.SessionID(), bigAj)
+		round.Params().Metrics().RecordProof(tss.ProofSchnorr, tss.ProofVerification, start)
+		if !ok {
+			return round.WrapError(tss.NewProofError(tss.ProofSchnorr, nil), Pj)
 		}
 		pijV, err := r6msg.UnmarshalZKVProof(round.Params().EC())
-		if err != nil || !pijV.Verify(bigVj, round.temp.bigR) {
-			return round.WrapError(errors.New("vverify for Vj failed"), Pj)
+		if err != nil {
+			return round.WrapError(fmt.Errorf("%w: failed to unmarshal the schnorr proof for Vj: %v", tss.ErrInvalidMessage, err), Pj)
+		}
+		start = time.Now()
+		ok = pijV.Verify(round.SessionID(), bigVj, round.temp.bigR)
+		round.Params().Metrics().RecordProof(tss.ProofSchnorrVariant, tss.ProofVerification, start)
+		if !ok {
+			return round.WrapError(tss.NewProofError(tss.ProofSchnorrVariant, nil), Pj)
 		}
 	}
 
@@ -82,10 +93,12 @@ func (round *round7) Start() *tss.Error {
 	TiX, TiY := round.Params().EC().ScalarMult(AX, AY, round.temp.li.Bytes())
 	round.temp.Ui = crypto.NewECPointNoCurveCheck(round.Params().EC(), UiX, UiY)
 	round.temp.Ti = crypto.NewECPointNoCurveCheck(round.Params().EC(), TiX, TiY)
-	cmt := commitments.NewHashCommitment(UiX, UiY, TiX, TiY)
+	cmt := commitments.NewHashCommitmentWithRand(round.Rand(), UiX, UiY, TiX, TiY)
 	r7msg := NewSignRound7Message(round.PartyID(), cmt.C)
 	round.temp.signRound7Messages[round.PartyID().Index] = r7msg
-	round.out <- r7msg
+	if err := tss.SendMessage(round.Params(), round.out, r7msg); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
 	round.temp.DTelda = cmt.D
 
 	return nil
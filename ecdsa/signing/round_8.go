@@ -7,14 +7,14 @@
 package signing
 
 import (
-	"errors"
+	"fmt"
 
 	"github.com/bnb-chain/tss-lib/tss"
 )
 
 func (round *round8) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 	round.number = 8
 	round.started = true
@@ -22,7 +22,9 @@ func (round *round8) Start() *tss.Error {
 
 	r8msg := NewSignRound8Message(round.PartyID(), round.temp.DTelda)
 	round.temp.signRound8Messages[round.PartyID().Index] = r8msg
-	round.out <- r8msg
+	if err := tss.SendMessage(round.Params(), round.out, r8msg); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
 
 	return nil
 }
@@ -49,5 +51,8 @@ func (round *round8) CanAccept(msg tss.ParsedMessage) bool {
 
 func (round *round8) NextRound() tss.Round {
 	round.started = false
+	if round.temp.preSigning {
+		return &preSignFinalization{round}
+	}
 	return &round9{round}
 }
@@ -7,7 +7,7 @@
 package signing
 
 import (
-	"errors"
+	"fmt"
 
 	"github.com/bnb-chain/tss-lib/crypto/commitments"
 	"github.com/bnb-chain/tss-lib/tss"
@@ -15,38 +15,21 @@ import (
 
 func (round *round9) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 	round.number = 9
 	round.started = true
 	round.resetOK()
 
-	UX, UY := round.temp.Ui.X(), round.temp.Ui.Y()
-	TX, TY := round.temp.Ti.X(), round.temp.Ti.Y()
-	for j, Pj := range round.Parties().IDs() {
-		if j == round.PartyID().Index {
-			continue
-		}
-
-		r7msg := round.temp.signRound7Messages[j].Content().(*SignRound7Message)
-		r8msg := round.temp.signRound8Messages[j].Content().(*SignRound8Message)
-		cj, dj := r7msg.UnmarshalCommitment(), r8msg.UnmarshalDeCommitment()
-		cmt := commitments.HashCommitDecommit{C: cj, D: dj}
-		ok, values := cmt.DeCommit()
-		if !ok && len(values) != 4 {
-			return round.WrapError(errors.New("de-commitment for bigVj and bigAj failed"), Pj)
-		}
-		UjX, UjY, TjX, TjY := values[0], values[1], values[2], values[3]
-		UX, UY = round.Params().EC().Add(UX, UY, UjX, UjY)
-		TX, TY = round.Params().EC().Add(TX, TY, TjX, TjY)
-	}
-	if UX.Cmp(TX) != 0 || UY.Cmp(TY) != 0 {
-		return round.WrapError(errors.New("U doesn't equal T"), round.PartyID())
+	if err := round.checkUT(); err != nil {
+		return err
 	}
 
 	r9msg := NewSignRound9Message(round.PartyID(), round.temp.si)
 	round.temp.signRound9Messages[round.PartyID().Index] = r9msg
-	round.out <- r9msg
+	if err := tss.SendMessage(round.Params(), round.out, r9msg); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
 	return nil
 }
 
@@ -74,3 +57,32 @@ func (round *round9) NextRound() tss.Round {
 	round.started = false
 	return &finalization{round}
 }
+
+// ----- //
+
+// checkUT opens the commitments to Uj and Tj and checks that U = T, GG18Spec (5D) Fig. 8
+func (round *base) checkUT() *tss.Error {
+	UX, UY := round.temp.Ui.X(), round.temp.Ui.Y()
+	TX, TY := round.temp.Ti.X(), round.temp.Ti.Y()
+	for j, Pj := range round.Parties().IDs() {
+		if j == round.PartyID().Index {
+			continue
+		}
+
+		r7msg := round.temp.signRound7Messages[j].Content().(*SignRound7Message)
+		r8msg := round.temp.signRound8Messages[j].Content().(*SignRound8Message)
+		cj, dj := r7msg.UnmarshalCommitment(), r8msg.UnmarshalDeCommitment()
+		cmt := commitments.HashCommitDecommit{C: cj, D: dj}
+		ok, values := cmt.DeCommit()
+		if !ok || len(values) != 4 {
+			return round.WrapError(fmt.Errorf("%w: Uj and Tj", tss.ErrBadDeCommitment), Pj)
+		}
+		UjX, UjY, TjX, TjY := values[0], values[1], values[2], values[3]
+		UX, UY = round.Params().EC().Add(UX, UY, UjX, UjY)
+		TX, TY = round.Params().EC().Add(TX, TY, TjX, TjY)
+	}
+	if UX.Cmp(TX) != 0 || UY.Cmp(TY) != 0 {
+		return round.WrapError(fmt.Errorf("%w: U doesn't equal T", tss.ErrInconsistentResult), round.PartyID())
+	}
+	return nil
+}
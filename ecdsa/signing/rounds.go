@@ -24,6 +24,7 @@ type (
 		temp    *localTempData
 		out     chan<- tss.Message
 		end     chan<- common.SignatureData
+		preEnd  chan<- *PreSignatureData
 		ok      []bool // `ok` tracks parties which have been verified by Update()
 		started bool
 		number  int
@@ -121,3 +122,8 @@ func (round *base) resetOK() {
 		round.ok[j] = false
 	}
 }
+
+// getBase is used to take a snapshot of the round a party is in
+func (round *base) getBase() *base {
+	return round
+}
@@ -0,0 +1,209 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bnb-chain/tss-lib/crypto"
+	cmt "github.com/bnb-chain/tss-lib/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/crypto/mta"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+type (
+	// snapshotState is what a snapshot of a signing party holds
+	snapshotState struct {
+		Round    int
+		OK       []bool
+		Keys     keygen.LocalPartySaveData
+		Data     []byte
+		Messages [][][]byte
+		Temp     snapshotTempData
+
+		// the mode of the party, which a snapshot may only be resumed in
+		PreSigning, Online bool
+	}
+
+	// snapshotTempData mirrors localTempData without its message store
+	snapshotTempData struct {
+		W, M, K, Theta, ThetaInverse     *big.Int
+		Sigma, KeyDerivationDelta, Gamma *big.Int
+		Cis                              []*big.Int
+		BigWs                            []*crypto.ECPoint
+		PointGamma                       *crypto.ECPoint
+		DeCommit                         cmt.HashDeCommitment
+
+		Betas, C1jis, C2jis, Vs []*big.Int
+		Pi1jis                  []*mta.ProofBob
+		Pi2jis                  []*mta.ProofBobWC
+
+		Li, Si, Rx, Ry, Roi *big.Int
+		BigR, BigAi, BigVi  *crypto.ECPoint
+		DPower              cmt.HashDeCommitment
+
+		Ui, Ti *crypto.ECPoint
+		DTelda cmt.HashDeCommitment
+	}
+)
+
+// Snapshot exports the state of this party in the middle of a signing run, encrypted with `key` (32 bytes).
+// After a restart, a new party constructed with the same arguments continues the run with Resume.
+func (p *LocalParty) Snapshot(key []byte) ([]byte, *tss.Error) {
+	return tss.BaseSnapshot(p, TaskName, key, p.exportState)
+}
+
+// Resume restores a snapshot taken with Snapshot into this party, which must not have been started.
+// The party then continues from the round it was in; messages that it missed should be delivered to it again.
+func (p *LocalParty) Resume(key, snapshot []byte) *tss.Error {
+	return tss.BaseResume(p, TaskName, key, snapshot, p.restoreState)
+}
+
+func (p *LocalParty) messageStores() []*[]tss.ParsedMessage {
+	return []*[]tss.ParsedMessage{
+		&p.temp.signRound1Message1s,
+		&p.temp.signRound1Message2s,
+		&p.temp.signRound2Messages,
+		&p.temp.signRound3Messages,
+		&p.temp.signRound4Messages,
+		&p.temp.signRound5Messages,
+		&p.temp.signRound6Messages,
+		&p.temp.signRound7Messages,
+		&p.temp.signRound8Messages,
+		&p.temp.signRound9Messages,
+	}
+}
+
+func (p *LocalParty) exportState(current tss.Round) (interface{}, error) {
+	rnd, ok := current.(interface{ getBase() *base })
+	if !ok {
+		return nil, errors.New("the party is in an unexpected round")
+	}
+	data, err := proto.Marshal(&p.data)
+	if err != nil {
+		return nil, err
+	}
+	temp := &p.temp
+	state := &snapshotState{
+		Round: current.RoundNumber(),
+		OK:    rnd.getBase().ok,
+		Keys:  p.keys,
+		Data:  data,
+		Temp: snapshotTempData{
+			W:                  temp.w,
+			M:                  temp.m,
+			K:                  temp.k,
+			Theta:              temp.theta,
+			ThetaInverse:       temp.thetaInverse,
+			Sigma:              temp.sigma,
+			KeyDerivationDelta: temp.keyDerivationDelta,
+			Gamma:              temp.gamma,
+			Cis:                temp.cis,
+			BigWs:              temp.bigWs,
+			PointGamma:         temp.pointGamma,
+			DeCommit:           temp.deCommit,
+			Betas:              temp.betas,
+			C1jis:              temp.c1jis,
+			C2jis:              temp.c2jis,
+			Vs:                 temp.vs,
+			Pi1jis:             temp.pi1jis,
+			Pi2jis:             temp.pi2jis,
+			Li:                 temp.li,
+			Si:                 temp.si,
+			Rx:                 temp.rx,
+			Ry:                 temp.ry,
+			Roi:                temp.roi,
+			BigR:               temp.bigR,
+			BigAi:              temp.bigAi,
+			BigVi:              temp.bigVi,
+			DPower:             temp.DPower,
+			Ui:                 temp.Ui,
+			Ti:                 temp.Ti,
+			DTelda:             temp.DTelda,
+		},
+		PreSigning: temp.preSigning,
+		Online:     temp.online,
+	}
+	for _, store := range p.messageStores() {
+		bzs, err := tss.MarshalMessageStore(*store)
+		if err != nil {
+			return nil, err
+		}
+		state.Messages = append(state.Messages, bzs)
+	}
+	return state, nil
+}
+
+func (p *LocalParty) restoreState(bz []byte) (tss.Round, error) {
+	state := new(snapshotState)
+	if err := json.Unmarshal(bz, state); err != nil {
+		return nil, err
+	}
+	stores := p.messageStores()
+	if len(state.Messages) != len(stores) || len(state.OK) != len(p.params.Parties().IDs()) {
+		return nil, errors.New("the snapshot does not match this party's parameters")
+	}
+	if state.PreSigning != p.temp.preSigning || state.Online != p.temp.online {
+		return nil, errors.New("the snapshot is of a party in another signing mode: presigning, online or full signing")
+	}
+	if err := proto.Unmarshal(state.Data, &p.data); err != nil {
+		return nil, err
+	}
+	for i, store := range stores {
+		msgs, err := tss.UnmarshalMessageStore(state.Messages[i], p.params.Parties().IDs())
+		if err != nil {
+			return nil, err
+		}
+		*store = msgs
+	}
+	p.keys = state.Keys
+	temp, saved := &p.temp, &state.Temp
+	temp.w = saved.W
+	temp.m = saved.M
+	temp.k = saved.K
+	temp.theta = saved.Theta
+	temp.thetaInverse = saved.ThetaInverse
+	temp.sigma = saved.Sigma
+	temp.keyDerivationDelta = saved.KeyDerivationDelta
+	temp.gamma = saved.Gamma
+	temp.cis = saved.Cis
+	temp.bigWs = saved.BigWs
+	temp.pointGamma = saved.PointGamma
+	temp.deCommit = saved.DeCommit
+	temp.betas = saved.Betas
+	temp.c1jis = saved.C1jis
+	temp.c2jis = saved.C2jis
+	temp.vs = saved.Vs
+	temp.pi1jis = saved.Pi1jis
+	temp.pi2jis = saved.Pi2jis
+	temp.li = saved.Li
+	temp.si = saved.Si
+	temp.rx = saved.Rx
+	temp.ry = saved.Ry
+	temp.roi = saved.Roi
+	temp.bigR = saved.BigR
+	temp.bigAi = saved.BigAi
+	temp.bigVi = saved.BigVi
+	temp.DPower = saved.DPower
+	temp.Ui = saved.Ui
+	temp.Ti = saved.Ti
+	temp.DTelda = saved.DTelda
+
+	round := p.FirstRound()
+	rnd := round.(interface{ getBase() *base }).getBase()
+	for i := 1; i < state.Round; i++ {
+		round = round.NextRound()
+	}
+	rnd.number, rnd.started = state.Round, true
+	copy(rnd.ok, state.OK)
+	return round, nil
+}
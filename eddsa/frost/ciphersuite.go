@@ -0,0 +1,149 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package frost
+
+import (
+	"crypto/sha512"
+	"errors"
+	"io"
+	"math/big"
+	"sort"
+
+	"github.com/bnb-chain/tss-lib/crypto"
+)
+
+// The FROST(Ed25519, SHA-512) ciphersuite of RFC 9591, Section 6.1
+
+const (
+	contextString = "FROST-ED25519-SHA512-v1"
+)
+
+// hashToScalar hashes `tag` and `msgs` with SHA-512 and reduces the digest, read as a little-endian integer, modulo q
+func hashToScalar(q *big.Int, tag string, msgs ...[]byte) *big.Int {
+	return new(big.Int).Mod(leToBigInt(hash(tag, msgs...)), q)
+}
+
+func hash(tag string, msgs ...[]byte) []byte {
+	h := sha512.New()
+	if tag != "" {
+		h.Write([]byte(contextString + tag))
+	}
+	for _, msg := range msgs {
+		h.Write(msg)
+	}
+	return h.Sum(nil)
+}
+
+// H1 computes the binding factors
+func h1(q *big.Int, msgs ...[]byte) *big.Int {
+	return hashToScalar(q, "rho", msgs...)
+}
+
+// H2 computes the challenge; it has no context string so that the signature is a standard Ed25519 signature
+func h2(q *big.Int, msgs ...[]byte) *big.Int {
+	return hashToScalar(q, "", msgs...)
+}
+
+// H3 derives the nonces
+func h3(q *big.Int, msgs ...[]byte) *big.Int {
+	return hashToScalar(q, "nonce", msgs...)
+}
+
+// H4 hashes the message
+func h4(msg []byte) []byte {
+	return hash("msg", msg)
+}
+
+// H5 hashes the encoded commitment list
+func h5(encCommitments []byte) []byte {
+	return hash("com", encCommitments)
+}
+
+// nonceGenerate derives a nonce from fresh randomness and the secret share, which guards against a bad source of randomness
+func nonceGenerate(rand io.Reader, q, secret *big.Int) *big.Int {
+	randomBytes := make([]byte, 32)
+	if _, err := io.ReadFull(rand, randomBytes); err != nil {
+		panic(errors.New("nonceGenerate: unable to read random bytes"))
+	}
+	return h3(q, randomBytes, serializeScalar(secret))
+}
+
+// computeBindingFactors returns the binding factor of each party, indexed like `ids`, where the commitment list is
+// encoded in the ascending order of the identifiers
+func computeBindingFactors(q *big.Int, pubKey *crypto.ECPoint, ids []*big.Int, hidings, bindings []*crypto.ECPoint, msg []byte) []*big.Int {
+	order := make([]int, len(ids))
+	for j := range order {
+		order[j] = j
+	}
+	sort.Slice(order, func(a, b int) bool {
+		return ids[order[a]].Cmp(ids[order[b]]) < 0
+	})
+	encCommitments := make([]byte, 0, len(ids)*96)
+	for _, j := range order {
+		encCommitments = append(encCommitments, serializeScalar(ids[j])...)
+		encCommitments = append(encCommitments, serializeElement(hidings[j])...)
+		encCommitments = append(encCommitments, serializeElement(bindings[j])...)
+	}
+	prefix := append(serializeElement(pubKey), h4(msg)...)
+	prefix = append(prefix, h5(encCommitments)...)
+
+	rhos := make([]*big.Int, len(ids))
+	for j, id := range ids {
+		rhos[j] = h1(q, prefix, serializeScalar(id))
+	}
+	return rhos
+}
+
+// identifiers returns the FROST identifier of each party, which is its key reduced modulo q
+func identifiers(q *big.Int, ks []*big.Int) []*big.Int {
+	ids := make([]*big.Int, len(ks))
+	for j, kj := range ks {
+		ids[j] = new(big.Int).Mod(kj, q)
+	}
+	return ids
+}
+
+// serializeScalar encodes a scalar as 32 little-endian bytes
+func serializeScalar(s *big.Int) []byte {
+	bz := s.FillBytes(make([]byte, 32))
+	reverse(bz)
+	return bz
+}
+
+// serializeElement encodes a point as in RFC 8032: the little-endian y with the sign of x in its top bit
+func serializeElement(p *crypto.ECPoint) []byte {
+	bz := serializeScalar(p.Y())
+	if p.X().Bit(0) == 1 {
+		bz[31] |= 0x80
+	}
+	return bz
+}
+
+// isValidElement reports whether `p` is a point of the prime-order subgroup other than the identity
+func isValidElement(p *crypto.ECPoint) bool {
+	if isIdentity(p) {
+		return false
+	}
+	return isIdentity(p.ScalarMult(p.Curve().Params().N))
+}
+
+func isIdentity(p *crypto.ECPoint) bool {
+	return p.X().Sign() == 0 && p.Y().Cmp(big.NewInt(1)) == 0
+}
+
+func leToBigInt(bz []byte) *big.Int {
+	be := make([]byte, len(bz))
+	copy(be, bz)
+	reverse(be)
+	return new(big.Int).SetBytes(be)
+}
+
+func reverse(s []byte) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
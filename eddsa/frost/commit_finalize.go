@@ -0,0 +1,76 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package frost
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func (round *commitFinalization) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	round.number = 2
+	round.started = true
+	round.resetOK()
+
+	// 1. check and save the commitments of every party
+	ec := round.Params().EC()
+	var multiErr error
+	culprits := make([]*tss.PartyID, 0, len(round.Parties().IDs())) // who caused the error(s)
+	for j, Pj := range round.Parties().IDs() {
+		round.ok[j] = true
+		r1msg := round.temp.commitRound1Messages[j].Content().(*CommitRound1Message)
+		Dj, err := r1msg.UnmarshalHiding(ec)
+		if err != nil {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("%w: hiding commitment: %v", tss.ErrPointNotOnCurve, err))
+			culprits = append(culprits, Pj)
+			continue
+		}
+		Ej, err := r1msg.UnmarshalBinding(ec)
+		if err != nil {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("%w: binding commitment: %v", tss.ErrPointNotOnCurve, err))
+			culprits = append(culprits, Pj)
+			continue
+		}
+		if !isValidElement(Dj) || !isValidElement(Ej) {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("%w: commitment is the identity or is outside of the prime-order subgroup", tss.ErrInvalidMessage))
+			culprits = append(culprits, Pj)
+			continue
+		}
+		round.temp.nonces.HidingCommitments[j] = Dj
+		round.temp.nonces.BindingCommitments[j] = Ej
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(multiErr, culprits...)
+	}
+
+	// 2. output the nonces for signing
+	nonces := round.temp.nonces
+	nonces.secret = &noncesSecret{di: round.temp.di, ei: round.temp.ei}
+	round.temp.di, round.temp.ei = nil, nil
+	round.nonceEnd <- &nonces
+	return nil
+}
+
+func (round *commitFinalization) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *commitFinalization) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *commitFinalization) NextRound() tss.Round {
+	return nil // finished!
+}
@@ -0,0 +1,83 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package frost
+
+import (
+	"fmt"
+
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// the nonce-commitment round of FROST (RFC 9591, Section 5.1)
+func newCommitRound1(params *tss.Parameters, key *keygen.LocalPartySaveData, temp *localTempData, out chan<- tss.Message, nonceEnd chan<- *Nonces) tss.Round {
+	return &commitRound1{
+		&base{params, key, nil, temp, out, nil, nonceEnd, make([]bool, len(params.Parties().IDs())), false, 1}}
+}
+
+func (round *commitRound1) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+
+	round.number = 1
+	round.started = true
+	round.resetOK()
+
+	ec := round.Params().EC()
+	q := ec.Params().N
+
+	// 1. select the hiding and binding nonces di, ei
+	di := nonceGenerate(round.Rand(), q, round.key.Xi)
+	ei := nonceGenerate(round.Rand(), q, round.key.Xi)
+
+	// 2. store the nonces
+	round.temp.di, round.temp.ei = di, ei
+	round.temp.nonces = Nonces{
+		Ks:                 round.key.Ks,
+		EDDSAPub:           round.key.EDDSAPub,
+		HidingCommitments:  make([]*crypto.ECPoint, len(round.key.Ks)),
+		BindingCommitments: make([]*crypto.ECPoint, len(round.key.Ks)),
+	}
+
+	// 3. broadcast the commitments Di = di * G, Ei = ei * G
+	i := round.PartyID().Index
+	round.ok[i] = true
+	r1msg := NewCommitRound1Message(round.PartyID(), crypto.ScalarBaseMult(ec, di), crypto.ScalarBaseMult(ec, ei))
+	round.temp.commitRound1Messages[i] = r1msg
+	if err := tss.SendMessage(round.Params(), round.out, r1msg); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
+
+	return nil
+}
+
+func (round *commitRound1) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.commitRound1Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *commitRound1) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*CommitRound1Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *commitRound1) NextRound() tss.Round {
+	round.started = false
+	return &commitFinalization{round}
+}
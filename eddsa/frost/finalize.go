@@ -0,0 +1,85 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package frost
+
+import (
+	"crypto/ed25519"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func (round *finalization) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	round.number = 2
+	round.started = true
+	round.resetOK()
+
+	ec := round.Params().EC()
+	q := ec.Params().N
+	modQ := common.ModInt(q)
+	i := round.PartyID().Index
+
+	// 1. verify the signature share of every other party: zj * G = Dj + rhoj * Ej + c * lambdaj * Yj
+	z := round.temp.zi
+	var multiErr error
+	culprits := make([]*tss.PartyID, 0, len(round.Parties().IDs())) // who caused the error(s)
+	for j, Pj := range round.Parties().IDs() {
+		round.ok[j] = true
+		if j == i {
+			continue
+		}
+		zj := round.temp.signRound1Messages[j].Content().(*SignRound1Message).UnmarshalZ()
+		expected, err := round.temp.bigRs[j].Add(round.key.BigXj[j].ScalarMult(modQ.Mul(round.temp.c, round.lagrange(j))))
+		if err != nil || zj.Cmp(q) >= 0 || !crypto.ScalarBaseMult(ec, zj).Equals(expected) {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("%w: signature share failed to verify", tss.ErrBadShare))
+			culprits = append(culprits, Pj)
+			continue
+		}
+		z = modQ.Add(z, zj)
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(multiErr, culprits...)
+	}
+
+	// 2. save the signature (R, z) for final output
+	// as in eddsa signing, R and S are the big-endian integers whose little-endian encodings make up the signature
+	encodedR := serializeElement(round.temp.bigR)
+	signature := append(encodedR, serializeScalar(z)...)
+	round.data.Signature = signature
+	round.data.R = leToBigInt(encodedR).Bytes()
+	round.data.S = z.Bytes()
+	round.data.M = round.temp.m
+
+	pk := ed25519.PublicKey(serializeElement(round.key.EDDSAPub))
+	if !ed25519.Verify(pk, round.temp.m, signature) {
+		return round.WrapError(fmt.Errorf("%w: signature verification failed", tss.ErrInconsistentResult))
+	}
+	round.end <- *round.data
+
+	return nil
+}
+
+func (round *finalization) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *finalization) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *finalization) NextRound() tss.Round {
+	return nil // finished!
+}
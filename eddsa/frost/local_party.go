@@ -0,0 +1,192 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package frost
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// Implements Party
+// Implements Stringer
+var _ tss.Party = (*LocalParty)(nil)
+var _ fmt.Stringer = (*LocalParty)(nil)
+
+type (
+	LocalParty struct {
+		*tss.BaseParty
+		params *tss.Parameters
+
+		keys keygen.LocalPartySaveData
+		temp localTempData
+		data common.SignatureData
+
+		// outbound messaging
+		out      chan<- tss.Message
+		end      chan<- common.SignatureData
+		nonceEnd chan<- *Nonces
+	}
+
+	localMessageStore struct {
+		commitRound1Messages,
+		signRound1Messages []tss.ParsedMessage
+	}
+
+	localTempData struct {
+		localMessageStore
+
+		// preprocessing makes the nonce commitments; signing starts from them and runs a single round
+		signing bool
+
+		// temp data (thrown away after sign)
+		m      []byte
+		nonces Nonces
+		di, ei *big.Int // the secret nonces, taken from the Nonces
+		wi     *big.Int
+
+		// the binding factors and commitment shares (Dj + rhoj * Ej) of each party, the group commitment and the challenge
+		rhos  []*big.Int
+		bigRs []*crypto.ECPoint
+		bigR  *crypto.ECPoint
+		c     *big.Int
+		zi    *big.Int
+	}
+)
+
+// NewLocalPreprocessingParty returns a party that makes a one-time pair of nonce commitments with the other parties,
+// ahead of the message to sign, and sends its Nonces to `end`. The same parties then sign a message with
+// NewLocalParty in a single round.
+func NewLocalPreprocessingParty(
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	out chan<- tss.Message,
+	end chan<- *Nonces,
+) tss.Party {
+	p := newLocalParty(params, key, out)
+	p.nonceEnd = end
+	return p
+}
+
+// NewLocalParty returns a party that signs `msg` with `nonces` in a single round, together with the parties that made
+// the nonce commitments. The secret nonces move into the party, and a party given nonces that are nil, or whose secret
+// nonces were already taken or exported, fails to start. The signature is a standard Ed25519 signature of `msg`.
+func NewLocalParty(
+	msg []byte,
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	nonces *Nonces,
+	out chan<- tss.Message,
+	end chan<- common.SignatureData,
+) tss.Party {
+	p := newLocalParty(params, key, out)
+	p.end = end
+	p.temp.signing = true
+	p.temp.m = msg
+	if nonces != nil {
+		p.temp.nonces = Nonces{
+			Ks:                 nonces.Ks,
+			EDDSAPub:           nonces.EDDSAPub,
+			HidingCommitments:  nonces.HidingCommitments,
+			BindingCommitments: nonces.BindingCommitments,
+		}
+		p.temp.di, p.temp.ei = nonces.take()
+	}
+	return p
+}
+
+func newLocalParty(params *tss.Parameters, key keygen.LocalPartySaveData, out chan<- tss.Message) *LocalParty {
+	partyCount := len(params.Parties().IDs())
+	p := &LocalParty{
+		BaseParty: tss.NewBaseParty(params),
+		params:    params,
+		keys:      keygen.BuildLocalSaveDataSubset(key, params.Parties().IDs()),
+		temp:      localTempData{},
+		data:      common.SignatureData{},
+		out:       out,
+	}
+	// msgs init
+	p.temp.commitRound1Messages = make([]tss.ParsedMessage, partyCount)
+	p.temp.signRound1Messages = make([]tss.ParsedMessage, partyCount)
+	// temp data init
+	p.temp.rhos = make([]*big.Int, partyCount)
+	p.temp.bigRs = make([]*crypto.ECPoint, partyCount)
+	return p
+}
+
+func (p *LocalParty) FirstRound() tss.Round {
+	if p.temp.signing {
+		return newSignRound1(p.params, &p.keys, &p.data, &p.temp, p.out, p.end)
+	}
+	return newCommitRound1(p.params, &p.keys, &p.temp, p.out, p.nonceEnd)
+}
+
+func (p *LocalParty) Start() *tss.Error {
+	return tss.BaseStart(p, TaskName)
+}
+
+func (p *LocalParty) StartWithContext(ctx context.Context) *tss.Error {
+	return tss.BaseStartWithContext(ctx, p, TaskName)
+}
+
+func (p *LocalParty) Update(msg tss.ParsedMessage) (ok bool, err *tss.Error) {
+	return tss.BaseUpdate(p, msg, TaskName)
+}
+
+func (p *LocalParty) UpdateFromBytes(wireBytes []byte, from *tss.PartyID, isBroadcast bool) (bool, *tss.Error) {
+	msg, err := p.ParseWireMessage(wireBytes, from, isBroadcast)
+	if err != nil {
+		return false, err
+	}
+	return p.Update(msg)
+}
+
+func (p *LocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
+	if msg.GetFrom() == nil || !msg.GetFrom().ValidateBasic() {
+		return false, p.WrapError(fmt.Errorf("%w: received msg with an invalid sender: %s", tss.ErrInvalidMessage, msg))
+	}
+	// check that the message's "from index" will fit into the array
+	if maxFromIdx := len(p.params.Parties().IDs()) - 1; maxFromIdx < msg.GetFrom().Index {
+		return false, p.WrapError(fmt.Errorf("%w: received msg with a sender index too great (%d <= %d)", tss.ErrInvalidMessage,
+			maxFromIdx, msg.GetFrom().Index), msg.GetFrom())
+	}
+	return p.BaseParty.ValidateMessage(msg)
+}
+
+func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
+	// ValidateBasic is cheap; double-check the message here in case the public StoreMessage was called externally
+	if ok, err := p.ValidateMessage(msg); !ok || err != nil {
+		return ok, err
+	}
+
+	// switch/case is necessary to store any messages beyond current round
+	// a sender may deliver each message only once; identical re-deliveries are ignored. spoofing protection is left to the caller.
+	switch msg.Content().(type) {
+	case *CommitRound1Message:
+		return tss.StoreMessageOnce(p, p.temp.commitRound1Messages, msg)
+
+	case *SignRound1Message:
+		return tss.StoreMessageOnce(p, p.temp.signRound1Messages, msg)
+
+	default: // unrecognised message, just ignore!
+		p.params.Logger().Warningf("unrecognised message ignored: %s", msg.Type())
+		return false, nil
+	}
+}
+
+func (p *LocalParty) PartyID() *tss.PartyID {
+	return p.params.PartyID()
+}
+
+func (p *LocalParty) String() string {
+	return fmt.Sprintf("id: %s, %s", p.PartyID(), p.BaseParty.String())
+}
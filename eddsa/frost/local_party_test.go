@@ -0,0 +1,200 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package frost
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/test"
+	"github.com/bnb-chain/tss-lib/test/adversary"
+	"github.com/bnb-chain/tss-lib/test/netsim"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+const (
+	testParticipants = test.TestParticipants
+	testThreshold    = test.TestThreshold
+)
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+
+	// only for test
+	tss.SetCurve(tss.Edwards())
+}
+
+// preprocess runs the nonce-commitment protocol and returns the nonces of each party
+func preprocess(t *testing.T, keys []keygen.LocalPartySaveData, pIDs tss.SortedPartyIDs) []*Nonces {
+	p2pCtx := tss.NewPeerContext(pIDs)
+	outCh := make(chan tss.Message, len(pIDs)*len(pIDs))
+	endChs := make([]chan *Nonces, len(pIDs))
+
+	net := netsim.New(1)
+	for i, pID := range pIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pID, len(pIDs), testThreshold)
+		endChs[i] = make(chan *Nonces, 1)
+		assert.NoError(t, net.Register(NewLocalPreprocessingParty(params, keys[i], outCh, endChs[i])))
+	}
+	results, err := net.Run(context.Background(), outCh, time.Second)
+	assert.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, netsim.Finished, r.Outcome, r.String())
+	}
+
+	nonces := make([]*Nonces, len(pIDs))
+	for i, endCh := range endChs {
+		nonces[i] = <-endCh
+	}
+	return nonces
+}
+
+func TestE2E(t *testing.T) {
+	setUp("info")
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	nonces := preprocess(t, keys, signPIDs)
+	for _, n := range nonces[1:] {
+		for j := range signPIDs {
+			assert.True(t, n.HidingCommitments[j].Equals(nonces[0].HidingCommitments[j]), "all parties should hold the same commitments")
+			assert.True(t, n.BindingCommitments[j].Equals(nonces[0].BindingCommitments[j]), "all parties should hold the same commitments")
+		}
+	}
+
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan common.SignatureData, len(signPIDs))
+
+	msg := []byte("\x00a message with a leading zero byte")
+	parties := make([]*LocalParty, 0, len(signPIDs))
+	net := netsim.New(1)
+	for i, pID := range signPIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pID, len(signPIDs), testThreshold)
+		P := NewLocalParty(msg, params, keys[i], nonces[i], outCh, endCh).(*LocalParty)
+		parties = append(parties, P)
+		assert.NoError(t, net.Register(P))
+	}
+	results, err := net.Run(context.Background(), outCh, time.Second)
+	assert.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, netsim.Finished, r.Outcome, r.String())
+	}
+	for _, n := range nonces {
+		di, ei := n.take()
+		assert.Nil(t, di, "the secret nonces should be cleared")
+		assert.Nil(t, ei, "the secret nonces should be cleared")
+	}
+
+	assert.Len(t, endCh, len(signPIDs))
+	pk := ed25519.PublicKey(serializeElement(keys[0].EDDSAPub))
+	for _, P := range parties {
+		sig := &P.data
+		assert.Equal(t, msg, sig.M)
+		assert.Equal(t, parties[0].data.Signature, sig.Signature, "all parties should make the same signature")
+		assert.True(t, ed25519.Verify(pk, msg, sig.Signature), "ed25519 verify must pass")
+	}
+}
+
+func TestE2ENoncesAreSingleUse(t *testing.T) {
+	setUp("info")
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	nonces := preprocess(t, keys, signPIDs)
+
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	params := tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[0], len(signPIDs), testThreshold)
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan common.SignatureData, len(signPIDs))
+	_ = NewLocalParty([]byte("first"), params, keys[0], nonces[0], outCh, endCh)
+	err2 := NewLocalParty([]byte("second"), params, keys[0], nonces[0], outCh, endCh).Start()
+	if assert.Error(t, err2, "signing twice with the same nonces should fail") {
+		assert.True(t, errors.Is(err2, tss.ErrInternal), "unexpected cause: %s", err2)
+	}
+	_, err = nonces[0].Export()
+	assert.Error(t, err, "used nonces should not be exported")
+
+	// nonces that are missing do not get a party started
+	err2 = NewLocalParty([]byte("first"), params, keys[0], nil, outCh, endCh).Start()
+	if assert.Error(t, err2, "signing without nonces should fail") {
+		assert.True(t, errors.Is(err2, tss.ErrInternal), "unexpected cause: %s", err2)
+	}
+}
+
+func TestE2EExportedNonces(t *testing.T) {
+	setUp("info")
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	nonces := preprocess(t, keys, signPIDs)
+
+	// the secret nonces move into the export, and are left out of the JSON encoding of the nonces
+	stored := make([][]byte, len(signPIDs))
+	for i, n := range nonces {
+		stored[i], err = n.Export()
+		assert.NoError(t, err)
+		_, err = n.Export()
+		assert.Error(t, err, "nonces should only be exported once")
+		bz, err := json.Marshal(n)
+		assert.NoError(t, err)
+		assert.NotContains(t, string(bz), "Hiding\"")
+	}
+	_, err = ImportNonces([]byte(`{"Ks":[]}`))
+	assert.Error(t, err, "nonces without secret nonces should not be imported")
+
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	outCh := make(chan tss.Message, len(signPIDs))
+	endCh := make(chan common.SignatureData, len(signPIDs))
+	msg := []byte("message")
+	net := netsim.New(1)
+	for i, pID := range signPIDs {
+		imported, err := ImportNonces(stored[i])
+		assert.NoError(t, err)
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pID, len(signPIDs), testThreshold)
+		assert.NoError(t, net.Register(NewLocalParty(msg, params, keys[i], imported, outCh, endCh)))
+	}
+	results, err := net.Run(context.Background(), outCh, time.Second)
+	assert.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, netsim.Finished, r.Outcome, r.String())
+	}
+	pk := ed25519.PublicKey(serializeElement(keys[0].EDDSAPub))
+	assert.True(t, ed25519.Verify(pk, msg, (<-endCh).Signature), "ed25519 verify must pass")
+}
+
+func TestE2ECheater(t *testing.T) {
+	setUp("info")
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	endCh := make(chan common.SignatureData, len(signPIDs))
+	newParams := func(i int) *tss.Parameters {
+		return tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+	}
+
+	// the cheater sends a signature share that does not match its commitments
+	nonces := preprocess(t, keys, signPIDs)
+	msg := []byte("message")
+	results, err := adversary.Run(len(signPIDs), 0, newParams, func(i int, params *tss.Parameters, out chan<- tss.Message) tss.Party {
+		return NewLocalParty(msg, params, keys[i], nonces[i], out, endCh)
+	}, adversary.Corruption{MessageType: adversary.MessageType(&SignRound1Message{}), Corrupt: adversary.TamperField("z")})
+	assert.NoError(t, err)
+	assert.NoError(t, adversary.CheckBlamed(results, signPIDs[0], tss.ErrBadShare))
+}
@@ -0,0 +1,94 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package frost
+
+import (
+	"crypto/elliptic"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// These messages were generated from Protocol Buffers definitions into eddsa-frost.pb.go
+// The following messages are registered on the Protocol Buffers "wire"
+
+var (
+	// Ensure that frost messages implement ValidateBasic
+	_ = []tss.MessageContent{
+		(*CommitRound1Message)(nil),
+		(*SignRound1Message)(nil),
+	}
+)
+
+// ----- //
+
+func NewCommitRound1Message(
+	from *tss.PartyID,
+	hiding, binding *crypto.ECPoint,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &CommitRound1Message{
+		HidingX:  hiding.X().Bytes(),
+		HidingY:  hiding.Y().Bytes(),
+		BindingX: binding.X().Bytes(),
+		BindingY: binding.Y().Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *CommitRound1Message) ValidateBasic() bool {
+	// the x of a point may be zero, so only the y are required to be non-empty
+	return m != nil &&
+		common.NonEmptyBytes(m.GetHidingY()) &&
+		common.NonEmptyBytes(m.GetBindingY())
+}
+
+func (m *CommitRound1Message) UnmarshalHiding(ec elliptic.Curve) (*crypto.ECPoint, error) {
+	return crypto.NewECPoint(
+		ec,
+		new(big.Int).SetBytes(m.GetHidingX()),
+		new(big.Int).SetBytes(m.GetHidingY()))
+}
+
+func (m *CommitRound1Message) UnmarshalBinding(ec elliptic.Curve) (*crypto.ECPoint, error) {
+	return crypto.NewECPoint(
+		ec,
+		new(big.Int).SetBytes(m.GetBindingX()),
+		new(big.Int).SetBytes(m.GetBindingY()))
+}
+
+// ----- //
+
+func NewSignRound1Message(
+	from *tss.PartyID,
+	zi *big.Int,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &SignRound1Message{
+		Z: zi.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *SignRound1Message) ValidateBasic() bool {
+	return m != nil &&
+		common.NonEmptyBytes(m.GetZ())
+}
+
+func (m *SignRound1Message) UnmarshalZ() *big.Int {
+	return new(big.Int).SetBytes(m.GetZ())
+}
@@ -0,0 +1,100 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package frost
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/bnb-chain/tss-lib/crypto"
+)
+
+type (
+	// Nonces is a party's share of the one-time nonce commitments made by the FROST preprocessing protocol.
+	// The hiding and binding nonces are secret and must be used to sign one message only: signing two messages
+	// with them reveals the party's key share.
+	// The secret nonces are taken by the first party they are given to, and are left out when Nonces is encoded as JSON;
+	// nonces are stored with Export and read back with ImportNonces.
+	Nonces struct {
+		// the keys of the parties that made the commitments, who must also be the signers
+		Ks       []*big.Int
+		EDDSAPub *crypto.ECPoint
+
+		// the commitments of each party (Dj, Ej), indexed like Ks
+		HidingCommitments,
+		BindingCommitments []*crypto.ECPoint
+
+		secret *noncesSecret
+	}
+
+	// noncesSecret holds the secret nonces (di, ei) until they are taken
+	noncesSecret struct {
+		mtx    sync.Mutex
+		di, ei *big.Int
+	}
+
+	// exportedNonces is the encoding of nonces made by Export
+	exportedNonces struct {
+		Ks                 []*big.Int
+		EDDSAPub           *crypto.ECPoint
+		HidingCommitments  []*crypto.ECPoint
+		BindingCommitments []*crypto.ECPoint
+		Hiding, Binding    *big.Int
+	}
+)
+
+// Export returns the encoding of `nonces` with the secret nonces, for them to be stored until a message is to be signed.
+// The secret nonces move out of `nonces`, which can then no longer be used to sign. The caller takes over the single-use
+// guarantee of the stored nonces: they must be deleted from storage when they are read back with ImportNonces.
+func (nonces *Nonces) Export() ([]byte, error) {
+	di, ei := nonces.take()
+	if di == nil || ei == nil {
+		return nil, errors.New("the nonces are missing or have already been used")
+	}
+	return json.Marshal(&exportedNonces{
+		Ks:                 nonces.Ks,
+		EDDSAPub:           nonces.EDDSAPub,
+		HidingCommitments:  nonces.HidingCommitments,
+		BindingCommitments: nonces.BindingCommitments,
+		Hiding:             di,
+		Binding:            ei,
+	})
+}
+
+// ImportNonces reads back nonces stored with Export
+func ImportNonces(bz []byte) (*Nonces, error) {
+	exported := new(exportedNonces)
+	if err := json.Unmarshal(bz, exported); err != nil {
+		return nil, err
+	}
+	if exported.Hiding == nil || exported.Binding == nil {
+		return nil, errors.New("the nonces have no secret nonces")
+	}
+	nonces := &Nonces{
+		Ks:                 exported.Ks,
+		EDDSAPub:           exported.EDDSAPub,
+		HidingCommitments:  exported.HidingCommitments,
+		BindingCommitments: exported.BindingCommitments,
+	}
+	nonces.secret = &noncesSecret{di: exported.Hiding, ei: exported.Binding}
+	return nonces, nil
+}
+
+// take returns the secret nonces and clears them, so that they are only ever returned once.
+// Copies of a Nonces share its secret, so taking the nonces from one also clears them from the others.
+func (nonces *Nonces) take() (di, ei *big.Int) {
+	if nonces == nil || nonces.secret == nil {
+		return nil, nil
+	}
+	nonces.secret.mtx.Lock()
+	defer nonces.secret.mtx.Unlock()
+	di, ei = nonces.secret.di, nonces.secret.ei
+	nonces.secret.di, nonces.secret.ei = nil, nil
+	return
+}
@@ -0,0 +1,145 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package frost
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/eddsa/signing"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// the signing round of FROST (RFC 9591, Section 5.2)
+func newSignRound1(params *tss.Parameters, key *keygen.LocalPartySaveData, data *common.SignatureData, temp *localTempData, out chan<- tss.Message, end chan<- common.SignatureData) tss.Round {
+	return &signRound1{
+		&base{params, key, data, temp, out, end, nil, make([]bool, len(params.Parties().IDs())), false, 1}}
+}
+
+func (round *signRound1) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+
+	round.number = 1
+	round.started = true
+	round.resetOK()
+
+	if err := round.checkNonces(); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
+
+	ec := round.Params().EC()
+	q := ec.Params().N
+	modQ := common.ModInt(q)
+	i := round.PartyID().Index
+	nonces := &round.temp.nonces
+
+	// 1. compute the binding factor and the commitment share of each party, and the group commitment R
+	ids := identifiers(q, round.key.Ks)
+	round.temp.rhos = computeBindingFactors(q, round.key.EDDSAPub, ids, nonces.HidingCommitments, nonces.BindingCommitments, round.temp.m)
+	var R *crypto.ECPoint
+	for j := range round.Parties().IDs() {
+		Rj, err := nonces.HidingCommitments[j].Add(nonces.BindingCommitments[j].ScalarMult(round.temp.rhos[j]))
+		if err != nil {
+			return round.WrapError(fmt.Errorf("%w: Dj + rhoj * Ej: %v", tss.ErrInternal, err))
+		}
+		round.temp.bigRs[j] = Rj
+		if R == nil {
+			R = Rj
+			continue
+		}
+		if R, err = R.Add(Rj); err != nil {
+			return round.WrapError(fmt.Errorf("%w: R + Rj: %v", tss.ErrInternal, err))
+		}
+	}
+	round.temp.bigR = R
+
+	// 2. compute the challenge c = H2(R || Y || m)
+	round.temp.c = h2(q, serializeElement(R), serializeElement(round.key.EDDSAPub), round.temp.m)
+
+	// 3. compute zi = di + ei * rhoi + lambdai * xi * c, and clear the nonces
+	round.temp.wi = signing.PrepareForSigning(ec, i, len(round.key.Ks), round.key.Xi, round.key.Ks)
+	zi := modQ.Add(modQ.Add(round.temp.di, modQ.Mul(round.temp.ei, round.temp.rhos[i])), modQ.Mul(round.temp.wi, round.temp.c))
+	round.temp.di, round.temp.ei = nil, nil
+	round.temp.zi = zi
+
+	// 4. broadcast zi to other parties
+	round.ok[i] = true
+	r1msg := NewSignRound1Message(round.PartyID(), zi)
+	round.temp.signRound1Messages[i] = r1msg
+	if err := tss.SendMessage(round.Params(), round.out, r1msg); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
+
+	return nil
+}
+
+// checkNonces checks that the nonces were made by the signing parties for this key, and that they have not been used
+func (round *signRound1) checkNonces() error {
+	nonces := &round.temp.nonces
+	if round.temp.di == nil || round.temp.ei == nil {
+		return errors.New("the nonces are missing or have already been used")
+	}
+	if nonces.EDDSAPub == nil || !nonces.EDDSAPub.Equals(round.key.EDDSAPub) {
+		return errors.New("the nonces were made for a different public key")
+	}
+	if len(nonces.Ks) != len(round.key.Ks) ||
+		len(nonces.HidingCommitments) != len(round.key.Ks) ||
+		len(nonces.BindingCommitments) != len(round.key.Ks) {
+		return errors.New("the nonces were made by a different set of parties")
+	}
+	for j, kj := range round.key.Ks {
+		if nonces.Ks[j] == nil || nonces.Ks[j].Cmp(kj) != 0 {
+			return errors.New("the nonces were made by a different set of parties")
+		}
+		if nonces.HidingCommitments[j] == nil || nonces.BindingCommitments[j] == nil {
+			return errors.New("the nonce commitments are incomplete")
+		}
+	}
+	ec := round.Params().EC()
+	i := round.PartyID().Index
+	if !crypto.ScalarBaseMult(ec, round.temp.di).Equals(nonces.HidingCommitments[i]) ||
+		!crypto.ScalarBaseMult(ec, round.temp.ei).Equals(nonces.BindingCommitments[i]) {
+		return errors.New("the nonces do not match this party's commitments")
+	}
+	return nil
+}
+
+func (round *signRound1) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.signRound1Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *signRound1) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*SignRound1Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *signRound1) NextRound() tss.Round {
+	round.started = false
+	return &finalization{round}
+}
+
+// lagrange returns the Lagrange coefficient of party j at zero
+func (round *base) lagrange(j int) *big.Int {
+	return signing.PrepareForSigning(round.Params().EC(), j, len(round.key.Ks), big.NewInt(1), round.key.Ks)
+}
@@ -0,0 +1,108 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"bytes"
+	"crypto/elliptic"
+	"fmt"
+
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// VerifyEvidence re-runs the check recorded in evidence raised by a keygen party on curve `ec`, using only the messages it holds.
+// `threshold` is that of the keygen run, which the culprit must have committed to a polynomial of.
+// It returns nil when the culprit failed the check, or an error wrapping tss.ErrInvalidEvidence otherwise.
+// The identity keys of the culprit and the victim in the evidence must be checked against trusted ones by the caller.
+func VerifyEvidence(ec elliptic.Curve, threshold int, ev *tss.Evidence) error {
+	if ev == nil || ev.Task != TaskName {
+		return fmt.Errorf("%w: not evidence of a %s party", tss.ErrInvalidEvidence, TaskName)
+	}
+	msgs, err := ev.ParseMessages()
+	if err != nil {
+		return err
+	}
+	var r1msg *KGRound1Message
+	var r2msg1 *KGRound2Message1
+	var r2msg2 *KGRound2Message2
+	for _, msg := range msgs {
+		if !bytes.Equal(msg.GetFrom().GetKey(), ev.Culprit.GetKey()) {
+			continue
+		}
+		switch content := msg.Content().(type) {
+		case *KGRound1Message:
+			r1msg = content
+		case *KGRound2Message1:
+			// the share is only checked for the party the culprit signed it for
+			if ev.IsToVictim(msg) {
+				r2msg1 = content
+			}
+		case *KGRound2Message2:
+			r2msg2 = content
+		}
+	}
+	if r1msg == nil || r2msg2 == nil {
+		return fmt.Errorf("%w: missing messages for check %q", tss.ErrInvalidEvidence, ev.Check)
+	}
+
+	switch ev.Check {
+	case tss.CheckDeCommitment:
+		_, err := deCommitPolyG(ec, r1msg, r2msg2)
+		return evidenceResult(err != nil)
+
+	case tss.ProofSchnorr:
+		// a proof can only be checked against commitments that open
+		PjVs, err := deCommitPolyG(ec, r1msg, r2msg2)
+		if err != nil {
+			return fmt.Errorf("%w: the de-commitment does not open", tss.ErrInvalidEvidence)
+		}
+		proof, err := r2msg2.UnmarshalZKProof(ec)
+		return evidenceResult(err != nil || !proof.Verify(ev.SessionID, PjVs[0]))
+
+	case tss.CheckVSSShare:
+		if r2msg1 == nil {
+			return fmt.Errorf("%w: missing messages for check %q", tss.ErrInvalidEvidence, ev.Check)
+		}
+		PjVs, err := deCommitPolyG(ec, r1msg, r2msg2)
+		if err != nil {
+			return fmt.Errorf("%w: the de-commitment does not open", tss.ErrInvalidEvidence)
+		}
+		// commitments to a polynomial of another degree than the threshold fail the check
+		share := vss.Share{Threshold: threshold, ID: ev.Victim.KeyInt(), Share: r2msg1.UnmarshalShare()}
+		return evidenceResult(!share.Verify(ec, threshold, PjVs))
+
+	default:
+		return fmt.Errorf("%w: unknown check %q", tss.ErrInvalidEvidence, ev.Check)
+	}
+}
+
+// deCommitPolyG opens the commitment to the VSS polynomial of a party
+func deCommitPolyG(ec elliptic.Curve, r1msg *KGRound1Message, r2msg2 *KGRound2Message2) (vss.Vs, error) {
+	cmtDeCmt := commitments.HashCommitDecommit{C: r1msg.UnmarshalCommitment(), D: r2msg2.UnmarshalDeCommitment()}
+	ok, flatPolyGs := cmtDeCmt.DeCommit()
+	if !ok || len(flatPolyGs) == 0 {
+		return nil, tss.ErrBadDeCommitment
+	}
+	PjVs, err := crypto.UnFlattenECPoints(ec, flatPolyGs)
+	if err != nil {
+		return nil, err
+	}
+	for i, PjV := range PjVs {
+		PjVs[i] = PjV.EightInvEight()
+	}
+	return PjVs, nil
+}
+
+func evidenceResult(failed bool) error {
+	if !failed {
+		return fmt.Errorf("%w: the culprit passed the check", tss.ErrInvalidEvidence)
+	}
+	return nil
+}
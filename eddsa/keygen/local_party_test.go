@@ -7,6 +7,8 @@
 package keygen
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -23,6 +25,7 @@ import (
 	"github.com/bnb-chain/tss-lib/crypto"
 	"github.com/bnb-chain/tss-lib/crypto/vss"
 	"github.com/bnb-chain/tss-lib/test"
+	"github.com/bnb-chain/tss-lib/test/adversary"
 	"github.com/bnb-chain/tss-lib/tss"
 )
 
@@ -37,6 +40,85 @@ func setUp(level string) {
 	}
 }
 
+// the threshold of the keygen runs with a cheater
+const cheaterThreshold = 1
+
+// runWithCheater runs keygen with party 0 corrupting its messages and checks that every honest party blames it alone, for `cause`,
+// with evidence of the failed `check` that can be verified by anyone
+func runWithCheater(t *testing.T, corruption adversary.Corruption, cause error, check string) {
+	pIDs := tss.GenerateTestPartyIDs(4)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	endCh := make(chan LocalPartySaveData, len(pIDs))
+
+	newParams := func(i int) *tss.Parameters {
+		pub, key, err := ed25519.GenerateKey(rand.Reader)
+		assert.NoError(t, err)
+		pIDs[i].SetIdentityKey(pub)
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[i], len(pIDs), cheaterThreshold)
+		params.SetIdentityKey(key)
+		return params
+	}
+	results, err := adversary.Run(len(pIDs), 0, newParams, func(i int, params *tss.Parameters, out chan<- tss.Message) tss.Party {
+		return NewLocalParty(params, out, endCh)
+	}, corruption)
+	assert.NoError(t, err)
+	assert.NoError(t, adversary.CheckBlamed(results, pIDs[0], cause))
+	for _, r := range results {
+		if r.Err == nil || !assert.Len(t, r.Err.Evidence(), 1) {
+			continue
+		}
+		// the evidence is checked by a third party that received it serialized
+		bz, err := json.Marshal(r.Err.Evidence()[0])
+		assert.NoError(t, err)
+		ev := new(tss.Evidence)
+		assert.NoError(t, json.Unmarshal(bz, ev))
+		assert.Equal(t, check, ev.Check)
+		assert.NoError(t, VerifyEvidence(tss.Edwards(), cheaterThreshold, ev), "the evidence should show that the cheater failed the check")
+
+		// a share check cannot be passed off as run by another victim
+		if check == tss.CheckVSSShare {
+			relabelled := *ev
+			for _, pID := range pIDs[1:] {
+				if pID.Index != ev.Victim.Index {
+					relabelled.Victim = pID
+				}
+			}
+			assert.ErrorIs(t, VerifyEvidence(tss.Edwards(), cheaterThreshold, &relabelled), tss.ErrInvalidEvidence, "evidence with another victim should be rejected")
+		}
+
+		last := ev.Messages[len(ev.Messages)-1]
+		last[len(last)-1] ^= 1
+		assert.ErrorIs(t, VerifyEvidence(tss.Edwards(), cheaterThreshold, ev), tss.ErrInvalidEvidence, "altered evidence should be rejected")
+	}
+}
+
+func TestCheaterWithBadShareIsBlamed(t *testing.T) {
+	setUp("info")
+
+	runWithCheater(t, adversary.Corruption{
+		MessageType: adversary.MessageType(&KGRound2Message1{}),
+		Corrupt:     adversary.TamperField("share"),
+	}, tss.ErrBadShare, tss.CheckVSSShare)
+}
+
+func TestCheaterWithWrongDeCommitmentIsBlamed(t *testing.T) {
+	setUp("info")
+
+	runWithCheater(t, adversary.Corruption{
+		MessageType: adversary.MessageType(&KGRound2Message2{}),
+		Corrupt:     adversary.TamperField("de_commitment"),
+	}, tss.ErrBadDeCommitment, tss.CheckDeCommitment)
+}
+
+func TestCheaterWithForgedSchnorrProofIsBlamed(t *testing.T) {
+	setUp("info")
+
+	runWithCheater(t, adversary.Corruption{
+		MessageType: adversary.MessageType(&KGRound2Message2{}),
+		Corrupt:     adversary.TamperField("proof_t"),
+	}, &tss.ProofError{Proof: tss.ProofSchnorr}, tss.ProofSchnorr)
+}
+
 func TestE2EConcurrentAndSaveFixtures(t *testing.T) {
 	setUp("info")
 
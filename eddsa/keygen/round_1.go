@@ -7,7 +7,7 @@
 package keygen
 
 import (
-	"errors"
+	"fmt"
 	"math/big"
 
 	"github.com/bnb-chain/tss-lib/common"
@@ -29,7 +29,7 @@ func newRound1(params *tss.Parameters, save *LocalPartySaveData, temp *localTemp
 
 func (round *round1) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 	round.number = 1
 	round.started = true
@@ -39,14 +39,14 @@ func (round *round1) Start() *tss.Error {
 	i := Pi.Index
 
 	// 1. calculate "partial" key share ui
-	ui := common.GetRandomPositiveInt(round.Params().EC().Params().N)
+	ui := common.GetRandomPositiveIntWithRand(round.Rand(), round.Params().EC().Params().N)
 	round.temp.ui = ui
 
 	// 2. compute the vss shares
 	ids := round.Parties().IDs().Keys()
-	vs, shares, err := vss.Create(round.Params().EC(), round.Threshold(), ui, ids)
+	vs, shares, err := vss.CreateWithRand(round.Rand(), round.Params().EC(), round.Threshold(), ui, ids)
 	if err != nil {
-		return round.WrapError(err, Pi)
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err), Pi)
 	}
 	round.save.Ks = ids
 
@@ -57,9 +57,9 @@ func (round *round1) Start() *tss.Error {
 	// 3. make commitment -> (C, D)
 	pGFlat, err := crypto.FlattenECPoints(vs)
 	if err != nil {
-		return round.WrapError(err, Pi)
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err), Pi)
 	}
-	cmt := cmts.NewHashCommitment(pGFlat...)
+	cmt := cmts.NewHashCommitmentWithRand(round.Rand(), pGFlat...)
 
 	// for this P: SAVE
 	// - shareID
@@ -76,7 +76,9 @@ func (round *round1) Start() *tss.Error {
 	{
 		msg := NewKGRound1Message(round.PartyID(), cmt.C)
 		round.temp.kgRound1Messages[i] = msg
-		round.out <- msg
+		if err := tss.SendMessage(round.Params(), round.out, msg); err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+		}
 	}
 	return nil
 }
@@ -7,9 +7,8 @@
 package keygen
 
 import (
-	"errors"
-
-	errors2 "github.com/pkg/errors"
+	"fmt"
+	"time"
 
 	"github.com/bnb-chain/tss-lib/crypto/schnorr"
 	"github.com/bnb-chain/tss-lib/tss"
@@ -17,7 +16,7 @@ import (
 
 func (round *round2) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 	round.number = 2
 	round.started = true
@@ -41,19 +40,25 @@ func (round *round2) Start() *tss.Error {
 			continue
 		}
 		round.temp.kgRound2Message1s[i] = r2msg1
-		round.out <- r2msg1
+		if err := tss.SendMessage(round.Params(), round.out, r2msg1); err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+		}
 	}
 
 	// 5. compute Schnorr prove
-	pii, err := schnorr.NewZKProof(round.temp.ui, round.temp.vs[0])
+	start := time.Now()
+	pii, err := schnorr.NewZKProofWithRand(round.Rand(), round.SessionID(), round.temp.ui, round.temp.vs[0])
+	round.Params().Metrics().RecordProof(tss.ProofSchnorr, tss.ProofGeneration, start)
 	if err != nil {
-		return round.WrapError(errors2.Wrapf(err, "NewZKProof(ui, vi0)"))
+		return round.WrapError(fmt.Errorf("%w: NewZKProof(ui, vi0): %v", tss.ErrInternal, err))
 	}
 
 	// 5. BROADCAST de-commitments of Shamir poly*G and Schnorr prove
 	r2msg2 := NewKGRound2Message2(round.PartyID(), round.temp.deCommitPolyG, pii)
 	round.temp.kgRound2Message2s[i] = r2msg2
-	round.out <- r2msg2
+	if err := tss.SendMessage(round.Params(), round.out, r2msg2); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
 
 	return nil
 }
@@ -7,11 +7,11 @@
 package keygen
 
 import (
-	"errors"
+	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
-	errors2 "github.com/pkg/errors"
 
 	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
@@ -22,7 +22,7 @@ import (
 
 func (round *round3) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 	round.number = 3
 	round.started = true
@@ -53,6 +53,7 @@ func (round *round3) Start() *tss.Error {
 	type vssOut struct {
 		unWrappedErr error
 		pjVs         vss.Vs
+		evidence     *tss.Evidence
 	}
 	chs := make([]chan vssOut, len(Ps))
 	for i := range chs {
@@ -67,48 +68,51 @@ func (round *round3) Start() *tss.Error {
 		}
 		// 6-9.
 		go func(j int, ch chan<- vssOut) {
+			Pj := Ps[j]
+			r1msgj, r2msg1j, r2msg2j := round.temp.kgRound1Messages[j], round.temp.kgRound2Message1s[j], round.temp.kgRound2Message2s[j]
 			// 4-10.
 			KGCj := round.temp.KGCs[j]
-			r2msg2 := round.temp.kgRound2Message2s[j].Content().(*KGRound2Message2)
+			r2msg2 := r2msg2j.Content().(*KGRound2Message2)
 			KGDj := r2msg2.UnmarshalDeCommitment()
 			cmtDeCmt := commitments.HashCommitDecommit{C: KGCj, D: KGDj}
 			ok, flatPolyGs := cmtDeCmt.DeCommit()
 			if !ok || flatPolyGs == nil {
-				ch <- vssOut{errors.New("de-commitment verify failed"), nil}
+				ch <- vssOut{tss.ErrBadDeCommitment, nil, round.evidence(tss.CheckDeCommitment, Pj, r1msgj, r2msg2j)}
 				return
 			}
 
 			PjVs, err := crypto.UnFlattenECPoints(round.Params().EC(), flatPolyGs)
-			for i, PjV := range PjVs {
-				PjVs[i] = PjV.EightInvEight()
-			}
-
 			if err != nil {
-				ch <- vssOut{err, nil}
+				ch <- vssOut{fmt.Errorf("%w: %v", tss.ErrPointNotOnCurve, err), nil, round.evidence(tss.CheckDeCommitment, Pj, r1msgj, r2msg2j)}
 				return
 			}
+			for i, PjV := range PjVs {
+				PjVs[i] = PjV.EightInvEight()
+			}
 			proof, err := r2msg2.UnmarshalZKProof(round.Params().EC())
 			if err != nil {
-				ch <- vssOut{errors.New("failed to unmarshal schnorr proof"), nil}
+				ch <- vssOut{fmt.Errorf("%w: failed to unmarshal schnorr proof: %v", tss.ErrInvalidMessage, err), nil, round.evidence(tss.ProofSchnorr, Pj, r1msgj, r2msg2j)}
 				return
 			}
-			ok = proof.Verify(PjVs[0])
+			start := time.Now()
+			ok = proof.Verify(round.SessionID(), PjVs[0])
+			round.Params().Metrics().RecordProof(tss.ProofSchnorr, tss.ProofVerification, start)
 			if !ok {
-				ch <- vssOut{errors.New("failed to prove schnorr proof"), nil}
+				ch <- vssOut{tss.NewProofError(tss.ProofSchnorr, nil), nil, round.evidence(tss.ProofSchnorr, Pj, r1msgj, r2msg2j)}
 				return
 			}
-			r2msg1 := round.temp.kgRound2Message1s[j].Content().(*KGRound2Message1)
+			r2msg1 := r2msg1j.Content().(*KGRound2Message1)
 			PjShare := vss.Share{
 				Threshold: round.Threshold(),
 				ID:        round.PartyID().KeyInt(),
 				Share:     r2msg1.UnmarshalShare(),
 			}
 			if ok = PjShare.Verify(round.Params().EC(), round.Threshold(), PjVs); !ok {
-				ch <- vssOut{errors.New("vss verify failed"), nil}
+				ch <- vssOut{tss.ErrBadShare, nil, round.evidence(tss.CheckVSSShare, Pj, r1msgj, r2msg1j, r2msg2j)}
 				return
 			}
 			// (9) handled above
-			ch <- vssOut{nil, PjVs}
+			ch <- vssOut{nil, PjVs, nil}
 		}(j, chs[j])
 	}
 
@@ -128,13 +132,18 @@ func (round *round3) Start() *tss.Error {
 		}
 		var multiErr error
 		if len(culprits) > 0 {
+			evidence := make([]*tss.Evidence, 0, len(culprits))
 			for _, vssResult := range vssResults {
 				if vssResult.unWrappedErr == nil {
 					continue
 				}
 				multiErr = multierror.Append(multiErr, vssResult.unWrappedErr)
+				evidence = append(evidence, vssResult.evidence)
 			}
-			return round.WrapError(multiErr, culprits...)
+			return round.WrapError(multiErr, culprits...).WithEvidence(evidence...)
+		}
+		for j := range Ps {
+			round.ok[j] = true
 		}
 	}
 	{
@@ -154,7 +163,7 @@ func (round *round3) Start() *tss.Error {
 			}
 		}
 		if len(culprits) > 0 {
-			return round.WrapError(errors.New("adding PjVs[c] to Vc[c] resulted in a point not on the curve"), culprits...)
+			return round.WrapError(fmt.Errorf("%w: adding PjVs[c] to Vc[c]", tss.ErrPointNotOnCurve), culprits...)
 		}
 	}
 
@@ -179,7 +188,7 @@ func (round *round3) Start() *tss.Error {
 			bigXj[j] = BigXj
 		}
 		if len(culprits) > 0 {
-			return round.WrapError(errors.New("adding Vc[c].ScalarMult(z) to BigXj resulted in a point not on the curve"), culprits...)
+			return round.WrapError(fmt.Errorf("%w: adding Vc[c].ScalarMult(z) to BigXj", tss.ErrPointNotOnCurve), culprits...)
 		}
 		round.save.BigXj = bigXj
 	}
@@ -187,12 +196,12 @@ func (round *round3) Start() *tss.Error {
 	// 18. compute and SAVE the EDDSA public key `y`
 	eddsaPubKey, err := crypto.NewECPoint(round.Params().EC(), Vc[0].X(), Vc[0].Y())
 	if err != nil {
-		return round.WrapError(errors2.Wrapf(err, "public key is not on the curve"))
+		return round.WrapError(fmt.Errorf("%w: public key: %v", tss.ErrPointNotOnCurve, err))
 	}
 	round.save.EDDSAPub = eddsaPubKey
 
 	// PRINT public key & private share
-	common.Logger.Debugf("%s public key: %x", round.PartyID(), eddsaPubKey)
+	round.Params().Logger().Debugf("%s public key: %x", round.PartyID(), eddsaPubKey)
 
 	round.end <- *round.save
 	return nil
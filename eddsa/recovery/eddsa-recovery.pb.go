@@ -0,0 +1,310 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.12
+// source: protob/eddsa-recovery.proto
+
+package recovery
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Represents a BROADCAST message sent by each helper during Round 1 of the EdDSA TSS share recovery and enrolment protocol.
+// It carries the commitments to the helper's split of its Lagrange-weighted share and the public save data of the key.
+type RecoveryRound1Message1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commitments [][]byte `protobuf:"bytes,1,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	Ks          [][]byte `protobuf:"bytes,2,rep,name=ks,proto3" json:"ks,omitempty"`
+	BigXj       [][]byte `protobuf:"bytes,3,rep,name=big_xj,json=bigXj,proto3" json:"big_xj,omitempty"`
+	EddsaPub    [][]byte `protobuf:"bytes,4,rep,name=eddsa_pub,json=eddsaPub,proto3" json:"eddsa_pub,omitempty"`
+}
+
+func (x *RecoveryRound1Message1) Reset() {
+	*x = RecoveryRound1Message1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_eddsa_recovery_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoveryRound1Message1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoveryRound1Message1) ProtoMessage() {}
+
+func (x *RecoveryRound1Message1) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_eddsa_recovery_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoveryRound1Message1.ProtoReflect.Descriptor instead.
+func (*RecoveryRound1Message1) Descriptor() ([]byte, []int) {
+	return file_protob_eddsa_recovery_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RecoveryRound1Message1) GetCommitments() [][]byte {
+	if x != nil {
+		return x.Commitments
+	}
+	return nil
+}
+
+func (x *RecoveryRound1Message1) GetKs() [][]byte {
+	if x != nil {
+		return x.Ks
+	}
+	return nil
+}
+
+func (x *RecoveryRound1Message1) GetBigXj() [][]byte {
+	if x != nil {
+		return x.BigXj
+	}
+	return nil
+}
+
+func (x *RecoveryRound1Message1) GetEddsaPub() [][]byte {
+	if x != nil {
+		return x.EddsaPub
+	}
+	return nil
+}
+
+// Represents a P2P message sent by each helper to each other helper during Round 1 of the EdDSA TSS share recovery and enrolment protocol.
+type RecoveryRound1Message2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Share []byte `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+}
+
+func (x *RecoveryRound1Message2) Reset() {
+	*x = RecoveryRound1Message2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_eddsa_recovery_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoveryRound1Message2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoveryRound1Message2) ProtoMessage() {}
+
+func (x *RecoveryRound1Message2) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_eddsa_recovery_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoveryRound1Message2.ProtoReflect.Descriptor instead.
+func (*RecoveryRound1Message2) Descriptor() ([]byte, []int) {
+	return file_protob_eddsa_recovery_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RecoveryRound1Message2) GetShare() []byte {
+	if x != nil {
+		return x.Share
+	}
+	return nil
+}
+
+// Represents a P2P message sent by each helper to the recovering or enrolling party during Round 2 of the EdDSA TSS share recovery and enrolment protocol.
+type RecoveryRound2Message1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sigma []byte `protobuf:"bytes,1,opt,name=sigma,proto3" json:"sigma,omitempty"`
+}
+
+func (x *RecoveryRound2Message1) Reset() {
+	*x = RecoveryRound2Message1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protob_eddsa_recovery_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoveryRound2Message1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoveryRound2Message1) ProtoMessage() {}
+
+func (x *RecoveryRound2Message1) ProtoReflect() protoreflect.Message {
+	mi := &file_protob_eddsa_recovery_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoveryRound2Message1.ProtoReflect.Descriptor instead.
+func (*RecoveryRound2Message1) Descriptor() ([]byte, []int) {
+	return file_protob_eddsa_recovery_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RecoveryRound2Message1) GetSigma() []byte {
+	if x != nil {
+		return x.Sigma
+	}
+	return nil
+}
+
+var File_protob_eddsa_recovery_proto protoreflect.FileDescriptor
+
+var file_protob_eddsa_recovery_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x2f, 0x65, 0x64, 0x64, 0x73, 0x61, 0x2d, 0x72,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1d, 0x62,
+	0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x74, 0x73, 0x73, 0x6c, 0x69, 0x62, 0x2e, 0x65, 0x64,
+	0x64, 0x73, 0x61, 0x2e, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x22, 0x7e, 0x0a, 0x16,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x31, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x31, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x6b, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x02, 0x6b, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x67, 0x5f,
+	0x78, 0x6a, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x69, 0x67, 0x58, 0x6a, 0x12,
+	0x1b, 0x0a, 0x09, 0x65, 0x64, 0x64, 0x73, 0x61, 0x5f, 0x70, 0x75, 0x62, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x08, 0x65, 0x64, 0x64, 0x73, 0x61, 0x50, 0x75, 0x62, 0x22, 0x2e, 0x0a, 0x16,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x31, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x22, 0x2e, 0x0a, 0x16,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x32, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x67, 0x6d, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x69, 0x67, 0x6d, 0x61, 0x42, 0x10, 0x5a, 0x0e,
+	0x65, 0x64, 0x64, 0x73, 0x61, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_protob_eddsa_recovery_proto_rawDescOnce sync.Once
+	file_protob_eddsa_recovery_proto_rawDescData = file_protob_eddsa_recovery_proto_rawDesc
+)
+
+func file_protob_eddsa_recovery_proto_rawDescGZIP() []byte {
+	file_protob_eddsa_recovery_proto_rawDescOnce.Do(func() {
+		file_protob_eddsa_recovery_proto_rawDescData = protoimpl.X.CompressGZIP(file_protob_eddsa_recovery_proto_rawDescData)
+	})
+	return file_protob_eddsa_recovery_proto_rawDescData
+}
+
+var file_protob_eddsa_recovery_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_protob_eddsa_recovery_proto_goTypes = []interface{}{
+	(*RecoveryRound1Message1)(nil), // 0: binance.tsslib.eddsa.recovery.RecoveryRound1Message1
+	(*RecoveryRound1Message2)(nil), // 1: binance.tsslib.eddsa.recovery.RecoveryRound1Message2
+	(*RecoveryRound2Message1)(nil), // 2: binance.tsslib.eddsa.recovery.RecoveryRound2Message1
+}
+var file_protob_eddsa_recovery_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_protob_eddsa_recovery_proto_init() }
+func file_protob_eddsa_recovery_proto_init() {
+	if File_protob_eddsa_recovery_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_protob_eddsa_recovery_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoveryRound1Message1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protob_eddsa_recovery_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoveryRound1Message2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protob_eddsa_recovery_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoveryRound2Message1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_protob_eddsa_recovery_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_protob_eddsa_recovery_proto_goTypes,
+		DependencyIndexes: file_protob_eddsa_recovery_proto_depIdxs,
+		MessageInfos:      file_protob_eddsa_recovery_proto_msgTypes,
+	}.Build()
+	File_protob_eddsa_recovery_proto = out.File
+	file_protob_eddsa_recovery_proto_rawDesc = nil
+	file_protob_eddsa_recovery_proto_goTypes = nil
+	file_protob_eddsa_recovery_proto_depIdxs = nil
+}
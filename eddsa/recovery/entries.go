@@ -0,0 +1,78 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package recovery
+
+import (
+	"crypto/elliptic"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// ApplyEntries returns the save data `key` of a holder that did not take part in an enrolment, extended with the ShareID
+// of the new party and its Xj. The holder interpolates the Xj from the Xj of the other holders, so the ShareID is all it
+// needs to be given; the shares of `key` are unchanged. A recovery leaves the save data of the holders unchanged, so
+// holders that do not take part in one have nothing to apply.
+func ApplyEntries(ec elliptic.Curve, key keygen.LocalPartySaveData, shareID *big.Int) (keygen.LocalPartySaveData, error) {
+	if shareID == nil {
+		return key, fmt.Errorf("%w: the ShareID is missing", tss.ErrInvalidMessage)
+	}
+	// a ShareID of zero would be given the private key itself
+	q := ec.Params().N
+	if new(big.Int).Mod(shareID, q).Sign() == 0 {
+		return key, fmt.Errorf("%w: the ShareID is zero mod the curve order", tss.ErrInvalidMessage)
+	}
+	isHolder := false
+	for _, kk := range key.Ks {
+		if sameShareID(q, kk, shareID) {
+			return key, fmt.Errorf("%w: party %s is already a holder of the key", tss.ErrInvalidMessage, shareID)
+		}
+		isHolder = isHolder || (key.ShareID != nil && sameShareID(q, kk, key.ShareID))
+	}
+	if !isHolder {
+		return key, errors.New("the save data is not that of a holder of the key")
+	}
+
+	bigXT, err := interpolateBigX(ec, key.Ks, key.BigXj, shareID)
+	if err != nil {
+		return key, fmt.Errorf("%w: interpolating the Xj of the new party: %v", tss.ErrPointNotOnCurve, err)
+	}
+	key.Ks = append(append([]*big.Int(nil), key.Ks...), shareID)
+	key.BigXj = append(append([]*crypto.ECPoint(nil), key.BigXj...), bigXT)
+	return key, nil
+}
+
+// interpolateBigX returns the point at `x` of the polynomial in the exponent whose points at `ks` are `bigXs`,
+// which is the Xj of a party with ShareID `x`
+func interpolateBigX(ec elliptic.Curve, ks []*big.Int, bigXs []*crypto.ECPoint, x *big.Int) (*crypto.ECPoint, error) {
+	modQ := common.ModInt(ec.Params().N)
+	var sum *crypto.ECPoint
+	for j, kj := range ks {
+		coef := big.NewInt(1)
+		for m, km := range ks {
+			if m == j {
+				continue
+			}
+			coef = modQ.Mul(coef, modQ.Mul(modQ.Sub(x, km), modQ.ModInverse(modQ.Sub(kj, km))))
+		}
+		term := bigXs[j].ScalarMult(coef)
+		if sum == nil {
+			sum = term
+			continue
+		}
+		var err error
+		if sum, err = sum.Add(term); err != nil {
+			return nil, err
+		}
+	}
+	return sum, nil
+}
@@ -0,0 +1,279 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package recovery
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// Implements Party
+// Implements Stringer
+var _ tss.Party = (*LocalParty)(nil)
+var _ fmt.Stringer = (*LocalParty)(nil)
+
+type (
+	LocalParty struct {
+		*tss.BaseParty
+		params *tss.Parameters
+
+		temp localTempData
+		data keygen.LocalPartySaveData
+
+		// outbound messaging
+		out chan<- tss.Message
+		end chan<- keygen.LocalPartySaveData
+	}
+
+	localMessageStore struct {
+		recoveryRound1Message1s,
+		recoveryRound1Message2s,
+		recoveryRound2Message1s []tss.ParsedMessage
+	}
+
+	localTempData struct {
+		localMessageStore
+
+		// temp data (thrown away after the protocol)
+		target int   // the index of the recovering or enrolling party among the parties
+		enrol  bool  // whether the target is a new party, rather than one that lost its share
+		keyIdx []int // the index of each party in the save data; set in round 2 by the target
+
+		// helpers only
+		commitments [][]*crypto.ECPoint // the commitments of each helper to its split, one point per helper
+		shares      []*big.Int          // the split of this helper's weighted share, one part per helper
+	}
+)
+
+// NewLocalParty returns a helper that takes part in rebuilding the lost share of `lost` for its existing ShareID.
+// The parties are `lost` and at least t+1 holders of `key`; no helper learns the recovered share, and the save data
+// of the helpers does not change.
+func NewLocalParty(
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	lost *tss.PartyID,
+	out chan<- tss.Message,
+	end chan<- keygen.LocalPartySaveData,
+) tss.Party {
+	return newHelper("recovery.NewLocalParty", params, key, lost, false, out, end)
+}
+
+// NewLocalPartyForEnrolment returns a helper that takes part in giving a share of the key to `newcomer`, whose ShareID is
+// not yet in Ks. The parties are `newcomer` and at least t+1 holders of `key`; no helper learns the new share, and the
+// existing shares and the threshold do not change. The helpers end with `key` extended with the ShareID and Xj of
+// `newcomer`. Holders that do not take part do not learn of `newcomer` until they are given its ShareID, which they
+// save with ApplyEntries.
+func NewLocalPartyForEnrolment(
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	newcomer *tss.PartyID,
+	out chan<- tss.Message,
+	end chan<- keygen.LocalPartySaveData,
+) tss.Party {
+	return newHelper("recovery.NewLocalPartyForEnrolment", params, key, newcomer, true, out, end)
+}
+
+// NewLocalPartyToRecover returns the party that lost its share. Its PartyID must carry its existing ShareID as the key.
+// It ends with a full save data that includes its share.
+func NewLocalPartyToRecover(
+	params *tss.Parameters,
+	out chan<- tss.Message,
+	end chan<- keygen.LocalPartySaveData,
+) tss.Party {
+	return newTarget("recovery.NewLocalPartyToRecover", params, false, out, end)
+}
+
+// NewLocalPartyToEnrol returns the party that joins the holders of the key. Its PartyID carries its new ShareID as the key,
+// which must not be in Ks. It ends with a full save data that includes its new share.
+func NewLocalPartyToEnrol(
+	params *tss.Parameters,
+	out chan<- tss.Message,
+	end chan<- keygen.LocalPartySaveData,
+) tss.Party {
+	return newTarget("recovery.NewLocalPartyToEnrol", params, true, out, end)
+}
+
+func newHelper(
+	caller string,
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	targetID *tss.PartyID,
+	enrol bool,
+	out chan<- tss.Message,
+	end chan<- keygen.LocalPartySaveData,
+) *LocalParty {
+	Ps := params.Parties().IDs()
+	target := Ps.FindByKey(targetID.KeyInt())
+	if target == nil {
+		panic(fmt.Errorf("%s expected %s to take part", caller, targetID))
+	}
+	q := params.EC().Params().N
+	if key.ShareID == nil || !sameShareID(q, params.PartyID().KeyInt(), key.ShareID) || target.Index == params.PartyID().Index {
+		panic(fmt.Errorf("%s expected the party to be a holder of `key`", caller))
+	}
+	if params.PartyCount()-1 <= params.Threshold() {
+		panic(fmt.Errorf("%s expected at least t+1 helpers", caller))
+	}
+	// a ShareID of zero would be given the private key itself
+	if new(big.Int).Mod(target.KeyInt(), q).Sign() == 0 {
+		panic(fmt.Errorf("%s expected a ShareID that is not zero mod the curve order", caller))
+	}
+	keyIdx, err := indexesInKey(q, key.Ks, Ps, target.Index, enrol)
+	if err != nil {
+		panic(fmt.Errorf("%s: %v", caller, err))
+	}
+	// the entries of a new party are appended, so they must not be shared with the caller's key
+	key.Ks = append([]*big.Int(nil), key.Ks...)
+	key.BigXj = append([]*crypto.ECPoint(nil), key.BigXj...)
+	p := newLocalParty(params, key, target.Index, enrol, out, end)
+	p.temp.keyIdx = keyIdx
+	return p
+}
+
+func newTarget(
+	caller string,
+	params *tss.Parameters,
+	enrol bool,
+	out chan<- tss.Message,
+	end chan<- keygen.LocalPartySaveData,
+) *LocalParty {
+	if params.PartyCount()-1 <= params.Threshold() {
+		panic(fmt.Errorf("%s expected at least t+1 helpers", caller))
+	}
+	return newLocalParty(params, keygen.LocalPartySaveData{}, params.PartyID().Index, enrol, out, end)
+}
+
+func newLocalParty(
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	target int,
+	enrol bool,
+	out chan<- tss.Message,
+	end chan<- keygen.LocalPartySaveData,
+) *LocalParty {
+	partyCount := params.PartyCount()
+	p := &LocalParty{
+		BaseParty: tss.NewBaseParty(params),
+		params:    params,
+		temp:      localTempData{},
+		data:      key,
+		out:       out,
+		end:       end,
+	}
+	// msgs init
+	p.temp.recoveryRound1Message1s = make([]tss.ParsedMessage, partyCount)
+	p.temp.recoveryRound1Message2s = make([]tss.ParsedMessage, partyCount)
+	p.temp.recoveryRound2Message1s = make([]tss.ParsedMessage, partyCount)
+	// temp data init
+	p.temp.target = target
+	p.temp.enrol = enrol
+	p.temp.commitments = make([][]*crypto.ECPoint, partyCount)
+	return p
+}
+
+func (p *LocalParty) FirstRound() tss.Round {
+	return newRound1(p.params, &p.data, &p.temp, p.out, p.end)
+}
+
+func (p *LocalParty) Start() *tss.Error {
+	return tss.BaseStart(p, TaskName)
+}
+
+func (p *LocalParty) StartWithContext(ctx context.Context) *tss.Error {
+	return tss.BaseStartWithContext(ctx, p, TaskName)
+}
+
+func (p *LocalParty) Update(msg tss.ParsedMessage) (ok bool, err *tss.Error) {
+	return tss.BaseUpdate(p, msg, TaskName)
+}
+
+func (p *LocalParty) UpdateFromBytes(wireBytes []byte, from *tss.PartyID, isBroadcast bool) (bool, *tss.Error) {
+	msg, err := p.ParseWireMessage(wireBytes, from, isBroadcast)
+	if err != nil {
+		return false, err
+	}
+	return p.Update(msg)
+}
+
+func (p *LocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
+	if ok, err := p.BaseParty.ValidateMessage(msg); !ok || err != nil {
+		return ok, err
+	}
+	// check that the message's "from index" will fit into the array
+	if maxFromIdx := p.params.PartyCount() - 1; maxFromIdx < msg.GetFrom().Index {
+		return false, p.WrapError(fmt.Errorf("%w: received msg with a sender index too great (%d <= %d)", tss.ErrInvalidMessage,
+			p.params.PartyCount(), msg.GetFrom().Index), msg.GetFrom())
+	}
+	// only the helpers send messages
+	if msg.GetFrom().Index == p.temp.target {
+		return false, p.WrapError(fmt.Errorf("%w: the target may not send %s", tss.ErrInvalidMessage, msg.Type()), msg.GetFrom())
+	}
+	return true, nil
+}
+
+func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
+	// ValidateBasic is cheap; double-check the message here in case the public StoreMessage was called externally
+	if ok, err := p.ValidateMessage(msg); !ok || err != nil {
+		return ok, err
+	}
+
+	// switch/case is necessary to store any messages beyond current round
+	// a sender may deliver each message only once; identical re-deliveries are ignored. spoofing protection is left to the caller.
+	switch msg.Content().(type) {
+	case *RecoveryRound1Message1:
+		return tss.StoreMessageOnce(p, p.temp.recoveryRound1Message1s, msg)
+	case *RecoveryRound1Message2:
+		return tss.StoreMessageOnce(p, p.temp.recoveryRound1Message2s, msg)
+	case *RecoveryRound2Message1:
+		return tss.StoreMessageOnce(p, p.temp.recoveryRound2Message1s, msg)
+	default: // unrecognised message, just ignore!
+		p.params.Logger().Warningf("unrecognised message ignored: %s", msg.Type())
+		return false, nil
+	}
+}
+
+func (p *LocalParty) PartyID() *tss.PartyID {
+	return p.params.PartyID()
+}
+
+func (p *LocalParty) String() string {
+	return fmt.Sprintf("id: %s, %s", p.PartyID(), p.BaseParty.String())
+}
+
+// indexesInKey returns the index in `ks` of each party in `Ps`, comparing the ShareIDs mod the curve order `q`.
+// A new party at index `target` must not be in `ks`, and is given the index after the last one.
+func indexesInKey(q *big.Int, ks []*big.Int, Ps tss.SortedPartyIDs, target int, enrol bool) ([]int, error) {
+	keyIdx := make([]int, len(Ps))
+	for j, Pj := range Ps {
+		keyIdx[j] = -1
+		for k, kk := range ks {
+			if kk != nil && sameShareID(q, kk, Pj.KeyInt()) {
+				keyIdx[j] = k
+				break
+			}
+		}
+		switch {
+		case j == target && enrol && 0 <= keyIdx[j]:
+			return nil, fmt.Errorf("party %s is already a holder of the key", Pj)
+		case j == target && enrol:
+			keyIdx[j] = len(ks)
+		case keyIdx[j] < 0:
+			return nil, fmt.Errorf("party %s is not a holder of the key", Pj)
+		}
+	}
+	return keyIdx, nil
+}
+
+// sameShareID reports whether `a` and `b` are the same ShareID, which are points of the polynomials mod the curve order `q`
+func sameShareID(q, a, b *big.Int) bool {
+	return new(big.Int).Mod(a, q).Cmp(new(big.Int).Mod(b, q)) == 0
+}
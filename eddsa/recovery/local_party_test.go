@@ -0,0 +1,260 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package recovery
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/eddsa/signing"
+	"github.com/bnb-chain/tss-lib/test"
+	"github.com/bnb-chain/tss-lib/test/adversary"
+	"github.com/bnb-chain/tss-lib/test/netsim"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+const (
+	testParticipants = test.TestParticipants
+	testThreshold    = test.TestThreshold
+
+	// the index of the party that lost its share
+	testLost = 3
+)
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+
+	// only for test
+	tss.SetCurve(tss.Edwards())
+}
+
+// newParty makes party i of the recovery of `testLost` among the first t+2 fixtures
+func newParty(params *tss.Parameters, keys []keygen.LocalPartySaveData, pIDs tss.SortedPartyIDs, i int, out chan<- tss.Message, end chan<- keygen.LocalPartySaveData) tss.Party {
+	if i == testLost {
+		return NewLocalPartyToRecover(params, out, end)
+	}
+	return NewLocalParty(params, keys[i], pIDs[testLost], out, end)
+}
+
+// sign signs with the given keys and checks the signature against the public key
+func sign(t *testing.T, keys []keygen.LocalPartySaveData, signPIDs tss.SortedPartyIDs) {
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	outCh := make(chan tss.Message, len(signPIDs)*len(signPIDs))
+	sigCh := make(chan common.SignatureData, len(signPIDs))
+	msg := big.NewInt(42)
+	net := netsim.New(1)
+	for i, pID := range signPIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pID, len(signPIDs), testThreshold)
+		assert.NoError(t, net.Register(signing.NewLocalParty(msg, params, keys[i], outCh, sigCh)))
+	}
+	results, err := net.Run(context.Background(), outCh, time.Second)
+	assert.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, netsim.Finished, r.Outcome, r.String())
+	}
+	sig, err := edwards.ParseSignature((<-sigCh).Signature)
+	if !assert.NoError(t, err) {
+		return
+	}
+	pk := edwards.PublicKey{Curve: tss.Edwards(), X: keys[0].EDDSAPub.X(), Y: keys[0].EDDSAPub.Y()}
+	assert.True(t, edwards.Verify(&pk, msg.Bytes(), sig.R, sig.S), "eddsa verify must pass")
+}
+
+func TestE2E(t *testing.T) {
+	setUp("info")
+
+	keys, allPIDs, err := keygen.LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	pIDs := allPIDs[:testThreshold+2]
+
+	p2pCtx := tss.NewPeerContext(pIDs)
+	outCh := make(chan tss.Message, len(pIDs)*len(pIDs))
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+	net := netsim.New(1)
+	for i, pID := range pIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pID, len(pIDs), testThreshold)
+		assert.NoError(t, net.Register(newParty(params, keys, pIDs, i, outCh, endCh)))
+	}
+	results, err := net.Run(context.Background(), outCh, time.Second)
+	assert.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, netsim.Finished, r.Outcome, r.String())
+	}
+
+	saves := make([]keygen.LocalPartySaveData, len(pIDs))
+	for range pIDs {
+		save := <-endCh
+		index, err := save.OriginalIndex()
+		assert.NoError(t, err)
+		saves[index] = save
+	}
+	lost, recovered := keys[testLost], saves[testLost]
+	assert.Equal(t, 0, lost.Xi.Cmp(recovered.Xi), "the share should be recovered")
+	assert.Equal(t, 0, lost.ShareID.Cmp(recovered.ShareID))
+	assert.True(t, recovered.EDDSAPub.Equals(lost.EDDSAPub))
+	assert.Equal(t, lost.Ks, recovered.Ks)
+	for j := range lost.Ks {
+		assert.True(t, recovered.BigXj[j].Equals(lost.BigXj[j]))
+	}
+	for i, save := range saves {
+		if i == testLost {
+			continue
+		}
+		assert.Equal(t, 0, keys[i].Xi.Cmp(save.Xi), "the shares of the helpers should not change")
+		assert.Equal(t, len(keys[i].Ks), len(save.Ks))
+	}
+
+	// sign with the recovered share
+	signers := append([]keygen.LocalPartySaveData{}, keys[:testThreshold+1]...)
+	signers[testLost] = recovered
+	sign(t, signers, allPIDs[:testThreshold+1])
+}
+
+func TestE2EEnrol(t *testing.T) {
+	setUp("info")
+
+	keys, allPIDs, err := keygen.LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	newcomer := tss.NewPartyID("newcomer", "N", common.GetRandomPositiveInt(tss.Edwards().Params().N))
+	unsorted := tss.UnSortedPartyIDs{newcomer}
+	for _, pID := range allPIDs[:testThreshold+1] {
+		unsorted = append(unsorted, tss.NewPartyID(pID.Id, pID.Moniker, pID.KeyInt()))
+	}
+	pIDs := tss.SortPartyIDs(unsorted)
+
+	p2pCtx := tss.NewPeerContext(pIDs)
+	outCh := make(chan tss.Message, len(pIDs)*len(pIDs))
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+	net := netsim.New(1)
+	for _, pID := range pIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pID, len(pIDs), testThreshold)
+		if pID == newcomer {
+			assert.NoError(t, net.Register(NewLocalPartyToEnrol(params, outCh, endCh)))
+			continue
+		}
+		for _, key := range keys {
+			if key.ShareID.Cmp(pID.KeyInt()) == 0 {
+				assert.NoError(t, net.Register(NewLocalPartyForEnrolment(params, key, newcomer, outCh, endCh)))
+			}
+		}
+	}
+	results, err := net.Run(context.Background(), outCh, time.Second)
+	assert.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, netsim.Finished, r.Outcome, r.String())
+	}
+
+	var enrolled keygen.LocalPartySaveData
+	saves := make([]keygen.LocalPartySaveData, 0, len(pIDs)-1)
+	for range pIDs {
+		save := <-endCh
+		if save.ShareID.Cmp(newcomer.KeyInt()) == 0 {
+			enrolled = save
+			continue
+		}
+		saves = append(saves, save)
+	}
+	kN := len(keys)
+	assert.True(t, enrolled.EDDSAPub.Equals(keys[0].EDDSAPub))
+	assert.Equal(t, kN+1, len(enrolled.Ks))
+	assert.True(t, crypto.ScalarBaseMult(tss.Edwards(), enrolled.Xi).Equals(enrolled.BigXj[kN]))
+	for _, save := range saves {
+		assert.Equal(t, kN+1, len(save.Ks))
+		assert.Equal(t, 0, save.Ks[kN].Cmp(newcomer.KeyInt()))
+		assert.True(t, save.BigXj[kN].Equals(enrolled.BigXj[kN]))
+		for k := 0; k < kN; k++ {
+			assert.True(t, save.BigXj[k].Equals(enrolled.BigXj[k]))
+		}
+	}
+
+	// a holder that did not take part is given the ShareID of the newcomer
+	outsiderPID, outsider := allPIDs[len(allPIDs)-1], keys[len(keys)-1]
+	_, err = ApplyEntries(tss.Edwards(), outsider, keys[0].ShareID)
+	assert.True(t, errors.Is(err, tss.ErrInvalidMessage), "the ShareID of a holder should be rejected: %v", err)
+	_, err = ApplyEntries(tss.Edwards(), outsider, new(big.Int).Add(keys[0].ShareID, tss.Edwards().Params().N))
+	assert.True(t, errors.Is(err, tss.ErrInvalidMessage), "the ShareID of a holder plus the curve order should be rejected: %v", err)
+	outsider, err = ApplyEntries(tss.Edwards(), outsider, newcomer.KeyInt())
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, kN+1, len(outsider.Ks))
+	assert.Equal(t, 0, outsider.Ks[kN].Cmp(newcomer.KeyInt()))
+	assert.True(t, outsider.BigXj[kN].Equals(enrolled.BigXj[kN]), "the interpolated Xj should be that of the newcomer")
+	assert.Equal(t, kN, len(keys[len(keys)-1].Ks), "the save data passed in should not change")
+	saves = append(saves, outsider)
+
+	// sign with the newcomer, the holder that did not take part and t-1 holders that did
+	unsorted = tss.UnSortedPartyIDs{
+		tss.NewPartyID(newcomer.Id, newcomer.Moniker, newcomer.KeyInt()),
+		tss.NewPartyID(outsiderPID.Id, outsiderPID.Moniker, outsiderPID.KeyInt()),
+	}
+	for _, pID := range allPIDs[:testThreshold-1] {
+		unsorted = append(unsorted, tss.NewPartyID(pID.Id, pID.Moniker, pID.KeyInt()))
+	}
+	signPIDs := tss.SortPartyIDs(unsorted)
+	signers := make([]keygen.LocalPartySaveData, len(signPIDs))
+	for i, pID := range signPIDs {
+		signers[i] = enrolled
+		for _, save := range saves {
+			if save.ShareID.Cmp(pID.KeyInt()) == 0 {
+				signers[i] = save
+			}
+		}
+	}
+	sign(t, signers, signPIDs)
+}
+
+func TestE2ECheater(t *testing.T) {
+	setUp("info")
+
+	keys, allPIDs, err := keygen.LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	pIDs := allPIDs[:testThreshold+2]
+	p2pCtx := tss.NewPeerContext(pIDs)
+	newParams := func(i int) *tss.Parameters {
+		return tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[i], len(pIDs), testThreshold)
+	}
+
+	for _, tc := range []struct {
+		corruption adversary.Corruption
+		cause      error
+	}{
+		{adversary.Corruption{MessageType: adversary.MessageType(&RecoveryRound1Message2{}), Corrupt: adversary.TamperField("share")}, tss.ErrBadShare},
+		{adversary.Corruption{MessageType: adversary.MessageType(&RecoveryRound1Message1{}), Corrupt: adversary.TamperField("ks")}, tss.ErrInvalidMessage},
+		{adversary.Corruption{MessageType: adversary.MessageType(&RecoveryRound2Message1{}), Corrupt: adversary.TamperField("sigma")}, tss.ErrBadShare},
+	} {
+		endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+		results, err := adversary.Run(len(pIDs), 0, newParams, func(i int, params *tss.Parameters, out chan<- tss.Message) tss.Party {
+			return newParty(params, keys, pIDs, i, out, endCh)
+		}, tc.corruption)
+		assert.NoError(t, err)
+		errored := 0
+		for _, r := range results {
+			// a party that is not started with a context may still finish after it failed, so its error is checked
+			if r.Err == nil {
+				continue
+			}
+			errored++
+			assert.True(t, adversary.BlamesOnly(r.Err, pIDs[0]), "honest parties should blame the cheater alone: %s", r)
+			assert.True(t, errors.Is(r.Err, tc.cause), "unexpected cause: %s", r)
+		}
+		assert.NotZero(t, errored, "the cheating should be detected")
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package recovery
+
+import (
+	"bytes"
+	"crypto/elliptic"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// These messages were generated from Protocol Buffers definitions into eddsa-recovery.pb.go
+// The following messages are registered on the Protocol Buffers "wire"
+
+var (
+	// Ensure that recovery messages implement ValidateBasic
+	_ = []tss.MessageContent{
+		(*RecoveryRound1Message1)(nil),
+		(*RecoveryRound1Message2)(nil),
+		(*RecoveryRound2Message1)(nil),
+	}
+)
+
+// ----- //
+
+// NewRecoveryRound1Message1 makes the round 1 broadcast of a helper, with the public parts of its save data
+func NewRecoveryRound1Message1(
+	from *tss.PartyID,
+	commitments []*crypto.ECPoint,
+	key *keygen.LocalPartySaveData,
+) (tss.ParsedMessage, error) {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	commitmentsFlat, err := crypto.FlattenECPoints(commitments)
+	if err != nil {
+		return nil, err
+	}
+	bigXjFlat, err := crypto.FlattenECPoints(key.BigXj)
+	if err != nil {
+		return nil, err
+	}
+	content := &RecoveryRound1Message1{
+		Commitments: common.BigIntsToBytes(commitmentsFlat),
+		Ks:          common.BigIntsToBytes(key.Ks),
+		BigXj:       common.BigIntsToBytes(bigXjFlat),
+		EddsaPub:    common.BigIntsToBytes([]*big.Int{key.EDDSAPub.X(), key.EDDSAPub.Y()}),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg), nil
+}
+
+func (m *RecoveryRound1Message1) ValidateBasic() bool {
+	if m == nil || !common.NonEmptyMultiBytes(m.GetCommitments()) || len(m.GetCommitments())%2 != 0 {
+		return false
+	}
+	return common.NonEmptyMultiBytes(m.GetKs()) &&
+		common.NonEmptyMultiBytes(m.GetBigXj(), 2*len(m.GetKs())) &&
+		common.NonEmptyMultiBytes(m.GetEddsaPub(), 2)
+}
+
+func (m *RecoveryRound1Message1) UnmarshalCommitments(ec elliptic.Curve) ([]*crypto.ECPoint, error) {
+	return crypto.UnFlattenECPoints(ec, common.MultiBytesToBigInts(m.GetCommitments()))
+}
+
+// UnmarshalSaveData returns the public parts of the save data sent by the helper
+func (m *RecoveryRound1Message1) UnmarshalSaveData(ec elliptic.Curve) (keygen.LocalPartySaveData, error) {
+	save := keygen.NewLocalPartySaveData(len(m.GetKs()))
+	bigXj, err := crypto.UnFlattenECPoints(ec, common.MultiBytesToBigInts(m.GetBigXj()))
+	if err != nil {
+		return save, err
+	}
+	eddsaPub, err := crypto.NewECPoint(ec, new(big.Int).SetBytes(m.GetEddsaPub()[0]), new(big.Int).SetBytes(m.GetEddsaPub()[1]))
+	if err != nil {
+		return save, err
+	}
+	save.Ks = common.MultiBytesToBigInts(m.GetKs())
+	save.BigXj = bigXj
+	save.EDDSAPub = eddsaPub
+	return save, nil
+}
+
+// SameSaveData reports whether two helpers sent the same public save data
+func (m *RecoveryRound1Message1) SameSaveData(other *RecoveryRound1Message1) bool {
+	for _, pair := range [][2][][]byte{
+		{m.GetKs(), other.GetKs()},
+		{m.GetBigXj(), other.GetBigXj()},
+		{m.GetEddsaPub(), other.GetEddsaPub()},
+	} {
+		if len(pair[0]) != len(pair[1]) {
+			return false
+		}
+		for k := range pair[0] {
+			if !bytes.Equal(pair[0][k], pair[1][k]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// ----- //
+
+func NewRecoveryRound1Message2(
+	to, from *tss.PartyID,
+	share *big.Int,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	content := &RecoveryRound1Message2{
+		Share: share.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *RecoveryRound1Message2) ValidateBasic() bool {
+	return m != nil && common.NonEmptyBytes(m.GetShare())
+}
+
+func (m *RecoveryRound1Message2) UnmarshalShare() *big.Int {
+	return new(big.Int).SetBytes(m.GetShare())
+}
+
+// ----- //
+
+func NewRecoveryRound2Message1(
+	to, from *tss.PartyID,
+	sigma *big.Int,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	content := &RecoveryRound2Message1{
+		Sigma: sigma.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *RecoveryRound2Message1) ValidateBasic() bool {
+	return m != nil && common.NonEmptyBytes(m.GetSigma())
+}
+
+func (m *RecoveryRound2Message1) UnmarshalSigma() *big.Int {
+	return new(big.Int).SetBytes(m.GetSigma())
+}
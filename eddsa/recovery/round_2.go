@@ -0,0 +1,277 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package recovery
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func (round *round2) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	round.number = 2
+	round.started = true
+	round.resetOK()
+
+	if round.PartyID().Index == round.temp.target {
+		return round.startTarget()
+	}
+	return round.finishHelper()
+}
+
+// finishHelper checks the messages of round 1, sends the sum of the parts this helper received to the target and
+// saves the entries of a new party
+func (round *round2) finishHelper() *tss.Error {
+	ec := round.Params().EC()
+	Ps := round.Parties().IDs()
+	Pi := round.PartyID()
+	i := Pi.Index
+	T := round.temp.target
+	modQ := common.ModInt(ec.Params().N)
+	for j := range Ps {
+		round.ok[j] = true
+	}
+
+	// 1. the other helpers must hold the same public save data
+	{
+		own := round.temp.recoveryRound1Message1s[i].Content().(*RecoveryRound1Message1)
+		culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+		for _, j := range round.helpers() {
+			r1msg1 := round.temp.recoveryRound1Message1s[j].Content().(*RecoveryRound1Message1)
+			if j != i && !own.SameSaveData(r1msg1) {
+				culprits = append(culprits, Ps[j])
+			}
+		}
+		if len(culprits) > 0 {
+			return round.WrapError(fmt.Errorf("%w: the public save data does not match ours", tss.ErrInvalidMessage), culprits...)
+		}
+	}
+
+	// 2. check the commitments and the parts received from the other helpers
+	if err := round.verifyCommitments(); err != nil {
+		return err
+	}
+	sigma := big.NewInt(0)
+	{
+		var multiErr error
+		culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+		h := helperPos(i, T)
+		for _, j := range round.helpers() {
+			share := round.temp.recoveryRound1Message2s[j].Content().(*RecoveryRound1Message2).UnmarshalShare()
+			if !crypto.ScalarBaseMult(ec, share).Equals(round.temp.commitments[j][h]) {
+				multiErr = multierror.Append(multiErr, tss.ErrBadShare)
+				culprits = append(culprits, Ps[j])
+				continue
+			}
+			sigma = modQ.Add(sigma, share)
+		}
+		if len(culprits) > 0 {
+			return round.WrapError(multiErr, culprits...)
+		}
+	}
+
+	// 3. p2p send sigma to the target
+	r2msg1 := NewRecoveryRound2Message1(Ps[T], Pi, sigma)
+	if err := tss.SendMessage(round.Params(), round.out, r2msg1); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
+
+	// 4. SAVE the ShareID and Xj of a new party; the shares are unchanged
+	if round.temp.enrol {
+		bigXT, err := round.targetBigX()
+		if err != nil {
+			return round.WrapError(fmt.Errorf("%w: adding the commitments: %v", tss.ErrPointNotOnCurve, err))
+		}
+		round.save.Ks = append(round.save.Ks, Ps[T].KeyInt())
+		round.save.BigXj = append(round.save.BigXj, bigXT)
+	}
+	round.end <- *round.save
+
+	return nil
+}
+
+// startTarget takes the public save data held by most helpers and checks the commitments
+func (round *round2) startTarget() *tss.Error {
+	ec := round.Params().EC()
+	Ps := round.Parties().IDs()
+	Pi := round.PartyID()
+	i := Pi.Index
+
+	// 1. group the helpers by the public save data they sent; those outside the largest group are blamed
+	helpers := round.helpers()
+	var chosen keygen.LocalPartySaveData
+	{
+		var multiErr error
+		culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+		groups := make([][]int, 0, len(helpers))
+		for _, j := range helpers {
+			r1msg1 := round.temp.recoveryRound1Message1s[j].Content().(*RecoveryRound1Message1)
+			save, err := r1msg1.UnmarshalSaveData(ec)
+			if err == nil {
+				_, err = indexesInKey(ec.Params().N, save.Ks, Ps, i, round.temp.enrol)
+			}
+			if err != nil {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("%w: bad public save data: %v", tss.ErrInvalidMessage, err))
+				culprits = append(culprits, Ps[j])
+				continue
+			}
+			found := false
+			for g, group := range groups {
+				if round.temp.recoveryRound1Message1s[group[0]].Content().(*RecoveryRound1Message1).SameSaveData(r1msg1) {
+					groups[g], found = append(group, j), true
+					break
+				}
+			}
+			if !found {
+				groups = append(groups, []int{j})
+			}
+		}
+		largest := -1
+		for g, group := range groups {
+			if largest < 0 || len(groups[largest]) < len(group) {
+				largest = g
+			}
+		}
+		for g, group := range groups {
+			if g == largest {
+				continue
+			}
+			for _, j := range group {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("%w: the public save data does not match that of most helpers", tss.ErrInvalidMessage))
+				culprits = append(culprits, Ps[j])
+			}
+		}
+		if len(culprits) > 0 {
+			return round.WrapError(multiErr, culprits...)
+		}
+		var err error
+		chosen, err = round.temp.recoveryRound1Message1s[groups[largest][0]].Content().(*RecoveryRound1Message1).UnmarshalSaveData(ec)
+		if err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+		}
+	}
+
+	// 2. build the save data of this party; Xi and the Xj of a new party are set in round 3
+	keyIdx, err := indexesInKey(round.Params().EC().Params().N, chosen.Ks, Ps, i, round.temp.enrol)
+	if err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
+	round.temp.keyIdx = keyIdx
+	if round.temp.enrol {
+		chosen.Ks = append(chosen.Ks, Pi.KeyInt())
+		chosen.BigXj = append(chosen.BigXj, nil)
+	}
+	chosen.ShareID = Pi.KeyInt()
+	*round.save = chosen
+
+	// 3. check the commitments of the helpers
+	return round.verifyCommitments()
+}
+
+// verifyCommitments checks that the commitments of each helper j add up to λj * Xj
+func (round *round2) verifyCommitments() *tss.Error {
+	ec := round.Params().EC()
+	Ps := round.Parties().IDs()
+	helpers := round.helpers()
+	var multiErr error
+	culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+	for _, j := range helpers {
+		r1msg1 := round.temp.recoveryRound1Message1s[j].Content().(*RecoveryRound1Message1)
+		commitments, err := r1msg1.UnmarshalCommitments(ec)
+		if err != nil || len(commitments) != len(helpers) {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("%w: bad commitments", tss.ErrInvalidMessage))
+			culprits = append(culprits, Ps[j])
+			continue
+		}
+		sum := commitments[0]
+		for _, D := range commitments[1:] {
+			if sum, err = sum.Add(D); err != nil {
+				break
+			}
+		}
+		if err != nil || !sum.Equals(round.save.BigXj[round.temp.keyIdx[j]].ScalarMult(round.lagrange(j))) {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("%w: the commitments do not add up to the weighted Xj", tss.ErrInvalidMessage))
+			culprits = append(culprits, Ps[j])
+			continue
+		}
+		round.temp.commitments[j] = commitments
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(multiErr, culprits...)
+	}
+	return nil
+}
+
+// targetBigX adds up the commitments of all helpers, which gives the Xj of the target as the sum of λj * Xj
+func (round *round2) targetBigX() (*crypto.ECPoint, error) {
+	var sum *crypto.ECPoint
+	for _, j := range round.helpers() {
+		for _, D := range round.temp.commitments[j] {
+			if sum == nil {
+				sum = D
+				continue
+			}
+			var err error
+			if sum, err = sum.Add(D); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return sum, nil
+}
+
+func (round *round2) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*RecoveryRound2Message1); ok {
+		return !msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round2) Update() (bool, *tss.Error) {
+	// only the target expects messages in this round
+	for j := range round.ok {
+		if round.ok[j] {
+			continue
+		}
+		if j == round.temp.target {
+			round.ok[j] = true
+			continue
+		}
+		msg := round.temp.recoveryRound2Message1s[j]
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		// share checks are in round 3
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round2) NextRound() tss.Round {
+	round.started = false
+	if round.PartyID().Index != round.temp.target {
+		return nil // finished!
+	}
+	return &round3{round}
+}
+
+// helperPos returns the position of helper j among the helpers, which skip the target at index target
+func helperPos(j, target int) int {
+	if j < target {
+		return j
+	}
+	return j - 1
+}
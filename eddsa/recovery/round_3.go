@@ -0,0 +1,98 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package recovery
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// round 3 is run by the target alone: it checks each sigma against the commitments of the helpers and adds them up
+// to its share
+func (round *round3) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	round.number = 3
+	round.started = true
+	round.resetOK()
+
+	ec := round.Params().EC()
+	Ps := round.Parties().IDs()
+	i := round.PartyID().Index
+	modQ := common.ModInt(ec.Params().N)
+	for j := range Ps {
+		round.ok[j] = true
+	}
+
+	// 1. sigma_j*G must equal the sum of the commitments of the helpers to the parts sent to helper j
+	xi := big.NewInt(0)
+	{
+		var multiErr error
+		culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+		for _, j := range round.helpers() {
+			h := helperPos(j, i)
+			var sum *crypto.ECPoint
+			var err error
+			for _, m := range round.helpers() {
+				if sum == nil {
+					sum = round.temp.commitments[m][h]
+				} else if sum, err = sum.Add(round.temp.commitments[m][h]); err != nil {
+					break
+				}
+			}
+			sigma := round.temp.recoveryRound2Message1s[j].Content().(*RecoveryRound2Message1).UnmarshalSigma()
+			if err != nil || !crypto.ScalarBaseMult(ec, sigma).Equals(sum) {
+				multiErr = multierror.Append(multiErr, tss.ErrBadShare)
+				culprits = append(culprits, Ps[j])
+				continue
+			}
+			xi = modQ.Add(xi, sigma)
+		}
+		if len(culprits) > 0 {
+			return round.WrapError(multiErr, culprits...)
+		}
+	}
+	bigXT, err := round.targetBigX()
+	if err != nil {
+		return round.WrapError(fmt.Errorf("%w: adding the commitments: %v", tss.ErrPointNotOnCurve, err))
+	}
+	kT := round.temp.keyIdx[i]
+	if !round.temp.enrol && !bigXT.Equals(round.save.BigXj[kT]) {
+		return round.WrapError(fmt.Errorf("%w: the commitments do not match the Xj of this party", tss.ErrInconsistentResult))
+	}
+	if !crypto.ScalarBaseMult(ec, xi).Equals(bigXT) {
+		return round.WrapError(fmt.Errorf("%w: the share does not match its commitment", tss.ErrInconsistentResult))
+	}
+
+	// 2. SAVE the share
+	round.save.Xi = xi
+	round.save.BigXj[kT] = bigXT
+	round.end <- *round.save
+
+	return nil
+}
+
+func (round *round3) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *round3) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *round3) NextRound() tss.Round {
+	return nil // finished!
+}
@@ -0,0 +1,123 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package recovery
+
+import (
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+const (
+	TaskName = "eddsa-recovery"
+)
+
+type (
+	base struct {
+		*tss.Parameters
+		save    *keygen.LocalPartySaveData
+		temp    *localTempData
+		out     chan<- tss.Message
+		end     chan<- keygen.LocalPartySaveData
+		ok      []bool // `ok` tracks parties which have been verified by Update()
+		started bool
+		number  int
+	}
+	round1 struct {
+		*base
+	}
+	round2 struct {
+		*round1
+	}
+	round3 struct {
+		*round2
+	}
+)
+
+var (
+	_ tss.Round = (*round1)(nil)
+	_ tss.Round = (*round2)(nil)
+	_ tss.Round = (*round3)(nil)
+)
+
+// ----- //
+
+func (round *base) Params() *tss.Parameters {
+	return round.Parameters
+}
+
+func (round *base) RoundNumber() int {
+	return round.number
+}
+
+// CanProceed is inherited by other rounds
+func (round *base) CanProceed() bool {
+	if !round.started {
+		return false
+	}
+	for _, ok := range round.ok {
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// WaitingFor is called by a Party for reporting back to the caller
+func (round *base) WaitingFor() []*tss.PartyID {
+	Ps := round.Parties().IDs()
+	ids := make([]*tss.PartyID, 0, len(round.ok))
+	for j, ok := range round.ok {
+		if ok {
+			continue
+		}
+		ids = append(ids, Ps[j])
+	}
+	return ids
+}
+
+func (round *base) WrapError(err error, culprits ...*tss.PartyID) *tss.Error {
+	return tss.NewError(err, TaskName, round.number, round.PartyID(), culprits...)
+}
+
+// ----- //
+
+// `ok` tracks parties which have been verified by Update()
+func (round *base) resetOK() {
+	for j := range round.ok {
+		round.ok[j] = false
+	}
+}
+
+// helpers returns the indexes of the parties other than the target
+func (round *base) helpers() []int {
+	hs := make([]int, 0, round.PartyCount()-1)
+	for j := 0; j < round.PartyCount(); j++ {
+		if j != round.temp.target {
+			hs = append(hs, j)
+		}
+	}
+	return hs
+}
+
+// lagrange returns the Lagrange coefficient of helper j over the helpers, evaluated at the ShareID of the target
+func (round *base) lagrange(j int) *big.Int {
+	modQ := common.ModInt(round.Params().EC().Params().N)
+	Ps := round.Parties().IDs()
+	kj, kT := Ps[j].KeyInt(), Ps[round.temp.target].KeyInt()
+	coef := big.NewInt(1)
+	for _, m := range round.helpers() {
+		if m == j {
+			continue
+		}
+		km := Ps[m].KeyInt()
+		coef = modQ.Mul(coef, modQ.Mul(modQ.Sub(kT, km), modQ.ModInverse(modQ.Sub(kj, km))))
+	}
+	return coef
+}
@@ -0,0 +1,136 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package refresh
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/eddsa/signing"
+	"github.com/bnb-chain/tss-lib/test"
+	"github.com/bnb-chain/tss-lib/test/adversary"
+	"github.com/bnb-chain/tss-lib/test/netsim"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+const (
+	testParticipants = test.TestParticipants
+	testThreshold    = test.TestThreshold
+)
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+
+	// only for test
+	tss.SetCurve(tss.Edwards())
+}
+
+func TestE2E(t *testing.T) {
+	setUp("info")
+
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	p2pCtx := tss.NewPeerContext(pIDs)
+	outCh := make(chan tss.Message, len(pIDs)*len(pIDs))
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+
+	net := netsim.New(1)
+	for i, pID := range pIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pID, len(pIDs), testThreshold)
+		assert.NoError(t, net.Register(NewLocalParty(params, keys[i], outCh, endCh)))
+	}
+	results, err := net.Run(context.Background(), outCh, time.Second)
+	assert.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, netsim.Finished, r.Outcome, r.String())
+	}
+
+	refreshed := make([]keygen.LocalPartySaveData, len(pIDs))
+	for range pIDs {
+		save := <-endCh
+		index, err := save.OriginalIndex()
+		assert.NoError(t, err)
+		refreshed[index] = save
+	}
+	shares := make(vss.Shares, 0, len(pIDs))
+	for i, save := range refreshed {
+		assert.True(t, save.EDDSAPub.Equals(keys[i].EDDSAPub), "the public key should not change")
+		assert.Equal(t, keys[i].Ks, save.Ks, "Ks should not change")
+		assert.NotEqual(t, 0, save.Xi.Cmp(keys[i].Xi), "the share should be refreshed")
+		for j := range pIDs {
+			assert.True(t, save.BigXj[j].Equals(refreshed[j].BigXj[j]), "the parties should agree on Xj")
+		}
+		assert.True(t, save.BigXj[i].Equals(crypto.ScalarBaseMult(tss.Edwards(), save.Xi)), "Xi should match the refreshed share")
+		shares = append(shares, &vss.Share{Threshold: testThreshold, ID: save.ShareID, Share: save.Xi})
+	}
+	x, err := shares[:testThreshold+1].ReConstruct(tss.Edwards())
+	assert.NoError(t, err)
+	assert.True(t, crypto.ScalarBaseMult(tss.Edwards(), x).Equals(keys[0].EDDSAPub), "the refreshed shares should reconstruct the private key")
+
+	// sign with the refreshed keys
+	signPIDs := pIDs[:testThreshold+1]
+	p2pCtx = tss.NewPeerContext(signPIDs)
+	outCh = make(chan tss.Message, len(signPIDs)*len(signPIDs))
+	sigCh := make(chan common.SignatureData, len(signPIDs))
+	msg := big.NewInt(42)
+	net = netsim.New(1)
+	for i, pID := range signPIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pID, len(signPIDs), testThreshold)
+		assert.NoError(t, net.Register(signing.NewLocalParty(msg, params, refreshed[i], outCh, sigCh)))
+	}
+	results, err = net.Run(context.Background(), outCh, time.Second)
+	assert.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, netsim.Finished, r.Outcome, r.String())
+	}
+	sig, err := edwards.ParseSignature((<-sigCh).Signature)
+	if !assert.NoError(t, err) {
+		return
+	}
+	pk := edwards.PublicKey{Curve: tss.Edwards(), X: keys[0].EDDSAPub.X(), Y: keys[0].EDDSAPub.Y()}
+	assert.True(t, edwards.Verify(&pk, msg.Bytes(), sig.R, sig.S), "eddsa verify must pass with the refreshed keys")
+}
+
+func TestE2ECheater(t *testing.T) {
+	setUp("info")
+
+	keys, pIDs, err := keygen.LoadKeygenTestFixtures(testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	p2pCtx := tss.NewPeerContext(pIDs)
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+	newParams := func(i int) *tss.Parameters {
+		return tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[i], len(pIDs), testThreshold)
+	}
+
+	for _, tc := range []struct {
+		corruption adversary.Corruption
+		cause      error
+	}{
+		{adversary.Corruption{MessageType: adversary.MessageType(&RefreshRound1Message2{}), Corrupt: adversary.TamperField("share")}, tss.ErrBadShare},
+		{adversary.Corruption{MessageType: adversary.MessageType(&RefreshRound1Message1{}), Corrupt: adversary.TamperField("vs")}, tss.ErrInvalidMessage},
+	} {
+		results, err := adversary.Run(len(pIDs), 0, newParams, func(i int, params *tss.Parameters, out chan<- tss.Message) tss.Party {
+			return NewLocalParty(params, keys[i], out, endCh)
+		}, tc.corruption)
+		assert.NoError(t, err)
+		assert.NoError(t, adversary.CheckBlamed(results, pIDs[0], tc.cause))
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package refresh
+
+import (
+	"crypto/elliptic"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// These messages were generated from Protocol Buffers definitions into eddsa-refresh.pb.go
+// The following messages are registered on the Protocol Buffers "wire"
+
+var (
+	// Ensure that refresh messages implement ValidateBasic
+	_ = []tss.MessageContent{
+		(*RefreshRound1Message1)(nil),
+		(*RefreshRound1Message2)(nil),
+	}
+)
+
+// ----- //
+
+func NewRefreshRound1Message1(from *tss.PartyID, vs vss.Vs) (tss.ParsedMessage, error) {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	vsFlat, err := crypto.FlattenECPoints(vs)
+	if err != nil {
+		return nil, err
+	}
+	content := &RefreshRound1Message1{
+		Vs: common.BigIntsToBytes(vsFlat),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg), nil
+}
+
+func (m *RefreshRound1Message1) ValidateBasic() bool {
+	return m != nil && common.NonEmptyMultiBytes(m.GetVs()) && len(m.GetVs())%2 == 0
+}
+
+func (m *RefreshRound1Message1) UnmarshalVs(ec elliptic.Curve) (vss.Vs, error) {
+	return crypto.UnFlattenECPoints(ec, common.MultiBytesToBigInts(m.GetVs()))
+}
+
+// ----- //
+
+func NewRefreshRound1Message2(to, from *tss.PartyID, share *vss.Share) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		To:          []*tss.PartyID{to},
+		IsBroadcast: false,
+	}
+	content := &RefreshRound1Message2{
+		Share: share.Share.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *RefreshRound1Message2) ValidateBasic() bool {
+	return m != nil && common.NonEmptyBytes(m.GetShare())
+}
+
+func (m *RefreshRound1Message2) UnmarshalShare() *big.Int {
+	return new(big.Int).SetBytes(m.GetShare())
+}
@@ -0,0 +1,121 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package refresh
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func (round *round2) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	round.number = 2
+	round.started = true
+	round.resetOK()
+
+	ec := round.Params().EC()
+	Ps := round.Parties().IDs()
+	PIdx := round.PartyID().Index
+
+	// 1. verify the zero shares of the other parties against their commitments
+	Vsj := make([]vss.Vs, len(Ps))
+	Vsj[PIdx] = round.temp.vs
+	xi := new(big.Int).Set(round.save.Xi)
+	{
+		var multiErr error
+		culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+		for j, Pj := range Ps {
+			round.ok[j] = true
+			r1msg2 := round.temp.refreshRound1Message2s[j].Content().(*RefreshRound1Message2)
+			if j == PIdx {
+				xi.Add(xi, r1msg2.UnmarshalShare())
+				continue
+			}
+			r1msg1 := round.temp.refreshRound1Message1s[j].Content().(*RefreshRound1Message1)
+			PjVs, err := r1msg1.UnmarshalVs(ec)
+			if err != nil || len(PjVs) != round.Threshold() {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("%w: bad zero sharing commitments", tss.ErrInvalidMessage))
+				culprits = append(culprits, Pj)
+				continue
+			}
+			PjShare := vss.Share{
+				Threshold: round.Threshold(),
+				ID:        round.PartyID().KeyInt(),
+				Share:     r1msg2.UnmarshalShare(),
+			}
+			if !PjShare.VerifyZeroSharing(ec, round.Threshold(), PjVs) {
+				multiErr = multierror.Append(multiErr, tss.ErrBadShare)
+				culprits = append(culprits, Pj)
+				continue
+			}
+			Vsj[j] = PjVs
+			xi.Add(xi, PjShare.Share)
+		}
+		if len(culprits) > 0 {
+			return round.WrapError(multiErr, culprits...)
+		}
+	}
+
+	// 2. sum the zero sharing commitments of all parties, then compute the refreshed Xj of each Pj
+	Vc := make(vss.Vs, round.Threshold())
+	copy(Vc, Vsj[PIdx])
+	for j := range Ps {
+		if j == PIdx {
+			continue
+		}
+		for c := range Vc {
+			var err error
+			if Vc[c], err = Vc[c].Add(Vsj[j][c]); err != nil {
+				return round.WrapError(fmt.Errorf("%w: adding the zero sharing commitments: %v", tss.ErrPointNotOnCurve, err))
+			}
+		}
+	}
+	bigXj := make([]*crypto.ECPoint, len(Ps))
+	for m, Pm := range Ps {
+		Vm, err := vss.ZeroSharingCommitment(ec, Vc, Pm.KeyInt())
+		if err == nil {
+			bigXj[m], err = round.save.BigXj[m].Add(Vm)
+		}
+		if err != nil {
+			return round.WrapError(fmt.Errorf("%w: refreshing BigXj: %v", tss.ErrPointNotOnCurve, err))
+		}
+	}
+	xi.Mod(xi, ec.Params().N)
+	if !crypto.ScalarBaseMult(ec, xi).Equals(bigXj[PIdx]) {
+		return round.WrapError(fmt.Errorf("%w: the refreshed share does not match its commitment", tss.ErrInconsistentResult))
+	}
+
+	// 3. SAVE the refreshed share; Ks and EDDSAPub are unchanged
+	round.save.Xi = xi
+	round.save.BigXj = bigXj
+
+	round.end <- *round.save
+
+	return nil
+}
+
+func (round *round2) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *round2) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *round2) NextRound() tss.Round {
+	return nil // finished!
+}
@@ -7,14 +7,14 @@
 package resharing
 
 import (
-	"errors"
+	"fmt"
 
 	"github.com/bnb-chain/tss-lib/tss"
 )
 
 func (round *round2) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 	round.number = 2
 	round.started = true
@@ -32,7 +32,9 @@ func (round *round2) Start() *tss.Error {
 	// 1. "broadcast" "ACK" members of the OLD committee
 	r2msg := NewDGRound2Message(round.OldParties().IDs(), Pi)
 	round.temp.dgRound2Messages[i] = r2msg
-	round.out <- r2msg
+	if err := tss.SendMessage(round.Params(), round.out, r2msg); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
 
 	return nil
 }
@@ -7,10 +7,9 @@
 package resharing
 
 import (
+	"fmt"
 	"math/big"
 
-	"github.com/pkg/errors"
-
 	"github.com/bnb-chain/tss-lib/common"
 	"github.com/bnb-chain/tss-lib/crypto"
 	"github.com/bnb-chain/tss-lib/crypto/commitments"
@@ -20,7 +19,7 @@ import (
 
 func (round *round4) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 	round.number = 4
 	round.started = true
@@ -53,11 +52,11 @@ func (round *round4) Start() *tss.Error {
 		ok, flatVs := vCmtDeCmt.DeCommit()
 		if !ok || len(flatVs) != (round.NewThreshold()+1)*2 { // they're points so * 2
 			// TODO collect culprits and return a list of them as per convention
-			return round.WrapError(errors.New("de-commitment of v_j0..v_jt failed"), round.Parties().IDs()[j])
+			return round.WrapError(fmt.Errorf("%w: v_j0..v_jt", tss.ErrBadDeCommitment), round.Parties().IDs()[j])
 		}
 		vj, err := crypto.UnFlattenECPoints(round.Params().EC(), flatVs)
 		if err != nil {
-			return round.WrapError(err, round.Parties().IDs()[j])
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrPointNotOnCurve, err), round.Parties().IDs()[j])
 		}
 
 		for i, v := range vj {
@@ -73,7 +72,7 @@ func (round *round4) Start() *tss.Error {
 			Share:     new(big.Int).SetBytes(r3msg1.Share),
 		}
 		if ok := sharej.Verify(round.Params().EC(), round.NewThreshold(), vj); !ok {
-			return round.WrapError(errors.New("share from old committee did not pass Verify()"), round.Parties().IDs()[j])
+			return round.WrapError(fmt.Errorf("%w: share from old committee", tss.ErrBadShare), round.Parties().IDs()[j])
 		}
 
 		newXi = new(big.Int).Add(newXi, sharej.Share)
@@ -87,14 +86,14 @@ func (round *round4) Start() *tss.Error {
 		for j := 1; j <= len(vjc)-1; j++ {
 			Vc[c], err = Vc[c].Add(vjc[j][c])
 			if err != nil {
-				return round.WrapError(errors.Wrapf(err, "Vc[c].Add(vjc[j][c])"))
+				return round.WrapError(fmt.Errorf("%w: Vc[c].Add(vjc[j][c]): %v", tss.ErrPointNotOnCurve, err))
 			}
 		}
 	}
 
 	// 13-15.
 	if !Vc[0].Equals(round.save.EDDSAPub) {
-		return round.WrapError(errors.New("assertion failed: V_0 != y"), round.PartyID())
+		return round.WrapError(fmt.Errorf("%w: V_0 != y", tss.ErrInconsistentResult), round.PartyID())
 	}
 
 	// 16-20.
@@ -117,7 +116,7 @@ func (round *round4) Start() *tss.Error {
 		newBigXjs[j] = newBigXj
 	}
 	if len(culprits) > 0 {
-		return round.WrapError(errors.Wrapf(err, "newBigXj.Add(Vc[c].ScalarMult(z))"), culprits...)
+		return round.WrapError(fmt.Errorf("%w: newBigXj.Add(Vc[c].ScalarMult(z)): %v", tss.ErrPointNotOnCurve, err), culprits...)
 	}
 
 	round.temp.newXi = newXi
@@ -127,7 +126,9 @@ func (round *round4) Start() *tss.Error {
 	// 21. Send an "ACK" message to both committees to signal that we're ready to save our data
 	r4msg := NewDGRound4Message(round.OldAndNewParties(), Pi)
 	round.temp.dgRound4Messages[i] = r4msg
-	round.out <- r4msg
+	if err := tss.SendMessage(round.Params(), round.out, r4msg); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
 
 	return nil
 }
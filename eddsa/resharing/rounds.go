@@ -133,3 +133,8 @@ func (round *base) allNewOK() {
 		round.newOK[j] = true
 	}
 }
+
+// getBase is used to take a snapshot of the round a party is in
+func (round *base) getBase() *base {
+	return round
+}
@@ -0,0 +1,130 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package resharing
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/crypto"
+	cmt "github.com/bnb-chain/tss-lib/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+type (
+	// snapshotState is what a snapshot of a resharing party holds
+	snapshotState struct {
+		Round        int
+		OldOK, NewOK []bool
+		Input, Save  keygen.LocalPartySaveData
+		Messages     [][][]byte
+		Temp         snapshotTempData
+	}
+
+	// snapshotTempData mirrors localTempData without its message store
+	snapshotTempData struct {
+		NewVs     vss.Vs
+		NewShares vss.Shares
+		VD        cmt.HashDeCommitment
+		NewXi     *big.Int
+		NewKs     []*big.Int
+		NewBigXjs []*crypto.ECPoint
+	}
+)
+
+// Snapshot exports the state of this party in the middle of a resharing run, encrypted with `key` (32 bytes).
+// After a restart, a new party constructed with the same arguments continues the run with Resume.
+func (p *LocalParty) Snapshot(key []byte) ([]byte, *tss.Error) {
+	return tss.BaseSnapshot(p, TaskName, key, p.exportState)
+}
+
+// Resume restores a snapshot taken with Snapshot into this party, which must not have been started.
+// The party then continues from the round it was in; messages that it missed should be delivered to it again.
+func (p *LocalParty) Resume(key, snapshot []byte) *tss.Error {
+	return tss.BaseResume(p, TaskName, key, snapshot, p.restoreState)
+}
+
+func (p *LocalParty) messageStores() []*[]tss.ParsedMessage {
+	return []*[]tss.ParsedMessage{
+		&p.temp.dgRound1Messages,
+		&p.temp.dgRound2Messages,
+		&p.temp.dgRound3Message1s,
+		&p.temp.dgRound3Message2s,
+		&p.temp.dgRound4Messages,
+	}
+}
+
+func (p *LocalParty) exportState(current tss.Round) (interface{}, error) {
+	rnd, ok := current.(interface{ getBase() *base })
+	if !ok {
+		return nil, errors.New("the party is in an unexpected round")
+	}
+	state := &snapshotState{
+		Round: current.RoundNumber(),
+		OldOK: rnd.getBase().oldOK,
+		NewOK: rnd.getBase().newOK,
+		Input: p.input,
+		Save:  p.save,
+		Temp: snapshotTempData{
+			NewVs:     p.temp.NewVs,
+			NewShares: p.temp.NewShares,
+			VD:        p.temp.VD,
+			NewXi:     p.temp.newXi,
+			NewKs:     p.temp.newKs,
+			NewBigXjs: p.temp.newBigXjs,
+		},
+	}
+	for _, store := range p.messageStores() {
+		bzs, err := tss.MarshalMessageStore(*store)
+		if err != nil {
+			return nil, err
+		}
+		state.Messages = append(state.Messages, bzs)
+	}
+	return state, nil
+}
+
+func (p *LocalParty) restoreState(bz []byte) (tss.Round, error) {
+	state := new(snapshotState)
+	if err := json.Unmarshal(bz, state); err != nil {
+		return nil, err
+	}
+	stores := p.messageStores()
+	if len(state.Messages) != len(stores) ||
+		len(state.OldOK) != len(p.params.OldParties().IDs()) ||
+		len(state.NewOK) != len(p.params.NewParties().IDs()) {
+		return nil, errors.New("the snapshot does not match this party's parameters")
+	}
+	for i, store := range stores {
+		msgs, err := tss.UnmarshalMessageStore(state.Messages[i], p.params.OldAndNewParties())
+		if err != nil {
+			return nil, err
+		}
+		*store = msgs
+	}
+	p.input = state.Input
+	p.save = state.Save
+	p.temp.NewVs = state.Temp.NewVs
+	p.temp.NewShares = state.Temp.NewShares
+	p.temp.VD = state.Temp.VD
+	p.temp.newXi = state.Temp.NewXi
+	p.temp.newKs = state.Temp.NewKs
+	p.temp.newBigXjs = state.Temp.NewBigXjs
+
+	round := p.FirstRound()
+	rnd := round.(*round1).base
+	for i := 1; i < state.Round; i++ {
+		round = round.NextRound()
+	}
+	rnd.number, rnd.started = state.Round, true
+	copy(rnd.oldOK, state.OldOK)
+	copy(rnd.newOK, state.NewOK)
+	return round, nil
+}
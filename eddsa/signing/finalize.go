@@ -7,7 +7,6 @@
 package signing
 
 import (
-	"errors"
 	"fmt"
 	"math/big"
 
@@ -19,7 +18,7 @@ import (
 
 func (round *finalization) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 	round.number = 4
 	round.started = true
@@ -43,7 +42,7 @@ func (round *finalization) Start() *tss.Error {
 	round.data.Signature = append(bigIntToEncodedBytes(round.temp.r)[:], sumS[:]...)
 	round.data.R = round.temp.r.Bytes()
 	round.data.S = s.Bytes()
-	round.data.M = round.temp.m.Bytes()
+	round.data.M = round.temp.m
 
 	pk := edwards.PublicKey{
 		Curve: round.Params().EC(),
@@ -51,9 +50,9 @@ func (round *finalization) Start() *tss.Error {
 		Y:     round.key.EDDSAPub.Y(),
 	}
 
-	ok := edwards.Verify(&pk, round.temp.m.Bytes(), round.temp.r, s)
+	ok := edwards.Verify(&pk, round.temp.m, round.temp.r, s)
 	if !ok {
-		return round.WrapError(fmt.Errorf("signature verification failed"))
+		return round.WrapError(fmt.Errorf("%w: signature verification failed", tss.ErrInconsistentResult))
 	}
 	round.end <- *round.data
 
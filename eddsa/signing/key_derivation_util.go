@@ -0,0 +1,56 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/crypto/ckd"
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+)
+
+// UpdatePublicKeyAndAdjustBigXj sets EDDSAPub to the derived child key and shifts every BigXj by delta*G,
+// so that the keys can be used with NewLocalPartyWithKDD.
+func UpdatePublicKeyAndAdjustBigXj(keyDerivationDelta *big.Int, keys []keygen.LocalPartySaveData, childPk *ecdsa.PublicKey, ec elliptic.Curve) error {
+	var err error
+	gDelta := crypto.ScalarBaseMult(ec, keyDerivationDelta)
+	for k := range keys {
+		keys[k].EDDSAPub, err = crypto.NewECPoint(ec, childPk.X, childPk.Y)
+		if err != nil {
+			return fmt.Errorf("error creating new child public key: %w", err)
+		}
+		// Suppose X_j has shamir shares X_j0,     X_j1,     ..., X_jn
+		// So X_j + D has shamir shares  X_j0 + D, X_j1 + D, ..., X_jn + D
+		for j := range keys[k].BigXj {
+			keys[k].BigXj[j], err = keys[k].BigXj[j].Add(gDelta)
+			if err != nil {
+				return fmt.Errorf("error in delta operation: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+func derivingPubkeyFromPath(masterPub *crypto.ECPoint, chainCode []byte, path []uint32, ec elliptic.Curve) (*big.Int, *ckd.ExtendedKey, error) {
+	pk := ecdsa.PublicKey{
+		Curve: ec,
+		X:     masterPub.X(),
+		Y:     masterPub.Y(),
+	}
+	extendedParentPk := &ckd.ExtendedKey{
+		PublicKey:  pk,
+		Depth:      0,
+		ChildIndex: 0,
+		ChainCode:  chainCode[:],
+		ParentFP:   []byte{0x00, 0x00, 0x00, 0x00},
+	}
+	return ckd.DeriveEd25519ChildKeyFromHierarchy(path, extendedParentPk)
+}
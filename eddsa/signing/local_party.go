@@ -7,7 +7,7 @@
 package signing
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"math/big"
 
@@ -47,8 +47,9 @@ type (
 		localMessageStore
 
 		// temp data (thrown away after sign) / round 1
+		m []byte
 		wi,
-		m,
+		keyDerivationDelta,
 		ri *big.Int
 		pointRi  *crypto.ECPoint
 		deCommit cmt.HashDeCommitment
@@ -69,9 +70,58 @@ func NewLocalParty(
 	out chan<- tss.Message,
 	end chan<- common.SignatureData,
 ) tss.Party {
+	return newLocalParty(msg.Bytes(), params, key, nil, out, end)
+}
+
+// NewLocalPartyWithBytes returns a party that signs msg as is. Unlike NewLocalParty it keeps any leading zero bytes,
+// so the signature verifies with crypto/ed25519.
+func NewLocalPartyWithBytes(
+	msg []byte,
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	out chan<- tss.Message,
+	end chan<- common.SignatureData,
+) tss.Party {
+	return newLocalParty(append([]byte{}, msg...), params, key, nil, out, end)
+}
+
+// NewLocalPartyWithKDD returns a party with key derivation delta for HD support.
+// `key` should already hold the child public key, see UpdatePublicKeyAndAdjustBigXj.
+func NewLocalPartyWithKDD(
+	msg *big.Int,
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	keyDerivationDelta *big.Int,
+	out chan<- tss.Message,
+	end chan<- common.SignatureData,
+) tss.Party {
+	return newLocalParty(msg.Bytes(), params, key, keyDerivationDelta, out, end)
+}
+
+// NewLocalPartyWithBytesAndKDD returns a party that signs msg as is, like NewLocalPartyWithBytes, under the child key
+// of a key derivation delta, like NewLocalPartyWithKDD.
+func NewLocalPartyWithBytesAndKDD(
+	msg []byte,
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	keyDerivationDelta *big.Int,
+	out chan<- tss.Message,
+	end chan<- common.SignatureData,
+) tss.Party {
+	return newLocalParty(append([]byte{}, msg...), params, key, keyDerivationDelta, out, end)
+}
+
+func newLocalParty(
+	msg []byte,
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	keyDerivationDelta *big.Int,
+	out chan<- tss.Message,
+	end chan<- common.SignatureData,
+) *LocalParty {
 	partyCount := len(params.Parties().IDs())
 	p := &LocalParty{
-		BaseParty: new(tss.BaseParty),
+		BaseParty: tss.NewBaseParty(params),
 		params:    params,
 		keys:      keygen.BuildLocalSaveDataSubset(key, params.Parties().IDs()),
 		temp:      localTempData{},
@@ -86,6 +136,7 @@ func NewLocalParty(
 
 	// temp data init
 	p.temp.m = msg
+	p.temp.keyDerivationDelta = keyDerivationDelta
 	p.temp.cjs = make([]*big.Int, partyCount)
 	return p
 }
@@ -95,16 +146,22 @@ func (p *LocalParty) FirstRound() tss.Round {
 }
 
 func (p *LocalParty) Start() *tss.Error {
-	return tss.BaseStart(p, TaskName, func(round tss.Round) *tss.Error {
-		round1, ok := round.(*round1)
-		if !ok {
-			return round.WrapError(errors.New("unable to Start(). party is in an unexpected round"))
-		}
-		if err := round1.prepare(); err != nil {
-			return round.WrapError(err)
-		}
-		return nil
-	})
+	return tss.BaseStart(p, TaskName, prepareRound1)
+}
+
+func (p *LocalParty) StartWithContext(ctx context.Context) *tss.Error {
+	return tss.BaseStartWithContext(ctx, p, TaskName, prepareRound1)
+}
+
+func prepareRound1(round tss.Round) *tss.Error {
+	round1, ok := round.(*round1)
+	if !ok {
+		return round.WrapError(fmt.Errorf("%w: unable to Start(). party is in an unexpected round", tss.ErrInternal))
+	}
+	if err := round1.prepare(); err != nil {
+		return round.WrapError(err)
+	}
+	return nil
 }
 
 func (p *LocalParty) Update(msg tss.ParsedMessage) (ok bool, err *tss.Error) {
@@ -112,20 +169,20 @@ func (p *LocalParty) Update(msg tss.ParsedMessage) (ok bool, err *tss.Error) {
 }
 
 func (p *LocalParty) UpdateFromBytes(wireBytes []byte, from *tss.PartyID, isBroadcast bool) (bool, *tss.Error) {
-	msg, err := tss.ParseWireMessage(wireBytes, from, isBroadcast)
+	msg, err := p.ParseWireMessage(wireBytes, from, isBroadcast)
 	if err != nil {
-		return false, p.WrapError(err)
+		return false, err
 	}
 	return p.Update(msg)
 }
 
 func (p *LocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	if msg.GetFrom() == nil || !msg.GetFrom().ValidateBasic() {
-		return false, p.WrapError(fmt.Errorf("received msg with an invalid sender: %s", msg))
+		return false, p.WrapError(fmt.Errorf("%w: received msg with an invalid sender: %s", tss.ErrInvalidMessage, msg))
 	}
 	// check that the message's "from index" will fit into the array
 	if maxFromIdx := len(p.params.Parties().IDs()) - 1; maxFromIdx < msg.GetFrom().Index {
-		return false, p.WrapError(fmt.Errorf("received msg with a sender index too great (%d <= %d)",
+		return false, p.WrapError(fmt.Errorf("%w: received msg with a sender index too great (%d <= %d)", tss.ErrInvalidMessage,
 			maxFromIdx, msg.GetFrom().Index), msg.GetFrom())
 	}
 	return p.BaseParty.ValidateMessage(msg)
@@ -136,25 +193,23 @@ func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
 	if ok, err := p.ValidateMessage(msg); !ok || err != nil {
 		return ok, err
 	}
-	fromPIdx := msg.GetFrom().Index
 
 	// switch/case is necessary to store any messages beyond current round
-	// this does not handle message replays. we expect the caller to apply replay and spoofing protection.
+	// a sender may deliver each message only once; identical re-deliveries are ignored. spoofing protection is left to the caller.
 	switch msg.Content().(type) {
 	case *SignRound1Message:
-		p.temp.signRound1Messages[fromPIdx] = msg
+		return tss.StoreMessageOnce(p, p.temp.signRound1Messages, msg)
 
 	case *SignRound2Message:
-		p.temp.signRound2Messages[fromPIdx] = msg
+		return tss.StoreMessageOnce(p, p.temp.signRound2Messages, msg)
 
 	case *SignRound3Message:
-		p.temp.signRound3Messages[fromPIdx] = msg
+		return tss.StoreMessageOnce(p, p.temp.signRound3Messages, msg)
 
 	default: // unrecognised message, just ignore!
-		common.Logger.Warningf("unrecognised message ignored: %v", msg)
+		p.params.Logger().Warningf("unrecognised message ignored: %s", msg.Type())
 		return false, nil
 	}
-	return true, nil
 }
 
 func (p *LocalParty) PartyID() *tss.PartyID {
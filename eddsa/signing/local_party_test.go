@@ -7,10 +7,16 @@
 package signing
 
 import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
 	"fmt"
 	"math/big"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/agl/ed25519/edwards25519"
 	"github.com/decred/dcrd/dcrec/edwards/v2"
@@ -63,6 +69,7 @@ func TestE2EConcurrent(t *testing.T) {
 	// init the parties
 	for i := 0; i < len(signPIDs); i++ {
 		params := tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[i], len(signPIDs), threshold)
+		params.SetSessionID([]byte("eddsa-signing-e2e"))
 
 		P := NewLocalParty(msg, params, keys[i], outCh, endCh).(*LocalParty)
 		parties = append(parties, P)
@@ -142,3 +149,291 @@ signing:
 		}
 	}
 }
+
+func TestE2EStartWithContext(t *testing.T) {
+	setUp("info")
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	parties := make([]*LocalParty, 0, len(signPIDs))
+
+	errCh := make(chan *tss.Error, len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs)*len(signPIDs))
+	endCh := make(chan common.SignatureData, len(signPIDs))
+
+	msg := big.NewInt(200)
+	for i := range signPIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+		params.SetRoundTimeout(30 * time.Second)
+		P := NewLocalParty(msg, params, keys[i], outCh, endCh).(*LocalParty)
+		parties = append(parties, P)
+	}
+	for _, P := range parties {
+		go func(P *LocalParty) {
+			errCh <- P.StartWithContext(context.Background())
+		}(P)
+	}
+	// route the messages only once every party has started and sent its round 1 broadcast
+	pending := make([]tss.Message, 0, len(signPIDs))
+	for range signPIDs {
+		pending = append(pending, <-outCh)
+	}
+	updateErrCh := make(chan *tss.Error, len(signPIDs))
+	route := func(m tss.Message) {
+		for _, P := range parties {
+			if dest := m.GetTo(); P.PartyID().Index != m.GetFrom().Index && (dest == nil || dest[0].Index == P.PartyID().Index) {
+				go test.SharedPartyUpdater(P, m, updateErrCh)
+			}
+		}
+	}
+	for _, m := range pending {
+		route(m)
+	}
+	for ended := 0; ended < len(signPIDs); {
+		select {
+		case err := <-updateErrCh:
+			assert.FailNow(t, err.Error())
+		case m := <-outCh:
+			route(m)
+		case <-endCh:
+			ended++
+		}
+	}
+	// StartWithContext returns nil once the party has finished
+	for range signPIDs {
+		assert.Nil(t, <-errCh)
+	}
+
+	pk := edwards.PublicKey{
+		Curve: tss.Edwards(),
+		X:     keys[0].EDDSAPub.X(),
+		Y:     keys[0].EDDSAPub.Y(),
+	}
+	sig, err := edwards.ParseSignature(parties[0].data.Signature)
+	if assert.NoError(t, err) {
+		assert.True(t, edwards.Verify(&pk, msg.Bytes(), sig.R, sig.S), "eddsa verify must pass")
+	}
+}
+
+func TestE2EWithHDKeyDerivation(t *testing.T) {
+	setUp("info")
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	chainCode := make([]byte, 32)
+	_, err = rand.Read(chainCode)
+	assert.NoError(t, err)
+
+	delta, childPk, err := derivingPubkeyFromPath(keys[0].EDDSAPub, chainCode, []uint32{44, 1815, 0, 7}, tss.Edwards())
+	assert.NoErrorf(t, err, "there should not be an error deriving the child public key")
+	err = UpdatePublicKeyAndAdjustBigXj(delta, keys, &childPk.PublicKey, tss.Edwards())
+	assert.NoErrorf(t, err, "there should not be an error setting the derived keys")
+
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	run := func(newParty func(params *tss.Parameters, key keygen.LocalPartySaveData, out chan<- tss.Message, end chan<- common.SignatureData) tss.Party) []byte {
+		parties := make([]*LocalParty, 0, len(signPIDs))
+
+		errCh := make(chan *tss.Error, len(signPIDs))
+		outCh := make(chan tss.Message, len(signPIDs)*len(signPIDs))
+		endCh := make(chan common.SignatureData, len(signPIDs))
+
+		for i := range signPIDs {
+			params := tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+			P := newParty(params, keys[i], outCh, endCh).(*LocalParty)
+			parties = append(parties, P)
+			go func(P *LocalParty) {
+				if err := P.Start(); err != nil {
+					errCh <- err
+				}
+			}(P)
+		}
+
+		var ended int
+		for ended < len(signPIDs) {
+			select {
+			case err := <-errCh:
+				assert.FailNow(t, err.Error())
+			case msg := <-outCh:
+				if dest := msg.GetTo(); dest == nil {
+					for _, P := range parties {
+						if P.PartyID().Index != msg.GetFrom().Index {
+							go test.SharedPartyUpdater(P, msg, errCh)
+						}
+					}
+				} else {
+					go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+				}
+			case <-endCh:
+				ended++
+			}
+		}
+		return parties[0].data.Signature
+	}
+
+	pk := edwards.PublicKey{
+		Curve: tss.Edwards(),
+		X:     childPk.X,
+		Y:     childPk.Y,
+	}
+	msg := big.NewInt(42)
+	sig, err := edwards.ParseSignature(run(func(params *tss.Parameters, key keygen.LocalPartySaveData, out chan<- tss.Message, end chan<- common.SignatureData) tss.Party {
+		return NewLocalPartyWithKDD(msg, params, key, delta, out, end)
+	}))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, edwards.Verify(&pk, msg.Bytes(), sig.R, sig.S), "eddsa verify must pass under the child key")
+
+	// the exact bytes of a message are signed under the child key as well
+	msgBytes := []byte("\x00a message with a leading zero byte")
+	sigBytes := run(func(params *tss.Parameters, key keygen.LocalPartySaveData, out chan<- tss.Message, end chan<- common.SignatureData) tss.Party {
+		return NewLocalPartyWithBytesAndKDD(msgBytes, params, key, delta, out, end)
+	})
+	assert.True(t, ed25519.Verify(pk.Serialize(), msgBytes, sigBytes), "ed25519 verify must pass under the child key")
+}
+
+func TestE2EBytesMessage(t *testing.T) {
+	setUp("info")
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	pk := edwards.PublicKey{
+		Curve: tss.Edwards(),
+		X:     keys[0].EDDSAPub.X(),
+		Y:     keys[0].EDDSAPub.Y(),
+	}
+	// leading zero bytes would be lost by a *big.Int message
+	for _, msg := range [][]byte{{0x00, 0x00, 0x2a}, {}, make([]byte, 1000)} {
+		p2pCtx := tss.NewPeerContext(signPIDs)
+		parties := make([]*LocalParty, 0, len(signPIDs))
+
+		errCh := make(chan *tss.Error, len(signPIDs))
+		outCh := make(chan tss.Message, len(signPIDs)*len(signPIDs))
+		endCh := make(chan common.SignatureData, len(signPIDs))
+
+		for i := range signPIDs {
+			params := tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+			P := NewLocalPartyWithBytes(msg, params, keys[i], outCh, endCh).(*LocalParty)
+			parties = append(parties, P)
+			go func(P *LocalParty) {
+				if err := P.Start(); err != nil {
+					errCh <- err
+				}
+			}(P)
+		}
+
+		var ended int
+		for ended < len(signPIDs) {
+			select {
+			case err := <-errCh:
+				assert.FailNow(t, err.Error())
+			case m := <-outCh:
+				if dest := m.GetTo(); dest == nil {
+					for _, P := range parties {
+						if P.PartyID().Index != m.GetFrom().Index {
+							go test.SharedPartyUpdater(P, m, errCh)
+						}
+					}
+				} else {
+					go test.SharedPartyUpdater(parties[dest[0].Index], m, errCh)
+				}
+			case <-endCh:
+				ended++
+			}
+		}
+
+		assert.Equal(t, len(msg), len(parties[0].data.M))
+		assert.True(t, bytes.Equal(msg, parties[0].data.M), "the signed message must be kept as is")
+		assert.True(t, ed25519.Verify(pk.Serialize(), msg, parties[0].data.Signature), "ed25519 verify must pass")
+	}
+}
+
+func TestE2EResumeFromSnapshot(t *testing.T) {
+	setUp("info")
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	parties := make([]*LocalParty, 0, len(signPIDs))
+
+	errCh := make(chan *tss.Error, len(signPIDs)*len(signPIDs))
+	outCh := make(chan tss.Message, len(signPIDs)*len(signPIDs))
+	endCh := make(chan common.SignatureData, len(signPIDs))
+
+	msg := big.NewInt(200)
+	newParty := func(i int) *LocalParty {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+		params.SetSessionID([]byte("eddsa-signing-resume"))
+		return NewLocalParty(msg, params, keys[i], outCh, endCh).(*LocalParty)
+	}
+	for i := range signPIDs {
+		P := newParty(i)
+		parties = append(parties, P)
+		if err := P.Start(); err != nil {
+			assert.FailNow(t, err.Error())
+		}
+	}
+
+	snapshotKey, otherKey := make([]byte, 32), make([]byte, 32)
+	otherKey[0] = 1
+	resumes := 0
+	deliver := func(P *LocalParty, msg tss.Message) {
+		if P.PartyID().Index == 0 {
+			// restart P[0] from a snapshot before every message it receives
+			snapshot, err := P.Snapshot(snapshotKey)
+			if !assert.Nil(t, err) {
+				return
+			}
+			err = newParty(0).Resume(otherKey, snapshot)
+			assert.True(t, errors.Is(err, tss.ErrInvalidSnapshot))
+			P = newParty(0)
+			if err := P.Resume(snapshotKey, snapshot); !assert.Nil(t, err) {
+				return
+			}
+			parties[0] = P
+			resumes++
+		}
+		test.SharedPartyUpdater(P, msg, errCh)
+	}
+
+	var ended int
+	for ended < len(signPIDs) {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			if dest := msg.GetTo(); dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index != msg.GetFrom().Index {
+						deliver(P, msg)
+					}
+				}
+			} else {
+				deliver(parties[dest[0].Index], msg)
+			}
+		case <-endCh:
+			ended++
+		}
+	}
+	assert.Equal(t, (len(signPIDs)-1)*3, resumes)
+
+	pk := edwards.PublicKey{
+		Curve: tss.Edwards(),
+		X:     keys[0].EDDSAPub.X(),
+		Y:     keys[0].EDDSAPub.Y(),
+	}
+	sig, err := edwards.ParseSignature(parties[0].data.Signature)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, edwards.Verify(&pk, msg.Bytes(), sig.R, sig.S), "eddsa verify must pass")
+
+	// a finished party has nothing to snapshot
+	_, err2 := parties[0].Snapshot(snapshotKey)
+	assert.True(t, errors.Is(err2, tss.ErrInvalidSnapshot))
+}
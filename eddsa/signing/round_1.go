@@ -7,7 +7,6 @@
 package signing
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/bnb-chain/tss-lib/common"
@@ -25,7 +24,7 @@ func newRound1(params *tss.Parameters, key *keygen.LocalPartySaveData, data *com
 
 func (round *round1) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 
 	round.number = 1
@@ -33,11 +32,11 @@ func (round *round1) Start() *tss.Error {
 	round.resetOK()
 
 	// 1. select ri
-	ri := common.GetRandomPositiveInt(round.Params().EC().Params().N)
+	ri := common.GetRandomPositiveIntWithRand(round.Rand(), round.Params().EC().Params().N)
 
 	// 2. make commitment
 	pointRi := crypto.ScalarBaseMult(round.Params().EC(), ri)
-	cmt := commitments.NewHashCommitment(pointRi.X(), pointRi.Y())
+	cmt := commitments.NewHashCommitmentWithRand(round.Rand(), pointRi.X(), pointRi.Y())
 
 	// 3. store r1 message pieces
 	round.temp.ri = ri
@@ -50,7 +49,9 @@ func (round *round1) Start() *tss.Error {
 	// 4. broadcast commitment
 	r1msg2 := NewSignRound1Message(round.PartyID(), cmt.C)
 	round.temp.signRound1Messages[i] = r1msg2
-	round.out <- r1msg2
+	if err := tss.SendMessage(round.Params(), round.out, r1msg2); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
 
 	return nil
 }
@@ -89,8 +90,17 @@ func (round *round1) prepare() error {
 	xi := round.key.Xi
 	ks := round.key.Ks
 
+	if round.temp.keyDerivationDelta != nil {
+		// adding the key derivation delta to the xi's
+		// Suppose x has shamir shares x_0,     x_1,     ..., x_n
+		// So x + D has shamir shares  x_0 + D, x_1 + D, ..., x_n + D
+		mod := common.ModInt(round.Params().EC().Params().N)
+		xi = mod.Add(round.temp.keyDerivationDelta, xi)
+		round.key.Xi = xi
+	}
+
 	if round.Threshold()+1 > len(ks) {
-		return fmt.Errorf("t+1=%d is not satisfied by the key count of %d", round.Threshold()+1, len(ks))
+		return fmt.Errorf("%w: t+1=%d is not satisfied by the key count of %d", tss.ErrInternal, round.Threshold()+1, len(ks))
 	}
 	wi := PrepareForSigning(round.Params().EC(), i, len(ks), xi, ks)
 
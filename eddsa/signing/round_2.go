@@ -7,9 +7,8 @@
 package signing
 
 import (
-	"errors"
-
-	errors2 "github.com/pkg/errors"
+	"fmt"
+	"time"
 
 	"github.com/bnb-chain/tss-lib/crypto/schnorr"
 	"github.com/bnb-chain/tss-lib/tss"
@@ -17,7 +16,7 @@ import (
 
 func (round *round2) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 	round.number = 2
 	round.started = true
@@ -32,15 +31,19 @@ func (round *round2) Start() *tss.Error {
 	}
 
 	// 2. compute Schnorr prove
-	pir, err := schnorr.NewZKProof(round.temp.ri, round.temp.pointRi)
+	start := time.Now()
+	pir, err := schnorr.NewZKProofWithRand(round.Rand(), round.SessionID(), round.temp.ri, round.temp.pointRi)
+	round.Params().Metrics().RecordProof(tss.ProofSchnorr, tss.ProofGeneration, start)
 	if err != nil {
-		return round.WrapError(errors2.Wrapf(err, "NewZKProof(ri, pointRi)"))
+		return round.WrapError(fmt.Errorf("%w: NewZKProof(ri, pointRi): %v", tss.ErrInternal, err))
 	}
 
 	// 3. BROADCAST de-commitments of Shamir poly*G and Schnorr prove
 	r2msg2 := NewSignRound2Message(round.PartyID(), round.temp.deCommit, pir)
 	round.temp.signRound2Messages[i] = r2msg2
-	round.out <- r2msg2
+	if err := tss.SendMessage(round.Params(), round.out, r2msg2); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
 
 	return nil
 }
@@ -8,9 +8,10 @@ package signing
 
 import (
 	"crypto/sha512"
+	"fmt"
+	"time"
 
 	"github.com/agl/ed25519/edwards25519"
-	"github.com/pkg/errors"
 
 	"github.com/bnb-chain/tss-lib/crypto"
 	"github.com/bnb-chain/tss-lib/crypto/commitments"
@@ -19,7 +20,7 @@ import (
 
 func (round *round3) Start() *tss.Error {
 	if round.started {
-		return round.WrapError(errors.New("round already started"))
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
 	}
 
 	round.number = 3
@@ -43,27 +44,29 @@ func (round *round3) Start() *tss.Error {
 		cmtDeCmt := commitments.HashCommitDecommit{C: round.temp.cjs[j], D: r2msg.UnmarshalDeCommitment()}
 		ok, coordinates := cmtDeCmt.DeCommit()
 		if !ok {
-			return round.WrapError(errors.New("de-commitment verify failed"))
+			return round.WrapError(tss.ErrBadDeCommitment, Pj)
 		}
 		if len(coordinates) != 2 {
-			return round.WrapError(errors.New("length of de-commitment should be 2"))
+			return round.WrapError(fmt.Errorf("%w: length of de-commitment should be 2", tss.ErrBadDeCommitment), Pj)
 		}
 
 		Rj, err := crypto.NewECPoint(round.Params().EC(), coordinates[0], coordinates[1])
-		Rj = Rj.EightInvEight()
 		if err != nil {
-			return round.WrapError(errors.Wrapf(err, "NewECPoint(Rj)"), Pj)
+			return round.WrapError(fmt.Errorf("%w: NewECPoint(Rj): %v", tss.ErrPointNotOnCurve, err), Pj)
 		}
+		Rj = Rj.EightInvEight()
 		proof, err := r2msg.UnmarshalZKProof(round.Params().EC())
 		if err != nil {
-			return round.WrapError(errors.New("failed to unmarshal Rj proof"), Pj)
+			return round.WrapError(fmt.Errorf("%w: failed to unmarshal Rj proof: %v", tss.ErrInvalidMessage, err), Pj)
 		}
-		ok = proof.Verify(Rj)
+		start := time.Now()
+		ok = proof.Verify(round.SessionID(), Rj)
+		round.Params().Metrics().RecordProof(tss.ProofSchnorr, tss.ProofVerification, start)
 		if !ok {
-			return round.WrapError(errors.New("failed to prove Rj"), Pj)
+			return round.WrapError(tss.NewProofError(tss.ProofSchnorr, nil), Pj)
 		}
 
-		extendedRj := ecPointToExtendedElement(round.Params().EC(), Rj.X(), Rj.Y())
+		extendedRj := ecPointToExtendedElement(round.Rand(), round.Params().EC(), Rj.X(), Rj.Y())
 		R = addExtendedElements(R, extendedRj)
 	}
 
@@ -77,7 +80,7 @@ func (round *round3) Start() *tss.Error {
 	h.Reset()
 	h.Write(encodedR[:])
 	h.Write(encodedPubKey[:])
-	h.Write(round.temp.m.Bytes())
+	h.Write(round.temp.m)
 
 	var lambda [64]byte
 	h.Sum(lambda[:0])
@@ -95,7 +98,9 @@ func (round *round3) Start() *tss.Error {
 	// 10. broadcast si to other parties
 	r3msg := NewSignRound3Message(round.PartyID(), encodedBytesToBigInt(&localS))
 	round.temp.signRound3Messages[round.PartyID().Index] = r3msg
-	round.out <- r3msg
+	if err := tss.SendMessage(round.Params(), round.out, r3msg); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
 
 	return nil
 }
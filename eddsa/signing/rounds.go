@@ -97,3 +97,8 @@ func (round *base) resetOK() {
 		round.ok[j] = false
 	}
 }
+
+// getBase is used to take a snapshot of the round a party is in
+func (round *base) getBase() *base {
+	return round
+}
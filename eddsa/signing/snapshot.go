@@ -0,0 +1,139 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bnb-chain/tss-lib/crypto"
+	cmt "github.com/bnb-chain/tss-lib/crypto/commitments"
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+type (
+	// snapshotState is what a snapshot of a signing party holds
+	snapshotState struct {
+		Round    int
+		OK       []bool
+		Keys     keygen.LocalPartySaveData
+		Data     []byte
+		Messages [][][]byte
+		Temp     snapshotTempData
+	}
+
+	// snapshotTempData mirrors localTempData without its message store
+	snapshotTempData struct {
+		M                          []byte
+		Wi, KeyDerivationDelta, Ri *big.Int
+		PointRi                    *crypto.ECPoint
+		DeCommit                   cmt.HashDeCommitment
+		Cjs                        []*big.Int
+		Si                         *[32]byte
+		R                          *big.Int
+	}
+)
+
+// Snapshot exports the state of this party in the middle of a signing run, encrypted with `key` (32 bytes).
+// After a restart, a new party constructed with the same arguments continues the run with Resume.
+func (p *LocalParty) Snapshot(key []byte) ([]byte, *tss.Error) {
+	return tss.BaseSnapshot(p, TaskName, key, p.exportState)
+}
+
+// Resume restores a snapshot taken with Snapshot into this party, which must not have been started.
+// The party then continues from the round it was in; messages that it missed should be delivered to it again.
+func (p *LocalParty) Resume(key, snapshot []byte) *tss.Error {
+	return tss.BaseResume(p, TaskName, key, snapshot, p.restoreState)
+}
+
+func (p *LocalParty) messageStores() []*[]tss.ParsedMessage {
+	return []*[]tss.ParsedMessage{
+		&p.temp.signRound1Messages,
+		&p.temp.signRound2Messages,
+		&p.temp.signRound3Messages,
+	}
+}
+
+func (p *LocalParty) exportState(current tss.Round) (interface{}, error) {
+	rnd, ok := current.(interface{ getBase() *base })
+	if !ok {
+		return nil, errors.New("the party is in an unexpected round")
+	}
+	data, err := proto.Marshal(&p.data)
+	if err != nil {
+		return nil, err
+	}
+	state := &snapshotState{
+		Round: current.RoundNumber(),
+		OK:    rnd.getBase().ok,
+		Keys:  p.keys,
+		Data:  data,
+		Temp: snapshotTempData{
+			Wi:                 p.temp.wi,
+			M:                  p.temp.m,
+			KeyDerivationDelta: p.temp.keyDerivationDelta,
+			Ri:                 p.temp.ri,
+			PointRi:            p.temp.pointRi,
+			DeCommit:           p.temp.deCommit,
+			Cjs:                p.temp.cjs,
+			Si:                 p.temp.si,
+			R:                  p.temp.r,
+		},
+	}
+	for _, store := range p.messageStores() {
+		bzs, err := tss.MarshalMessageStore(*store)
+		if err != nil {
+			return nil, err
+		}
+		state.Messages = append(state.Messages, bzs)
+	}
+	return state, nil
+}
+
+func (p *LocalParty) restoreState(bz []byte) (tss.Round, error) {
+	state := new(snapshotState)
+	if err := json.Unmarshal(bz, state); err != nil {
+		return nil, err
+	}
+	stores := p.messageStores()
+	if len(state.Messages) != len(stores) || len(state.OK) != len(p.params.Parties().IDs()) {
+		return nil, errors.New("the snapshot does not match this party's parameters")
+	}
+	if err := proto.Unmarshal(state.Data, &p.data); err != nil {
+		return nil, err
+	}
+	for i, store := range stores {
+		msgs, err := tss.UnmarshalMessageStore(state.Messages[i], p.params.Parties().IDs())
+		if err != nil {
+			return nil, err
+		}
+		*store = msgs
+	}
+	p.keys = state.Keys
+	p.temp.wi = state.Temp.Wi
+	p.temp.m = state.Temp.M
+	p.temp.keyDerivationDelta = state.Temp.KeyDerivationDelta
+	p.temp.ri = state.Temp.Ri
+	p.temp.pointRi = state.Temp.PointRi
+	p.temp.deCommit = state.Temp.DeCommit
+	p.temp.cjs = state.Temp.Cjs
+	p.temp.si = state.Temp.Si
+	p.temp.r = state.Temp.R
+
+	round := p.FirstRound()
+	rnd := round.(*round1).base
+	for i := 1; i < state.Round; i++ {
+		round = round.NextRound()
+	}
+	rnd.number, rnd.started = state.Round, true
+	copy(rnd.ok, state.OK)
+	return round, nil
+}
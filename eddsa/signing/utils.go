@@ -8,6 +8,7 @@ package signing
 
 import (
 	"crypto/elliptic"
+	"io"
 	"math/big"
 
 	"github.com/agl/ed25519/edwards25519"
@@ -100,11 +101,11 @@ func addExtendedElements(p, q edwards25519.ExtendedGroupElement) edwards25519.Ex
 	return result
 }
 
-func ecPointToExtendedElement(ec elliptic.Curve, x *big.Int, y *big.Int) edwards25519.ExtendedGroupElement {
+func ecPointToExtendedElement(rand io.Reader, ec elliptic.Curve, x *big.Int, y *big.Int) edwards25519.ExtendedGroupElement {
 	encodedXBytes := bigIntToEncodedBytes(x)
 	encodedYBytes := bigIntToEncodedBytes(y)
 
-	z := common.GetRandomPositiveInt(ec.Params().N)
+	z := common.GetRandomPositiveIntWithRand(rand, ec.Params().N)
 	encodedZBytes := bigIntToEncodedBytes(z)
 
 	var fx, fy, fxy edwards25519.FieldElement
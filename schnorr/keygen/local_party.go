@@ -0,0 +1,30 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package keygen
+
+import (
+	"errors"
+
+	cggmpkeygen "github.com/bnb-chain/tss-lib/ecdsa/cggmp/keygen"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// NewLocalParty returns a party that generates secp256k1 key shares for BIP-340 Schnorr signing with schnorr/signing.
+// Unlike ecdsa keygen, it makes no Paillier keys or safe primes, which Schnorr signing does not use: it runs the
+// keygen of the cggmp package. The save data is an ecdsa keygen.LocalPartySaveData, which ECDSA signing needs to give
+// Paillier keys to with the cggmp auxinfo protocol.
+func NewLocalParty(
+	params *tss.Parameters,
+	out chan<- tss.Message,
+	end chan<- keygen.LocalPartySaveData,
+) tss.Party {
+	if name, ok := tss.GetCurveName(params.EC()); !ok || name != tss.Secp256k1 {
+		panic(errors.New("schnorr keygen: BIP-340 keys are only defined over secp256k1"))
+	}
+	return cggmpkeygen.NewLocalParty(params, out, end)
+}
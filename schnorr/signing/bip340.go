@@ -0,0 +1,127 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"crypto/elliptic"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+	"sort"
+
+	"github.com/bnb-chain/tss-lib/crypto"
+)
+
+const (
+	tagChallenge = "BIP0340/challenge"
+	tagTapTweak  = "TapTweak"
+	tagRho       = "TSS/BIP340/rho"
+)
+
+// taggedHash is the tagged hash of BIP-340: SHA256(SHA256(tag) || SHA256(tag) || msgs)
+func taggedHash(tag string, msgs ...[]byte) []byte {
+	tagHash := sha256.Sum256([]byte(tag))
+	h := sha256.New()
+	h.Write(tagHash[:])
+	h.Write(tagHash[:])
+	for _, msg := range msgs {
+		h.Write(msg)
+	}
+	return h.Sum(nil)
+}
+
+// XOnly returns the 32-byte x-only encoding of a point
+func XOnly(p *crypto.ECPoint) []byte {
+	return p.X().FillBytes(make([]byte, 32))
+}
+
+// TaprootOutputKey returns the output key Q = lift_x(P) + tG of BIP-341 for the internal key `pub`, where
+// t = hash_TapTweak(P || merkleRoot). A nil `merkleRoot` commits to no script tree, as in BIP-86.
+// The key of the Taproot output is XOnly(Q).
+func TaprootOutputKey(pub *crypto.ECPoint, merkleRoot []byte) (*crypto.ECPoint, error) {
+	t, err := taprootTweak(pub, merkleRoot)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.ScalarBaseMult(pub.Curve(), t).Add(evenY(pub))
+}
+
+func taprootTweak(pub *crypto.ECPoint, merkleRoot []byte) (*big.Int, error) {
+	t := new(big.Int).SetBytes(taggedHash(tagTapTweak, XOnly(pub), merkleRoot))
+	if t.Cmp(pub.Curve().Params().N) >= 0 {
+		return nil, errors.New("the taproot tweak is not less than the order of the curve")
+	}
+	return t, nil
+}
+
+// challenge returns e = hash_BIP0340/challenge(R || Q || m) mod q, with the x-only R and Q
+func challenge(q *big.Int, R, Q *crypto.ECPoint, msg []byte) *big.Int {
+	return new(big.Int).Mod(new(big.Int).SetBytes(taggedHash(tagChallenge, XOnly(R), XOnly(Q), msg)), q)
+}
+
+// computeBindingFactors returns the binding factor of each party, indexed like `ids`, as in FROST (RFC 9591) with
+// the tagged hashes of BIP-340. The commitment list is encoded in the ascending order of the identifiers.
+func computeBindingFactors(q *big.Int, Q *crypto.ECPoint, ids []*big.Int, hidings, bindings []*crypto.ECPoint, msg []byte) []*big.Int {
+	order := make([]int, len(ids))
+	for j := range order {
+		order[j] = j
+	}
+	sort.Slice(order, func(a, b int) bool {
+		return ids[order[a]].Cmp(ids[order[b]]) < 0
+	})
+	encCommitments := make([]byte, 0, len(ids)*98)
+	for _, j := range order {
+		encCommitments = append(encCommitments, ids[j].FillBytes(make([]byte, 32))...)
+		encCommitments = append(encCommitments, compressed(hidings[j])...)
+		encCommitments = append(encCommitments, compressed(bindings[j])...)
+	}
+	msgHash, commitmentsHash := sha256.Sum256(msg), sha256.Sum256(encCommitments)
+
+	rhos := make([]*big.Int, len(ids))
+	for j, id := range ids {
+		rho := taggedHash(tagRho, XOnly(Q), msgHash[:], commitmentsHash[:], id.FillBytes(make([]byte, 32)))
+		rhos[j] = new(big.Int).Mod(new(big.Int).SetBytes(rho), q)
+	}
+	return rhos
+}
+
+// identifiers returns the identifier of each party, which is its key reduced modulo q
+func identifiers(q *big.Int, ks []*big.Int) []*big.Int {
+	ids := make([]*big.Int, len(ks))
+	for j, kj := range ks {
+		ids[j] = new(big.Int).Mod(kj, q)
+	}
+	return ids
+}
+
+func compressed(p *crypto.ECPoint) []byte {
+	return elliptic.MarshalCompressed(p.Curve(), p.X(), p.Y())
+}
+
+func hasEvenY(p *crypto.ECPoint) bool {
+	return p.Y().Bit(0) == 0
+}
+
+// evenY returns `p` or its negation, whichever has an even y
+func evenY(p *crypto.ECPoint) *crypto.ECPoint {
+	if hasEvenY(p) {
+		return p
+	}
+	return negate(p)
+}
+
+func negate(p *crypto.ECPoint) *crypto.ECPoint {
+	return crypto.NewECPointNoCurveCheck(p.Curve(), p.X(), new(big.Int).Sub(p.Curve().Params().P, p.Y()))
+}
+
+// sign returns 1 if `p` has an even y and -1 otherwise, modulo q
+func sign(q *big.Int, p *crypto.ECPoint) *big.Int {
+	if hasEvenY(p) {
+		return big.NewInt(1)
+	}
+	return new(big.Int).Sub(q, big.NewInt(1))
+}
@@ -0,0 +1,95 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func (round *finalization) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+	round.number = 3
+	round.started = true
+	round.resetOK()
+
+	ec := round.Params().EC()
+	q := ec.Params().N
+	modQ := common.ModInt(q)
+	i := round.PartyID().Index
+
+	// 1. verify the signature share of every other party: zj * G = gR * Rj + e * keyCoef * Wj
+	gR := sign(q, round.temp.bigR)
+	eKeyCoef := modQ.Mul(round.temp.e, round.temp.keyCoef)
+	s := round.temp.zi
+	var multiErr error
+	culprits := make([]*tss.PartyID, 0, len(round.Parties().IDs())) // who caused the error(s)
+	for j, Pj := range round.Parties().IDs() {
+		round.ok[j] = true
+		if j == i {
+			continue
+		}
+		zj := round.temp.signRound2Messages[j].Content().(*SignRound2Message).UnmarshalZ()
+		expected, err := round.temp.bigRs[j].ScalarMult(gR).Add(round.temp.bigWs[j].ScalarMult(eKeyCoef))
+		if err != nil || zj.Cmp(q) >= 0 || !crypto.ScalarBaseMult(ec, zj).Equals(expected) {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("%w: signature share failed to verify", tss.ErrBadShare))
+			culprits = append(culprits, Pj)
+			continue
+		}
+		s = modQ.Add(s, zj)
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(multiErr, culprits...)
+	}
+
+	// 2. s = sum(zj) + e * tweak
+	s = modQ.Add(s, modQ.Mul(round.temp.e, round.temp.tweak))
+
+	// 3. save the signature (R, s) for final output
+	r := XOnly(round.temp.bigR)
+	round.data.R = r
+	round.data.S = s.FillBytes(make([]byte, 32))
+	round.data.Signature = append(r, round.data.S...)
+	round.data.M = round.temp.m
+
+	sig, err := schnorr.ParseSignature(round.data.Signature)
+	if err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInconsistentResult, err))
+	}
+	pk, err := schnorr.ParsePubKey(XOnly(round.temp.bigQ))
+	if err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInconsistentResult, err))
+	}
+	if !sig.Verify(round.temp.m, pk) {
+		return round.WrapError(fmt.Errorf("%w: signature verification failed", tss.ErrInconsistentResult))
+	}
+	round.end <- *round.data
+
+	return nil
+}
+
+func (round *finalization) CanAccept(msg tss.ParsedMessage) bool {
+	// not expecting any incoming messages in this round
+	return false
+}
+
+func (round *finalization) Update() (bool, *tss.Error) {
+	// not expecting any incoming messages in this round
+	return false, nil
+}
+
+func (round *finalization) NextRound() tss.Round {
+	return nil // finished!
+}
@@ -0,0 +1,180 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// Implements Party
+// Implements Stringer
+var _ tss.Party = (*LocalParty)(nil)
+var _ fmt.Stringer = (*LocalParty)(nil)
+
+type (
+	LocalParty struct {
+		*tss.BaseParty
+		params *tss.Parameters
+
+		keys keygen.LocalPartySaveData
+		temp localTempData
+		data common.SignatureData
+
+		// outbound messaging
+		out chan<- tss.Message
+		end chan<- common.SignatureData
+	}
+
+	localMessageStore struct {
+		signRound1Messages,
+		signRound2Messages []tss.ParsedMessage
+	}
+
+	localTempData struct {
+		localMessageStore
+
+		// temp data (thrown away after sign) / round 1
+		m          []byte
+		taproot    bool
+		merkleRoot []byte
+		wi         *big.Int
+		bigWs      []*crypto.ECPoint
+		di, ei     *big.Int
+
+		// the key Q that the signature verifies under, and the factors that the shares of its secret and the
+		// tweak take in the signature: Q = keyCoef * P + tweak * G, with Q of even y
+		bigQ    *crypto.ECPoint
+		keyCoef *big.Int
+		tweak   *big.Int
+
+		// round 2
+		rhos  []*big.Int
+		bigRs []*crypto.ECPoint
+		bigR  *crypto.ECPoint
+		e     *big.Int
+		zi    *big.Int
+	}
+)
+
+// NewLocalParty returns a party that signs the 32-byte `msg` with BIP-340 Schnorr under the x-only form of the public
+// key of `key`.
+// The key shares may come from ecdsa keygen or from the schnorr keygen, on secp256k1.
+func NewLocalParty(
+	msg []byte,
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	out chan<- tss.Message,
+	end chan<- common.SignatureData,
+) tss.Party {
+	partyCount := len(params.Parties().IDs())
+	p := &LocalParty{
+		BaseParty: tss.NewBaseParty(params),
+		params:    params,
+		keys:      keygen.BuildLocalSaveDataSubset(key, params.Parties().IDs()),
+		temp:      localTempData{},
+		data:      common.SignatureData{},
+		out:       out,
+		end:       end,
+	}
+	// msgs init
+	p.temp.signRound1Messages = make([]tss.ParsedMessage, partyCount)
+	p.temp.signRound2Messages = make([]tss.ParsedMessage, partyCount)
+	// temp data init
+	p.temp.m = msg
+	p.temp.rhos = make([]*big.Int, partyCount)
+	p.temp.bigRs = make([]*crypto.ECPoint, partyCount)
+	return p
+}
+
+// NewLocalPartyWithTaprootTweak returns a party that signs `msg` for a key-path spend of a Taproot output, under the
+// output key TaprootOutputKey(key.ECDSAPub, merkleRoot) of BIP-341. A nil `merkleRoot` signs for an output with no
+// script tree, as in BIP-86.
+func NewLocalPartyWithTaprootTweak(
+	msg []byte,
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	merkleRoot []byte,
+	out chan<- tss.Message,
+	end chan<- common.SignatureData,
+) tss.Party {
+	p := NewLocalParty(msg, params, key, out, end).(*LocalParty)
+	p.temp.taproot = true
+	p.temp.merkleRoot = merkleRoot
+	return p
+}
+
+func (p *LocalParty) FirstRound() tss.Round {
+	return newRound1(p.params, &p.keys, &p.data, &p.temp, p.out, p.end)
+}
+
+func (p *LocalParty) Start() *tss.Error {
+	return tss.BaseStart(p, TaskName)
+}
+
+func (p *LocalParty) StartWithContext(ctx context.Context) *tss.Error {
+	return tss.BaseStartWithContext(ctx, p, TaskName)
+}
+
+func (p *LocalParty) Update(msg tss.ParsedMessage) (ok bool, err *tss.Error) {
+	return tss.BaseUpdate(p, msg, TaskName)
+}
+
+func (p *LocalParty) UpdateFromBytes(wireBytes []byte, from *tss.PartyID, isBroadcast bool) (bool, *tss.Error) {
+	msg, err := p.ParseWireMessage(wireBytes, from, isBroadcast)
+	if err != nil {
+		return false, err
+	}
+	return p.Update(msg)
+}
+
+func (p *LocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
+	if msg.GetFrom() == nil || !msg.GetFrom().ValidateBasic() {
+		return false, p.WrapError(fmt.Errorf("%w: received msg with an invalid sender: %s", tss.ErrInvalidMessage, msg))
+	}
+	// check that the message's "from index" will fit into the array
+	if maxFromIdx := len(p.params.Parties().IDs()) - 1; maxFromIdx < msg.GetFrom().Index {
+		return false, p.WrapError(fmt.Errorf("%w: received msg with a sender index too great (%d <= %d)", tss.ErrInvalidMessage,
+			maxFromIdx, msg.GetFrom().Index), msg.GetFrom())
+	}
+	return p.BaseParty.ValidateMessage(msg)
+}
+
+func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
+	// ValidateBasic is cheap; double-check the message here in case the public StoreMessage was called externally
+	if ok, err := p.ValidateMessage(msg); !ok || err != nil {
+		return ok, err
+	}
+
+	// switch/case is necessary to store any messages beyond current round
+	// a sender may deliver each message only once; identical re-deliveries are ignored. spoofing protection is left to the caller.
+	switch msg.Content().(type) {
+	case *SignRound1Message:
+		return tss.StoreMessageOnce(p, p.temp.signRound1Messages, msg)
+
+	case *SignRound2Message:
+		return tss.StoreMessageOnce(p, p.temp.signRound2Messages, msg)
+
+	default: // unrecognised message, just ignore!
+		p.params.Logger().Warningf("unrecognised message ignored: %s", msg.Type())
+		return false, nil
+	}
+}
+
+func (p *LocalParty) PartyID() *tss.PartyID {
+	return p.params.PartyID()
+}
+
+func (p *LocalParty) String() string {
+	return fmt.Sprintf("id: %s, %s", p.PartyID(), p.BaseParty.String())
+}
@@ -0,0 +1,175 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	schnorrkeygen "github.com/bnb-chain/tss-lib/schnorr/keygen"
+	"github.com/bnb-chain/tss-lib/test"
+	"github.com/bnb-chain/tss-lib/test/adversary"
+	"github.com/bnb-chain/tss-lib/test/netsim"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+const (
+	testParticipants = test.TestParticipants
+	testThreshold    = test.TestThreshold
+)
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+}
+
+// runSigning runs the signing protocol with `newParty` and returns the signature of each party
+func runSigning(t *testing.T, pIDs tss.SortedPartyIDs, newParty func(params *tss.Parameters, i int, out chan<- tss.Message, end chan<- common.SignatureData) tss.Party) []*common.SignatureData {
+	p2pCtx := tss.NewPeerContext(pIDs)
+	outCh := make(chan tss.Message, len(pIDs)*len(pIDs))
+	endCh := make(chan common.SignatureData, len(pIDs))
+
+	parties := make([]*LocalParty, 0, len(pIDs))
+	net := netsim.New(1)
+	for i, pID := range pIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(pIDs), testThreshold)
+		P := newParty(params, i, outCh, endCh).(*LocalParty)
+		parties = append(parties, P)
+		assert.NoError(t, net.Register(P))
+	}
+	results, err := net.Run(context.Background(), outCh, time.Second)
+	assert.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, netsim.Finished, r.Outcome, r.String())
+	}
+	assert.Len(t, endCh, len(pIDs))
+
+	sigs := make([]*common.SignatureData, len(parties))
+	for i, P := range parties {
+		sigs[i] = &P.data
+	}
+	return sigs
+}
+
+// verify checks the signatures of every party with btcec against the x-only `pub`
+func verify(t *testing.T, sigs []*common.SignatureData, msg []byte, pub *crypto.ECPoint) {
+	pk, err := schnorr.ParsePubKey(XOnly(pub))
+	assert.NoError(t, err)
+	for _, sigData := range sigs {
+		assert.Equal(t, msg, sigData.M)
+		assert.Len(t, sigData.Signature, 64)
+		assert.Equal(t, sigs[0].Signature, sigData.Signature, "all parties should make the same signature")
+		sig, err := schnorr.ParseSignature(sigData.Signature)
+		if assert.NoError(t, err) {
+			assert.True(t, sig.Verify(msg, pk), "BIP-340 verify must pass")
+		}
+	}
+}
+
+func TestE2E(t *testing.T) {
+	setUp("info")
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+
+	msg := sha256.Sum256([]byte("bip-340"))
+	sigs := runSigning(t, signPIDs, func(params *tss.Parameters, i int, out chan<- tss.Message, end chan<- common.SignatureData) tss.Party {
+		return NewLocalParty(msg[:], params, keys[i], out, end)
+	})
+	verify(t, sigs, msg[:], keys[0].ECDSAPub)
+}
+
+func TestE2ETaprootTweak(t *testing.T) {
+	setUp("info")
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	internalKey, err := btcec.ParsePubKey(compressed(keys[0].ECDSAPub))
+	assert.NoError(t, err)
+
+	msg := sha256.Sum256([]byte("key-path spend"))
+	scriptRoot := sha256.Sum256([]byte("script tree"))
+	for _, merkleRoot := range [][]byte{nil, scriptRoot[:]} {
+		Q, err := TaprootOutputKey(keys[0].ECDSAPub, merkleRoot)
+		assert.NoError(t, err)
+		assert.Equal(t, schnorr.SerializePubKey(txscript.ComputeTaprootOutputKey(internalKey, merkleRoot)), XOnly(Q),
+			"the output key should match that of btcd")
+
+		sigs := runSigning(t, signPIDs, func(params *tss.Parameters, i int, out chan<- tss.Message, end chan<- common.SignatureData) tss.Party {
+			return NewLocalPartyWithTaprootTweak(msg[:], params, keys[i], merkleRoot, out, end)
+		})
+		verify(t, sigs, msg[:], Q)
+	}
+}
+
+func TestE2EWithSchnorrKeygen(t *testing.T) {
+	setUp("info")
+
+	pIDs := tss.GenerateTestPartyIDs(testParticipants)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	outCh := make(chan tss.Message, len(pIDs)*len(pIDs))
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+
+	net := netsim.New(1)
+	for _, pID := range pIDs {
+		params := tss.NewParameters(tss.S256(), p2pCtx, pID, len(pIDs), testThreshold)
+		assert.NoError(t, net.Register(schnorrkeygen.NewLocalParty(params, outCh, endCh)))
+	}
+	_, err := net.Run(context.Background(), outCh, time.Second)
+	assert.NoError(t, err)
+	keys := make([]keygen.LocalPartySaveData, len(pIDs))
+	for range pIDs {
+		key := <-endCh
+		index, err := key.OriginalIndex()
+		assert.NoError(t, err)
+		keys[index] = key
+	}
+
+	// any t+1 of the parties can sign
+	signers := make(tss.UnSortedPartyIDs, 0, testThreshold+1)
+	for _, pID := range pIDs[1 : testThreshold+2] {
+		signers = append(signers, tss.NewPartyID(pID.Id, pID.Moniker, pID.KeyInt()))
+	}
+	signPIDs := tss.SortPartyIDs(signers)
+	msg := sha256.Sum256([]byte("schnorr keygen"))
+	sigs := runSigning(t, signPIDs, func(params *tss.Parameters, i int, out chan<- tss.Message, end chan<- common.SignatureData) tss.Party {
+		return NewLocalParty(msg[:], params, keys[i+1], out, end)
+	})
+	verify(t, sigs, msg[:], keys[0].ECDSAPub)
+}
+
+func TestE2ECheater(t *testing.T) {
+	setUp("info")
+
+	keys, signPIDs, err := keygen.LoadKeygenTestFixturesRandomSet(testThreshold+1, testParticipants)
+	assert.NoError(t, err, "should load keygen fixtures")
+	p2pCtx := tss.NewPeerContext(signPIDs)
+	endCh := make(chan common.SignatureData, len(signPIDs))
+	newParams := func(i int) *tss.Parameters {
+		return tss.NewParameters(tss.S256(), p2pCtx, signPIDs[i], len(signPIDs), testThreshold)
+	}
+
+	// the cheater sends a signature share that does not match its commitments
+	msg := sha256.Sum256([]byte("cheater"))
+	results, err := adversary.Run(len(signPIDs), 0, newParams, func(i int, params *tss.Parameters, out chan<- tss.Message) tss.Party {
+		return NewLocalParty(msg[:], params, keys[i], out, endCh)
+	}, adversary.Corruption{MessageType: adversary.MessageType(&SignRound2Message{}), Corrupt: adversary.TamperField("z")})
+	assert.NoError(t, err)
+	assert.NoError(t, adversary.CheckBlamed(results, signPIDs[0], tss.ErrBadShare))
+}
@@ -0,0 +1,110 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/ecdsa/keygen"
+	"github.com/bnb-chain/tss-lib/ecdsa/signing"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// round 1 represents round 1 of the BIP-340 Schnorr signing protocol, in which the parties commit to their nonces
+func newRound1(params *tss.Parameters, key *keygen.LocalPartySaveData, data *common.SignatureData, temp *localTempData, out chan<- tss.Message, end chan<- common.SignatureData) tss.Round {
+	return &round1{
+		&base{params, key, data, temp, out, end, make([]bool, len(params.Parties().IDs())), false, 1}}
+}
+
+func (round *round1) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+
+	round.number = 1
+	round.started = true
+	round.resetOK()
+
+	ec := round.Params().EC()
+	if name, ok := tss.GetCurveName(ec); !ok || name != tss.Secp256k1 {
+		return round.WrapError(fmt.Errorf("%w: BIP-340 signatures are only defined over secp256k1", tss.ErrInternal))
+	}
+	if len(round.temp.m) != 32 {
+		return round.WrapError(fmt.Errorf("%w: the message should be a 32-byte hash, such as a Taproot sighash", tss.ErrInternal))
+	}
+	q := ec.Params().N
+	modQ := common.ModInt(q)
+
+	// 1. compute the key Q that the signature verifies under
+	P := round.key.ECDSAPub
+	tweak := big.NewInt(0)
+	if round.temp.taproot {
+		t, err := taprootTweak(P, round.temp.merkleRoot)
+		if err != nil {
+			return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+		}
+		tweak = t
+	}
+	// Q = lift_x(P) + tG; the secret of Q is its even-y form, so its shares and the tweak are negated if it has an odd y
+	Q := evenY(P)
+	if tweak.Sign() != 0 {
+		var err error
+		if Q, err = Q.Add(crypto.ScalarBaseMult(ec, tweak)); err != nil {
+			return round.WrapError(fmt.Errorf("%w: the tweaked key is the point at infinity", tss.ErrInternal))
+		}
+	}
+	gQ := sign(q, Q)
+	round.temp.bigQ = evenY(Q)
+	round.temp.keyCoef = modQ.Mul(gQ, sign(q, P))
+	round.temp.tweak = modQ.Mul(gQ, tweak)
+
+	// 2. compute the additive share wi of the secret, and Wj of every party
+	i := round.PartyID().Index
+	round.temp.wi, round.temp.bigWs = signing.PrepareForSigning(ec, i, len(round.key.Ks), round.key.Xi, round.key.Ks, round.key.BigXj)
+
+	// 3. select the hiding and binding nonces di, ei
+	round.temp.di = common.GetRandomPositiveIntWithRand(round.Rand(), q)
+	round.temp.ei = common.GetRandomPositiveIntWithRand(round.Rand(), q)
+
+	// 4. broadcast the commitments Di = di * G, Ei = ei * G
+	round.ok[i] = true
+	r1msg := NewSignRound1Message(round.PartyID(), crypto.ScalarBaseMult(ec, round.temp.di), crypto.ScalarBaseMult(ec, round.temp.ei))
+	round.temp.signRound1Messages[i] = r1msg
+	if err := tss.SendMessage(round.Params(), round.out, r1msg); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
+
+	return nil
+}
+
+func (round *round1) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.signRound1Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round1) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*SignRound1Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round1) NextRound() tss.Round {
+	round.started = false
+	return &round2{round}
+}
@@ -0,0 +1,121 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/bnb-chain/tss-lib/common"
+	"github.com/bnb-chain/tss-lib/crypto"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func (round *round2) Start() *tss.Error {
+	if round.started {
+		return round.WrapError(fmt.Errorf("%w: round already started", tss.ErrInternal))
+	}
+
+	round.number = 2
+	round.started = true
+	round.resetOK()
+
+	ec := round.Params().EC()
+	q := ec.Params().N
+	modQ := common.ModInt(q)
+	i := round.PartyID().Index
+	Ps := round.Parties().IDs()
+
+	// 1. unmarshal the commitments of every party
+	hidings, bindings := make([]*crypto.ECPoint, len(Ps)), make([]*crypto.ECPoint, len(Ps))
+	var multiErr error
+	culprits := make([]*tss.PartyID, 0, len(Ps)) // who caused the error(s)
+	for j, Pj := range Ps {
+		r1msg := round.temp.signRound1Messages[j].Content().(*SignRound1Message)
+		Dj, err := r1msg.UnmarshalHiding(ec)
+		if err != nil {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("%w: hiding commitment: %v", tss.ErrPointNotOnCurve, err))
+			culprits = append(culprits, Pj)
+			continue
+		}
+		Ej, err := r1msg.UnmarshalBinding(ec)
+		if err != nil {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("%w: binding commitment: %v", tss.ErrPointNotOnCurve, err))
+			culprits = append(culprits, Pj)
+			continue
+		}
+		hidings[j], bindings[j] = Dj, Ej
+	}
+	if len(culprits) > 0 {
+		return round.WrapError(multiErr, culprits...)
+	}
+
+	// 2. compute the binding factor and the commitment share Rj = Dj + rhoj * Ej of each party, and R = sum(Rj)
+	ids := identifiers(q, round.key.Ks)
+	round.temp.rhos = computeBindingFactors(q, round.temp.bigQ, ids, hidings, bindings, round.temp.m)
+	var R *crypto.ECPoint
+	for j := range Ps {
+		Rj, err := hidings[j].Add(bindings[j].ScalarMult(round.temp.rhos[j]))
+		if err != nil {
+			return round.WrapError(fmt.Errorf("%w: Dj + rhoj * Ej: %v", tss.ErrInternal, err))
+		}
+		round.temp.bigRs[j] = Rj
+		if R == nil {
+			R = Rj
+			continue
+		}
+		if R, err = R.Add(Rj); err != nil {
+			return round.WrapError(fmt.Errorf("%w: R + Rj: %v", tss.ErrInternal, err))
+		}
+	}
+	round.temp.bigR = R
+
+	// 3. compute the challenge e = hash(R || Q || m)
+	round.temp.e = challenge(q, R, round.temp.bigQ, round.temp.m)
+
+	// 4. compute zi = gR * (di + ei * rhoi) + e * keyCoef * wi, where gR negates the nonce if R has an odd y
+	ki := modQ.Mul(sign(q, R), modQ.Add(round.temp.di, modQ.Mul(round.temp.ei, round.temp.rhos[i])))
+	zi := modQ.Add(ki, modQ.Mul(modQ.Mul(round.temp.e, round.temp.keyCoef), round.temp.wi))
+	round.temp.di, round.temp.ei = nil, nil
+	round.temp.zi = zi
+
+	// 5. broadcast zi to other parties
+	round.ok[i] = true
+	r2msg := NewSignRound2Message(round.PartyID(), zi)
+	round.temp.signRound2Messages[i] = r2msg
+	if err := tss.SendMessage(round.Params(), round.out, r2msg); err != nil {
+		return round.WrapError(fmt.Errorf("%w: %v", tss.ErrInternal, err))
+	}
+
+	return nil
+}
+
+func (round *round2) Update() (bool, *tss.Error) {
+	for j, msg := range round.temp.signRound2Messages {
+		if round.ok[j] {
+			continue
+		}
+		if msg == nil || !round.CanAccept(msg) {
+			return false, nil
+		}
+		round.ok[j] = true
+	}
+	return true, nil
+}
+
+func (round *round2) CanAccept(msg tss.ParsedMessage) bool {
+	if _, ok := msg.Content().(*SignRound2Message); ok {
+		return msg.IsBroadcast()
+	}
+	return false
+}
+
+func (round *round2) NextRound() tss.Round {
+	round.started = false
+	return &finalization{round}
+}
@@ -0,0 +1,148 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Package adversary turns a party into a Byzantine one that corrupts chosen fields of its outgoing messages,
+// to test that the honest parties identify it as the culprit.
+package adversary
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+type (
+	// Corruption alters the matching outgoing messages of a malicious party
+	Corruption struct {
+		// the content type of the messages to corrupt, e.g. "binance.tsslib.ecdsa.keygen.KGRound2Message1"; see MessageType
+		MessageType string
+		// restricts the corruption to the messages sent to these parties; broadcasts are corrupted for every recipient
+		To []*tss.PartyID
+		// alters a copy of the content of each matching message
+		Corrupt func(content tss.MessageContent)
+	}
+
+	// Party is a party whose outgoing messages are corrupted before they reach the transport.
+	// It is used in place of the party it wraps, e.g. registered with a router or a netsim.Network.
+	Party struct {
+		tss.Party
+		params      *tss.Parameters
+		corruptions []Corruption
+	}
+)
+
+var _ tss.Party = (*Party)(nil)
+
+// NewParty makes a malicious party from the party returned by `newParty`, which must build it with `params` and the out channel it is given.
+// The messages of the party reach `out` once corrupted; they are still bound to the session of `params`, signed with its identity key
+// and encrypted, so that the honest parties reject them for their content only.
+func NewParty(params *tss.Parameters, out chan<- tss.Message, newParty func(out chan<- tss.Message) tss.Party, corruptions ...Corruption) *Party {
+	in := make(chan tss.Message)
+	p := &Party{Party: newParty(in), params: params, corruptions: corruptions}
+	go p.relay(in, out)
+	return p
+}
+
+// MessageType returns the type of the messages with `content`, to use in a Corruption
+func MessageType(content tss.MessageContent) string {
+	return string(proto.MessageName(content))
+}
+
+// TamperField returns a corruption that adds one to the field at `path` of the content, read as a big-endian integer.
+// The path is made of proto field names separated by dots, e.g. "share" or "dlnproof_1.alpha"; for repeated fields the first element is altered.
+func TamperField(path string) func(content tss.MessageContent) {
+	return func(content tss.MessageContent) {
+		msg := content.ProtoReflect()
+		names := strings.Split(path, ".")
+		for i, name := range names {
+			fd := msg.Descriptor().Fields().ByName(protoreflect.Name(name))
+			if fd == nil {
+				panic(fmt.Errorf("adversary: %s has no field %s", msg.Descriptor().FullName(), name))
+			}
+			if i < len(names)-1 {
+				msg = msg.Mutable(fd).Message()
+				continue
+			}
+			switch {
+			case fd.Kind() != protoreflect.BytesKind:
+				panic(fmt.Errorf("adversary: field %s is not a bytes field", fd.FullName()))
+			case fd.IsList():
+				list := msg.Mutable(fd).List()
+				if list.Len() == 0 {
+					panic(fmt.Errorf("adversary: field %s is empty", fd.FullName()))
+				}
+				list.Set(0, protoreflect.ValueOfBytes(increment(list.Get(0).Bytes())))
+			default:
+				msg.Set(fd, protoreflect.ValueOfBytes(increment(msg.Get(fd).Bytes())))
+			}
+		}
+	}
+}
+
+// BlamesOnly reports whether `err` names `cheater`, and no other party, as its culprit
+func BlamesOnly(err *tss.Error, cheater *tss.PartyID) bool {
+	if err == nil || len(err.Culprits()) != 1 {
+		return false
+	}
+	return err.Culprits()[0].KeyInt().Cmp(cheater.KeyInt()) == 0
+}
+
+// ----- //
+
+func increment(bz []byte) []byte {
+	return new(big.Int).Add(new(big.Int).SetBytes(bz), big.NewInt(1)).Bytes()
+}
+
+// relay corrupts the messages sent by the wrapped party and forwards them to `out`
+func (p *Party) relay(in <-chan tss.Message, out chan<- tss.Message) {
+	for msg := range in {
+		parsed, ok := msg.(tss.ParsedMessage)
+		if !ok {
+			out <- msg
+			continue
+		}
+		var corrupt []func(tss.MessageContent)
+		for _, c := range p.corruptions {
+			if c.MessageType == parsed.Type() && (len(c.To) == 0 || parsed.IsBroadcast() || isTo(parsed, c.To)) {
+				corrupt = append(corrupt, c.Corrupt)
+			}
+		}
+		if len(corrupt) == 0 {
+			out <- msg
+			continue
+		}
+		content := proto.Clone(parsed.Content()).(tss.MessageContent)
+		for _, fn := range corrupt {
+			fn(content)
+		}
+		routing := tss.MessageRouting{
+			From:                    parsed.GetFrom(),
+			To:                      parsed.GetTo(),
+			IsBroadcast:             parsed.IsBroadcast(),
+			IsToOldCommittee:        parsed.IsToOldCommittee(),
+			IsToOldAndNewCommittees: parsed.IsToOldAndNewCommittees(),
+		}
+		if err := tss.SendMessage(p.params, out, tss.NewMessage(routing, content, tss.NewMessageWrapper(routing, content))); err != nil {
+			p.params.Logger().Errorf("adversary could not send the corrupted %s: %v", parsed.Type(), err)
+		}
+	}
+}
+
+func isTo(msg tss.Message, to []*tss.PartyID) bool {
+	for _, dest := range msg.GetTo() {
+		for _, pID := range to {
+			if dest.KeyInt().Cmp(pID.KeyInt()) == 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package adversary
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bnb-chain/tss-lib/test/netsim"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// Run runs `n` parties on a netsim network, the party at index `cheater` being made malicious with `corruptions`.
+// The party at index i is built by `newParty` with the parameters returned by `newParams` for it and the out channel it is given.
+// It returns the results of the honest parties, in the order of their indexes.
+func Run(
+	n, cheater int,
+	newParams func(i int) *tss.Parameters,
+	newParty func(i int, params *tss.Parameters, out chan<- tss.Message) tss.Party,
+	corruptions ...Corruption,
+) ([]netsim.Result, error) {
+	outCh := make(chan tss.Message, n*n)
+	net := netsim.New(1)
+	for i := 0; i < n; i++ {
+		i, params := i, newParams(i)
+		var P tss.Party
+		if i == cheater {
+			P = NewParty(params, outCh, func(out chan<- tss.Message) tss.Party {
+				return newParty(i, params, out)
+			}, corruptions...)
+		} else {
+			P = newParty(i, params, outCh)
+		}
+		if err := net.Register(P); err != nil {
+			return nil, err
+		}
+	}
+	results, err := net.Run(context.Background(), outCh, time.Second)
+	if err != nil {
+		return nil, err
+	}
+	return append(results[:cheater:cheater], results[cheater+1:]...), nil
+}
+
+// CheckBlamed returns an error unless every result is that of a party that failed for `cause`, blaming `cheater` alone
+func CheckBlamed(results []netsim.Result, cheater *tss.PartyID, cause error) error {
+	if len(results) == 0 {
+		return errors.New("the cheating was not detected")
+	}
+	for _, r := range results {
+		switch {
+		case r.Outcome != netsim.Errored:
+			return fmt.Errorf("honest parties should fail: %s", r)
+		case !BlamesOnly(r.Err, cheater):
+			return fmt.Errorf("honest parties should blame the cheater alone: %s", r)
+		case !errors.Is(r.Err, cause):
+			return fmt.Errorf("unexpected cause: %s", r)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,452 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Package netsim runs parties of any protocol over a simulated network that drops, delays, reorders, duplicates and partitions
+// their messages, for use in tests.
+package netsim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/bnb-chain/tss-lib/tss"
+	"github.com/bnb-chain/tss-lib/tss/transport"
+)
+
+const (
+	// DefaultStallTimeout is how long the network must be idle, with unfinished parties, before they are reported as stalled
+	DefaultStallTimeout = 2 * time.Second
+
+	// how often Run checks whether the network is idle
+	idleCheckInterval = 10 * time.Millisecond
+)
+
+const (
+	// Finished is the outcome of a party that completed the protocol
+	Finished Outcome = iota + 1
+	// Errored is the outcome of a party that did not complete the protocol and failed with an error
+	Errored
+	// Stalled is the outcome of a party that did not complete the protocol nor fail, e.g. waiting for dropped messages
+	Stalled
+)
+
+type (
+	// Policy describes the faults injected into the messages on a link from one party to another.
+	// Each fault is decided independently for each message; the zero Policy delivers every message once and immediately.
+	Policy struct {
+		// probability that a message is lost
+		Drop float64
+		// probability that a message is delivered twice
+		Duplicate float64
+		// probability that a message is held back until the next message on the link is delivered or the network is idle
+		Reorder float64
+		// each delivery is delayed by a random duration between MinDelay and MaxDelay
+		MinDelay, MaxDelay time.Duration
+	}
+
+	// Outcome is what became of a party at the end of a run
+	Outcome int
+
+	// Result reports the outcome of a party at the end of a run
+	Result struct {
+		Party   *tss.PartyID
+		Outcome Outcome
+		// the first error returned by the party, if any; a finished party may have rejected some messages
+		Err *tss.Error
+		// the parties blamed by Err
+		Culprits []*tss.PartyID
+		// the parties that a stalled party was waiting for
+		WaitingFor []*tss.PartyID
+	}
+
+	// Network delivers the messages of the parties added to it through links with fault policies.
+	// It implements transport.Router, so it understands the committees of re-sharing.
+	Network struct {
+		mtx           sync.Mutex
+		rnd           *rand.Rand
+		defaultPolicy Policy
+		policies      map[link]Policy
+		groups        map[string]int // the partition of each party, when partitioned
+		members       []*member
+		byKey         map[string]*member
+		held          map[link][]delivery
+		inFlight      int
+		lastActivity  time.Time
+		closed        bool
+		wg            sync.WaitGroup
+	}
+
+	member struct {
+		party     tss.Party
+		committee transport.Committee
+		err       *tss.Error
+	}
+
+	link struct {
+		from, to string
+	}
+
+	delivery struct {
+		to          *member
+		bz          []byte
+		from        *tss.PartyID
+		isBroadcast bool
+	}
+)
+
+var _ transport.Router = (*Network)(nil)
+
+// New returns a network without faults whose random decisions are drawn from `seed`.
+// The order in which concurrent parties send their messages varies, so a seed does not make a run fully reproducible.
+func New(seed int64) *Network {
+	return &Network{
+		rnd:      rand.New(rand.NewSource(seed)),
+		policies: make(map[link]Policy),
+		byKey:    make(map[string]*member),
+		held:     make(map[link][]delivery),
+	}
+}
+
+// SetDefaultPolicy sets the policy of the links that have no policy of their own
+func (n *Network) SetDefaultPolicy(policy Policy) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	n.defaultPolicy = policy
+}
+
+// SetPolicy sets the policy of the link from party `from` to party `to`
+func (n *Network) SetPolicy(from, to *tss.PartyID, policy Policy) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	n.policies[link{key(from), key(to)}] = policy
+}
+
+// Partition splits the network into `groups`; messages between parties of different groups are dropped.
+// The parties that are not listed form one more group.
+func (n *Network) Partition(groups ...[]*tss.PartyID) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	n.groups = make(map[string]int)
+	for i, group := range groups {
+		for _, pID := range group {
+			n.groups[key(pID)] = i + 1
+		}
+	}
+}
+
+// Heal removes the partition of the network; the messages dropped meanwhile are not delivered
+func (n *Network) Heal() {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	n.groups = nil
+}
+
+// Register adds a party of the new committee, or a keygen or signing party, to the network
+func (n *Network) Register(party tss.Party) error {
+	return n.add(party, transport.NewCommittee)
+}
+
+// RegisterOldCommittee adds a party of the old committee of a re-sharing to the network
+func (n *Network) RegisterOldCommittee(party tss.Party) error {
+	return n.add(party, transport.OldCommittee)
+}
+
+// Route sends a message to each of its recipients through the links from its sender
+func (n *Network) Route(msg tss.Message) error {
+	bz, routing, err := msg.WireBytes()
+	if err != nil {
+		return err
+	}
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	if n.closed {
+		return transport.ErrClosed
+	}
+	n.lastActivity = time.Now()
+	from := key(routing.From)
+	for _, m := range n.members {
+		if !transport.IsRecipient(routing, m.party.PartyID(), m.committee) {
+			continue
+		}
+		l := link{from, key(m.party.PartyID())}
+		if n.groups != nil && n.groups[l.from] != n.groups[l.to] {
+			continue
+		}
+		policy, ok := n.policies[l]
+		if !ok {
+			policy = n.defaultPolicy
+		}
+		if n.rnd.Float64() < policy.Drop {
+			continue
+		}
+		d := delivery{to: m, bz: bz, from: routing.From, isBroadcast: routing.IsBroadcast}
+		copies := 1
+		if n.rnd.Float64() < policy.Duplicate {
+			copies = 2
+		}
+		for i := 0; i < copies; i++ {
+			if n.rnd.Float64() < policy.Reorder {
+				n.held[l] = append(n.held[l], d)
+				continue
+			}
+			n.schedule(l, d, n.delay(policy))
+		}
+	}
+	return nil
+}
+
+// Close stops routing messages and waits for the deliveries in flight
+func (n *Network) Close() error {
+	n.mtx.Lock()
+	if n.closed {
+		n.mtx.Unlock()
+		return transport.ErrClosed
+	}
+	n.closed = true
+	n.mtx.Unlock()
+	n.wg.Wait()
+	return nil
+}
+
+// Run starts the parties of the network and routes the messages they send to `out` until every party has finished or failed,
+// the network has been idle for `stallTimeout` (DefaultStallTimeout if 0), or `ctx` is done.
+// It returns the results of the parties in the order they were added, and closes the network.
+func (n *Network) Run(ctx context.Context, out <-chan tss.Message, stallTimeout time.Duration) ([]Result, error) {
+	if stallTimeout <= 0 {
+		stallTimeout = DefaultStallTimeout
+	}
+	// messages are held until every party has started, as a party ignores those delivered before it starts
+	var starting sync.WaitGroup
+	started := make(chan struct{})
+	n.mtx.Lock()
+	n.lastActivity = time.Now()
+	members := append([]*member(nil), n.members...)
+	for _, m := range members {
+		n.inFlight++
+		n.wg.Add(1)
+		starting.Add(1)
+		go func(m *member) {
+			defer n.wg.Done()
+			err := m.party.Start()
+			starting.Done()
+			n.mtx.Lock()
+			defer n.mtx.Unlock()
+			n.record(m, err)
+			n.inFlight--
+			n.lastActivity = time.Now()
+		}(m)
+	}
+	n.mtx.Unlock()
+	go func() {
+		starting.Wait()
+		close(started)
+	}()
+
+	ticker := time.NewTicker(idleCheckInterval)
+	defer ticker.Stop()
+	var (
+		runErr  error
+		pending []tss.Message
+	)
+loop:
+	for {
+		select {
+		case <-ctx.Done():
+			runErr = ctx.Err()
+			break loop
+		case <-started:
+			started = nil
+			for _, msg := range pending {
+				if runErr = n.Route(msg); runErr != nil {
+					break loop
+				}
+			}
+			pending = nil
+		case msg := <-out:
+			if started != nil {
+				pending = append(pending, msg)
+				continue
+			}
+			if err := n.Route(msg); err != nil {
+				runErr = err
+				break loop
+			}
+		case <-ticker.C:
+			if started != nil || len(out) != 0 || !n.idle() {
+				continue
+			}
+			if n.done() {
+				break loop
+			}
+			if n.releaseHeld() {
+				continue
+			}
+			n.mtx.Lock()
+			stalled := stallTimeout <= time.Since(n.lastActivity)
+			n.mtx.Unlock()
+			if stalled {
+				break loop
+			}
+		}
+	}
+	// drain the messages of the deliveries still in flight so that their parties are not blocked sending
+	closed := make(chan struct{})
+	go func() {
+		_ = n.Close()
+		close(closed)
+	}()
+	for {
+		select {
+		case <-out:
+		case <-closed:
+			return n.results(), runErr
+		}
+	}
+}
+
+func (o Outcome) String() string {
+	switch o {
+	case Finished:
+		return "finished"
+	case Errored:
+		return "errored"
+	case Stalled:
+		return "stalled"
+	default:
+		return "unknown"
+	}
+}
+
+func (r Result) String() string {
+	return fmt.Sprintf("party %s %s (err: %v, culprits: %v, waiting for: %v)", r.Party, r.Outcome, r.Err, r.Culprits, r.WaitingFor)
+}
+
+// ----- //
+
+func key(pID *tss.PartyID) string {
+	return string(pID.GetKey())
+}
+
+func (n *Network) add(party tss.Party, committee transport.Committee) error {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	if n.closed {
+		return transport.ErrClosed
+	}
+	k := key(party.PartyID())
+	if _, ok := n.byKey[k]; ok {
+		return errors.New("netsim: a party with the same key is already in the network")
+	}
+	m := &member{party: party, committee: committee}
+	n.members = append(n.members, m)
+	n.byKey[k] = m
+	return nil
+}
+
+// delay draws the delay of a delivery; the lock must be held
+func (n *Network) delay(policy Policy) time.Duration {
+	if policy.MaxDelay <= policy.MinDelay {
+		return policy.MinDelay
+	}
+	return policy.MinDelay + time.Duration(n.rnd.Int63n(int64(policy.MaxDelay-policy.MinDelay)))
+}
+
+// schedule delivers `d` after `delay` and then releases the messages held back on its link; the lock must be held
+func (n *Network) schedule(l link, d delivery, delay time.Duration) {
+	if n.closed {
+		return
+	}
+	n.inFlight++
+	n.wg.Add(1)
+	go func() {
+		defer n.wg.Done()
+		if 0 < delay {
+			time.Sleep(delay)
+		}
+		_, err := d.to.party.UpdateFromBytes(d.bz, d.from, d.isBroadcast)
+		n.mtx.Lock()
+		defer n.mtx.Unlock()
+		n.record(d.to, err)
+		for _, h := range n.held[l] {
+			n.schedule(l, h, 0)
+		}
+		delete(n.held, l)
+		n.inFlight--
+		n.lastActivity = time.Now()
+	}()
+}
+
+// releaseHeld delivers the messages held back on every link, returning whether there were any
+func (n *Network) releaseHeld() bool {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	released := false
+	for l, held := range n.held {
+		for _, d := range held {
+			n.schedule(l, d, 0)
+			released = true
+		}
+		delete(n.held, l)
+	}
+	return released
+}
+
+// record keeps the first error of a party; the lock must be held
+func (n *Network) record(m *member, err *tss.Error) {
+	if err != nil && m.err == nil {
+		m.err = err
+	}
+}
+
+func (n *Network) idle() bool {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	return n.inFlight == 0
+}
+
+// done reports whether every party has finished or failed; it must be called while the network is idle
+func (n *Network) done() bool {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	for _, m := range n.members {
+		if m.party.Running() && m.err == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (n *Network) results() []Result {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	results := make([]Result, 0, len(n.members))
+	for _, m := range n.members {
+		r := Result{Party: m.party.PartyID(), Err: m.err}
+		if m.err != nil {
+			r.Culprits = m.err.Culprits()
+		}
+		switch {
+		case !m.party.Running():
+			r.Outcome = Finished
+		case m.err != nil:
+			r.Outcome = Errored
+		default:
+			r.Outcome = Stalled
+			// a round lists the party itself until it has received its first message
+			for _, pID := range m.party.WaitingFor() {
+				if key(pID) != key(r.Party) {
+					r.WaitingFor = append(r.WaitingFor, pID)
+				}
+			}
+		}
+		results = append(results, r)
+	}
+	return results
+}
@@ -0,0 +1,139 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package netsim_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/eddsa/resharing"
+	"github.com/bnb-chain/tss-lib/test"
+	"github.com/bnb-chain/tss-lib/test/netsim"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+const testStallTimeout = 500 * time.Millisecond
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+
+	// only for test
+	tss.SetCurve(tss.Edwards())
+}
+
+// newKeygenNetwork adds `count` eddsa keygen parties with threshold 1 to a new network
+func newKeygenNetwork(t *testing.T, count int) (*netsim.Network, tss.SortedPartyIDs, chan tss.Message, chan keygen.LocalPartySaveData) {
+	pIDs := tss.GenerateTestPartyIDs(count)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	outCh := make(chan tss.Message, count*count)
+	endCh := make(chan keygen.LocalPartySaveData, count)
+
+	net := netsim.New(1)
+	for _, pID := range pIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pID, count, 1)
+		assert.NoError(t, net.Register(keygen.NewLocalParty(params, outCh, endCh)))
+	}
+	return net, pIDs, outCh, endCh
+}
+
+func TestFaultyLinksKeygen(t *testing.T) {
+	setUp("info")
+
+	net, pIDs, outCh, endCh := newKeygenNetwork(t, 4)
+	net.SetDefaultPolicy(netsim.Policy{Duplicate: 0.3, Reorder: 0.3, MaxDelay: 5 * time.Millisecond})
+
+	results, err := net.Run(context.Background(), outCh, testStallTimeout)
+	assert.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, netsim.Finished, r.Outcome, r.String())
+	}
+
+	assert.Len(t, endCh, len(pIDs))
+	first := <-endCh
+	for i := 1; i < len(pIDs); i++ {
+		save := <-endCh
+		assert.True(t, save.EDDSAPub.Equals(first.EDDSAPub), "all parties should derive the same public key")
+	}
+}
+
+func TestDroppedLinkStallsRecipient(t *testing.T) {
+	setUp("info")
+
+	net, pIDs, outCh, _ := newKeygenNetwork(t, 3)
+	net.SetPolicy(pIDs[0], pIDs[1], netsim.Policy{Drop: 1})
+
+	results, err := net.Run(context.Background(), outCh, testStallTimeout)
+	assert.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, netsim.Stalled, r.Outcome, r.String())
+	}
+	assert.Contains(t, results[1].WaitingFor, pIDs[0], "the recipient of the dropped link should wait for its sender")
+	assert.Contains(t, results[2].WaitingFor, pIDs[1], "the other parties should wait for the stalled recipient")
+}
+
+func TestPartitionStallsParties(t *testing.T) {
+	setUp("info")
+
+	net, pIDs, outCh, _ := newKeygenNetwork(t, 4)
+	net.Partition(pIDs[:2], pIDs[2:])
+
+	results, err := net.Run(context.Background(), outCh, testStallTimeout)
+	assert.NoError(t, err)
+	// a round may also list parties of its own group that it has not checked yet
+	for i, r := range results {
+		assert.Equal(t, netsim.Stalled, r.Outcome, r.String())
+		if i < 2 {
+			assert.Subset(t, r.WaitingFor, pIDs[2:], "parties should wait for the other side of the partition")
+		} else {
+			assert.Subset(t, r.WaitingFor, pIDs[:2], "parties should wait for the other side of the partition")
+		}
+	}
+}
+
+func TestFaultyLinksResharing(t *testing.T) {
+	setUp("info")
+
+	threshold, newThreshold := keygen.TestThreshold, 1
+	oldKeys, oldPIDs, err := keygen.LoadKeygenTestFixtures(threshold + 1)
+	assert.NoError(t, err, "should load keygen fixtures")
+	oldP2PCtx := tss.NewPeerContext(oldPIDs)
+	newPIDs := tss.GenerateTestPartyIDs(3)
+	newP2PCtx := tss.NewPeerContext(newPIDs)
+
+	total := len(oldPIDs) + len(newPIDs)
+	outCh := make(chan tss.Message, total*total)
+	endCh := make(chan keygen.LocalPartySaveData, total)
+
+	net := netsim.New(2)
+	net.SetDefaultPolicy(netsim.Policy{Duplicate: 0.2, Reorder: 0.2, MaxDelay: 2 * time.Millisecond})
+	for j, pID := range oldPIDs {
+		params := tss.NewReSharingParameters(tss.Edwards(), oldP2PCtx, newP2PCtx, pID, test.TestParticipants, threshold, len(newPIDs), newThreshold)
+		assert.NoError(t, net.RegisterOldCommittee(resharing.NewLocalParty(params, oldKeys[j], outCh, endCh)))
+	}
+	for _, pID := range newPIDs {
+		params := tss.NewReSharingParameters(tss.Edwards(), oldP2PCtx, newP2PCtx, pID, test.TestParticipants, threshold, len(newPIDs), newThreshold)
+		assert.NoError(t, net.Register(resharing.NewLocalParty(params, keygen.NewLocalPartySaveData(len(newPIDs)), outCh, endCh)))
+	}
+
+	results, err := net.Run(context.Background(), outCh, testStallTimeout)
+	assert.NoError(t, err)
+	for _, r := range results {
+		assert.Equal(t, netsim.Finished, r.Outcome, r.String())
+	}
+	for len(endCh) > 0 {
+		if save := <-endCh; save.Xi != nil {
+			assert.True(t, save.EDDSAPub.Equals(oldKeys[0].EDDSAPub), "the new committee should share the old public key")
+		}
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package test
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"sync"
+)
+
+// SeededReader is a deterministic source of randomness for reproducing protocol runs in tests.
+// Its output is entirely predictable from the seed: NEVER use it outside of tests.
+type SeededReader struct {
+	mtx     sync.Mutex
+	seed    []byte
+	counter uint64
+	buf     []byte
+}
+
+var _ io.Reader = (*SeededReader)(nil)
+
+// NewSeededReader returns a reader producing the SHA-256 stream of `seed`, to give to tss.Parameters.SetRand in a test.
+// Give each party its own reader: the order in which parties draw from a shared reader depends on scheduling.
+func NewSeededReader(seed []byte) *SeededReader {
+	return &SeededReader{seed: append([]byte(nil), seed...)}
+}
+
+func (r *SeededReader) Read(p []byte) (int, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	for n := 0; n < len(p); {
+		if len(r.buf) == 0 {
+			var ctr [8]byte
+			binary.BigEndian.PutUint64(ctr[:], r.counter)
+			r.counter++
+			block := sha256.Sum256(append(append([]byte(nil), r.seed...), ctr[:]...))
+			r.buf = block[:]
+		}
+		c := copy(p[n:], r.buf)
+		r.buf, n = r.buf[c:], n+c
+	}
+	return len(p), nil
+}
@@ -20,12 +20,7 @@ func SharedPartyUpdater(party tss.Party, msg tss.Message, errCh chan<- *tss.Erro
 		errCh <- party.WrapError(err)
 		return
 	}
-	pMsg, err := tss.ParseWireMessage(bz, msg.GetFrom(), msg.IsBroadcast())
-	if err != nil {
-		errCh <- party.WrapError(err)
-		return
-	}
-	if _, err := party.Update(pMsg); err != nil {
+	if _, err := party.UpdateFromBytes(bz, msg.GetFrom(), msg.IsBroadcast()); err != nil {
 		errCh <- err
 	}
 }
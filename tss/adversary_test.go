@@ -0,0 +1,91 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/test/adversary"
+	"github.com/bnb-chain/tss-lib/test/netsim"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// runKeygenWithCheater runs an EdDSA keygen between `pIDs` with identity keys and p2p encryption, party 0 corrupting its messages
+func runKeygenWithCheater(t *testing.T, pIDs tss.SortedPartyIDs, corruptions ...adversary.Corruption) []netsim.Result {
+	p2pCtx := tss.NewPeerContext(pIDs)
+	keys := generateIdentityKeys(t, pIDs)
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+
+	newParams := func(i int) *tss.Parameters {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[i], len(pIDs), 1)
+		params.SetSessionID([]byte("adversary"))
+		params.SetIdentityKey(keys[i])
+		params.SetP2PEncryption(true)
+		return params
+	}
+	results, err := adversary.Run(len(pIDs), 0, newParams, func(i int, params *tss.Parameters, out chan<- tss.Message) tss.Party {
+		return keygen.NewLocalParty(params, out, endCh)
+	}, corruptions...)
+	assert.NoError(t, err)
+	return results
+}
+
+// a corrupted message is still bound to the session, signed and encrypted by the cheater, so it is blamed for its content
+func TestCheaterIsBlamedThroughSignedEncryptedMessages(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(4)
+	results := runKeygenWithCheater(t, pIDs, adversary.Corruption{
+		MessageType: adversary.MessageType(&keygen.KGRound2Message1{}),
+		Corrupt:     adversary.TamperField("share"),
+	})
+	assert.Len(t, results, len(pIDs)-1)
+	assert.NoError(t, adversary.CheckBlamed(results, pIDs[0], tss.ErrBadShare))
+}
+
+func TestCheaterCorruptsOneRecipient(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(4)
+	results := runKeygenWithCheater(t, pIDs, adversary.Corruption{
+		MessageType: adversary.MessageType(&keygen.KGRound2Message1{}),
+		To:          []*tss.PartyID{pIDs[1]},
+		Corrupt:     adversary.TamperField("share"),
+	})
+
+	// only the recipient of the bad share fails, the others complete the keygen
+	if assert.Len(t, results, len(pIDs)-1) {
+		assert.NoError(t, adversary.CheckBlamed(results[:1], pIDs[0], tss.ErrBadShare))
+		for _, r := range results[1:] {
+			assert.Equal(t, netsim.Finished, r.Outcome, r.String())
+		}
+	}
+}
+
+func TestCheckBlamed(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(3)
+	blamed := func(culprits ...*tss.PartyID) netsim.Result {
+		return netsim.Result{Party: pIDs[1], Outcome: netsim.Errored, Err: tss.NewError(tss.ErrBadShare, keygen.TaskName, 2, pIDs[1], culprits...)}
+	}
+
+	assert.NoError(t, adversary.CheckBlamed([]netsim.Result{blamed(pIDs[0]), blamed(pIDs[0])}, pIDs[0], tss.ErrBadShare))
+	assert.Error(t, adversary.CheckBlamed(nil, pIDs[0], tss.ErrBadShare), "undetected cheating")
+	assert.Error(t, adversary.CheckBlamed([]netsim.Result{blamed(pIDs[0], pIDs[2])}, pIDs[0], tss.ErrBadShare), "an honest party blamed")
+	assert.Error(t, adversary.CheckBlamed([]netsim.Result{blamed(pIDs[0])}, pIDs[0], errors.New("other")), "another cause")
+	assert.Error(t, adversary.CheckBlamed([]netsim.Result{{Party: pIDs[1], Outcome: netsim.Finished}}, pIDs[0], tss.ErrBadShare), "an honest party finished")
+}
+
+func TestTamperField(t *testing.T) {
+	assert.Panics(t, func() {
+		adversary.TamperField("no_such_field")(&keygen.KGRound2Message1{Share: []byte{1}})
+	})
+
+	// the field is read as a big-endian integer and incremented
+	content := &keygen.KGRound2Message1{Share: []byte{1, 0xff}}
+	adversary.TamperField("share")(content)
+	assert.Equal(t, []byte{2, 0}, content.Share)
+}
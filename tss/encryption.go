@@ -0,0 +1,159 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"golang.org/x/crypto/curve25519"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/bnb-chain/tss-lib/common"
+)
+
+// Point-to-point messages are encrypted with AES-256-GCM under a key derived from an X25519 Diffie-Hellman
+// exchange between the identity keys of the sender and the recipient, converted to their Montgomery form.
+// The key is bound to the session and to the direction of the message, so every ordered pair of parties
+// uses a different key in every protocol run.
+
+// curve25519P is the field prime 2^255 - 19
+var curve25519P = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(19))
+
+// checkEncryption fails when p2p encryption is enabled but a key needed to encrypt to or from the known parties,
+// including the new committee of a re-sharing, is missing
+func (params *Parameters) checkEncryption() error {
+	if !params.P2PEncryption() {
+		return nil
+	}
+	if params.IdentityKey() == nil {
+		return errors.New("point-to-point encryption requires an identity key for this party")
+	}
+	Ps := params.Parties().IDs()
+	if params.newCommittee != nil {
+		Ps = append(append(SortedPartyIDs(nil), Ps...), params.newCommittee.IDs()...)
+	}
+	for _, Pj := range Ps {
+		if len(Pj.GetIdentityKey()) != ed25519.PublicKeySize {
+			return fmt.Errorf("point-to-point encryption requires an identity key for party %s", Pj)
+		}
+	}
+	return nil
+}
+
+// encryptWire seals the Any of a point-to-point message for its recipient and stores the result in the wire's ciphertext
+func encryptWire(params *Parameters, wire *MessageWrapper, to *PartyID) error {
+	key, err := p2pKey(params.IdentityKey(), to.GetIdentityKey(), wire.GetSessionId(), wire.GetFrom().GetKey(), to.GetKey())
+	if err != nil {
+		return err
+	}
+	plaintext, err := proto.Marshal(wire.Message)
+	if err != nil {
+		return err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+	wire.Ciphertext = aead.Seal(nonce, nonce, plaintext, nil)
+	return nil
+}
+
+// decryptWire opens the ciphertext of a point-to-point message from `from` that was encrypted for this party
+func decryptWire(params *Parameters, wire *MessageWrapper, from *PartyID) error {
+	key, err := p2pKey(params.IdentityKey(), from.GetIdentityKey(), wire.GetSessionId(), from.GetKey(), params.PartyID().GetKey())
+	if err != nil {
+		return err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return err
+	}
+	if len(wire.Ciphertext) < aead.NonceSize() {
+		return errors.New("ciphertext is too short")
+	}
+	nonce, sealed := wire.Ciphertext[:aead.NonceSize()], wire.Ciphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return err
+	}
+	wire.Message = new(anypb.Any)
+	return proto.Unmarshal(plaintext, wire.Message)
+}
+
+// p2pKey derives the key for messages from the party with key `fromKey` to the party with key `toKey` in a session
+func p2pKey(own ed25519.PrivateKey, peer []byte, session, fromKey, toKey []byte) ([]byte, error) {
+	if own == nil {
+		return nil, errors.New("this party has no identity key")
+	}
+	peerX, err := x25519PublicKey(peer)
+	if err != nil {
+		return nil, err
+	}
+	shared, err := curve25519.X25519(x25519PrivateKey(own), peerX)
+	if err != nil {
+		return nil, err
+	}
+	return common.SHA512_256([]byte("tss-lib p2p encryption"), shared, session, fromKey, toKey), nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// x25519PrivateKey returns the X25519 scalar of an Ed25519 private key
+func x25519PrivateKey(key ed25519.PrivateKey) []byte {
+	h := sha512.Sum512(key.Seed())
+	return h[:curve25519.ScalarSize] // clamped by X25519
+}
+
+// x25519PublicKey maps an Ed25519 public key to the Montgomery u-coordinate u = (1 + y) / (1 - y)
+func x25519PublicKey(key []byte) ([]byte, error) {
+	if len(key) != ed25519.PublicKeySize {
+		return nil, errors.New("the identity key has an invalid length")
+	}
+	le := make([]byte, len(key))
+	copy(le, key)
+	le[len(le)-1] &= 0x7f // drop the sign of x
+	y := new(big.Int).SetBytes(reverse(le))
+	if y.Cmp(curve25519P) >= 0 {
+		return nil, errors.New("the identity key is not a valid point")
+	}
+	den := new(big.Int).Sub(big.NewInt(1), y)
+	den.Mod(den, curve25519P)
+	if den.Sign() == 0 {
+		return nil, errors.New("the identity key is not a valid point")
+	}
+	u := new(big.Int).Add(big.NewInt(1), y)
+	u.Mul(u, den.ModInverse(den, curve25519P))
+	u.Mod(u, curve25519P)
+	return reverse(u.FillBytes(make([]byte, curve25519.PointSize))), nil
+}
+
+// reverse reverses bz in place, converting between big and little endian
+func reverse(bz []byte) []byte {
+	for i, j := 0, len(bz)-1; i < j; i, j = i+1, j-1 {
+		bz[i], bz[j] = bz[j], bz[i]
+	}
+	return bz
+}
@@ -0,0 +1,143 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/test"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// generateIdentityKeys gives every party an identity key and returns their private keys by index
+func generateIdentityKeys(t *testing.T, pIDs tss.SortedPartyIDs) []ed25519.PrivateKey {
+	keys := make([]ed25519.PrivateKey, len(pIDs))
+	for i, pID := range pIDs {
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		assert.NoError(t, err)
+		pID.SetIdentityKey(pub)
+		keys[i] = priv
+	}
+	return keys
+}
+
+func TestEncryptedMessage(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(3)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	keys := generateIdentityKeys(t, pIDs)
+
+	params0 := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[0], len(pIDs), 1)
+	params0.SetIdentityKey(keys[0])
+	params0.SetP2PEncryption(true)
+	msg := sendShare(t, params0, pIDs[0], pIDs[1])
+	bz, _, err := msg.WireBytes()
+	assert.NoError(t, err)
+
+	// every message is sealed under a fresh nonce
+	bz2, _, err := sendShare(t, params0, pIDs[0], pIDs[1]).WireBytes()
+	assert.NoError(t, err)
+	assert.NotEqual(t, bz, bz2)
+
+	// only its recipient can parse it
+	_, err = tss.ParseWireMessage(bz, pIDs[0], false)
+	assert.Error(t, err)
+	params1 := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[1], len(pIDs), 1)
+	params1.SetIdentityKey(keys[1])
+	params1.SetP2PEncryption(true)
+	ok, err2 := keygen.NewLocalParty(params1, make(chan tss.Message, len(pIDs)), nil).UpdateFromBytes(bz, pIDs[0], false)
+	assert.True(t, ok)
+	assert.Nil(t, err2)
+
+	// a share is not sent in the clear to a party without an identity key
+	pIDs[2].SetIdentityKey(nil)
+	outCh := make(chan tss.Message, 1)
+	share := &vss.Share{Threshold: 1, ID: pIDs[2].KeyInt(), Share: big.NewInt(42)}
+	assert.Error(t, tss.SendMessage(params0, outCh, keygen.NewKGRound2Message1(pIDs[2], pIDs[0], share)))
+	assert.Empty(t, outCh)
+}
+
+func TestE2EEncryptedP2PMessages(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(3)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	parties := make([]tss.Party, 0, len(pIDs))
+
+	errCh := make(chan *tss.Error, len(pIDs))
+	outCh := make(chan tss.Message, len(pIDs))
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+
+	keys := generateIdentityKeys(t, pIDs)
+	for i := range pIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[i], len(pIDs), 1)
+		params.SetIdentityKey(keys[i])
+		params.SetP2PEncryption(true)
+		P := keygen.NewLocalParty(params, outCh, endCh)
+		parties = append(parties, P)
+		go func(P tss.Party) {
+			if err := P.Start(); err != nil {
+				errCh <- err
+			}
+		}(P)
+	}
+
+	var p2pSeen, ended int
+	for ended < len(pIDs) {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			bz, _, err := msg.WireBytes()
+			assert.NoError(t, err)
+			if dest := msg.GetTo(); dest == nil {
+				for _, P := range parties {
+					if P.PartyID().Index != msg.GetFrom().Index {
+						go test.SharedPartyUpdater(P, msg, errCh)
+					}
+				}
+			} else {
+				// a relay sees none of the secret share
+				p2pSeen++
+				share := msg.WireMsg().GetMessage().GetValue()
+				assert.False(t, bytes.Contains(bz, share), "p2p message should be sent encrypted")
+
+				// a tampered ciphertext is rejected without blaming the sender, who may not have sent it
+				tampered := append([]byte{}, bz...)
+				tampered[len(tampered)-1] ^= 1
+				_, err2 := parties[dest[0].Index].UpdateFromBytes(tampered, msg.GetFrom(), false)
+				if assert.NotNil(t, err2) {
+					assert.True(t, errors.Is(err2, tss.ErrDecryptionFailed))
+					assert.Empty(t, err2.Culprits())
+				}
+				go test.SharedPartyUpdater(parties[dest[0].Index], msg, errCh)
+			}
+		case <-endCh:
+			ended++
+		}
+	}
+	assert.Equal(t, len(pIDs)*(len(pIDs)-1), p2pSeen)
+
+	// a party with encryption enabled rejects point-to-point messages sent in the clear
+	params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[1], len(pIDs), 1)
+	params.SetIdentityKey(keys[1])
+	params.SetP2PEncryption(true)
+	P := keygen.NewLocalParty(params, outCh, endCh)
+	params0 := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[0], len(pIDs), 1)
+	params0.SetIdentityKey(keys[0])
+	ok, err := P.Update(sendShare(t, params0, pIDs[0], pIDs[1]))
+	assert.False(t, ok)
+	if assert.NotNil(t, err) {
+		assert.True(t, errors.Is(err, tss.ErrUnencryptedMessage))
+	}
+}
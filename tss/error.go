@@ -7,9 +7,80 @@
 package tss
 
 import (
+	"errors"
 	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+var (
+	// ErrSessionMismatch is the cause of an Error raised when a message bound to another protocol run is received.
+	ErrSessionMismatch = errors.New("message belongs to a different session")
+	// ErrRoundTimeout is the cause of an Error raised when a round's deadline passes; the culprits are the parties that did not respond.
+	ErrRoundTimeout = errors.New("round timed out waiting for messages")
+	// ErrDuplicateMessage is the cause of an Error raised when a sender delivers two different messages of the same type.
+	ErrDuplicateMessage = errors.New("received a different message of the same type from the same sender")
+	// ErrInvalidSignature is the cause of an Error raised when a message from a party with an identity key is not signed by that key;
+	// it names no culprit, as the sender of an unauthenticated message is only claimed by the transport.
+	ErrInvalidSignature = errors.New("message signature is missing or invalid")
+	// ErrMisroutedMessage is the cause of an Error raised when a signed message arrives at a party that is not among its
+	// signed recipients; it names no culprit, as the message was redirected after it was signed.
+	ErrMisroutedMessage = errors.New("message was signed for other recipients")
+	// ErrDecryptionFailed is the cause of an Error raised when an encrypted point-to-point message cannot be decrypted;
+	// like ErrInvalidSignature, it names no culprit.
+	ErrDecryptionFailed = errors.New("could not decrypt point-to-point message")
+	// ErrUnencryptedMessage is the cause of an Error raised when a point-to-point message arrives in the clear while encryption is enabled.
+	ErrUnencryptedMessage = errors.New("point-to-point message was not encrypted")
+	// ErrInvalidSnapshot is the cause of an Error raised when a snapshot cannot be taken, decrypted or restored.
+	ErrInvalidSnapshot = errors.New("invalid snapshot")
+	// ErrPartyStopped is the cause of an Error returned when a party that was stopped receives a message.
+	ErrPartyStopped = errors.New("party has stopped")
+
+	// ErrInvalidProof is matched by the cause of an Error raised when a zero-knowledge proof does not verify; see ProofError.
+	ErrInvalidProof = errors.New("invalid proof")
+	// ErrBadDeCommitment is the cause of an Error raised when a de-commitment does not open the commitment received before it.
+	ErrBadDeCommitment = errors.New("de-commitment does not match the commitment")
+	// ErrBadShare is the cause of an Error raised when a secret share does not verify against the VSS commitments of its dealer.
+	ErrBadShare = errors.New("share failed VSS verification")
+	// ErrPointNotOnCurve is the cause of an Error raised when a received or computed point is not on the curve.
+	ErrPointNotOnCurve = errors.New("point is not on the curve")
+	// ErrInvalidMessage is the cause of an Error raised when a message is malformed or carries values that are out of range.
+	ErrInvalidMessage = errors.New("invalid message")
+	// ErrInconsistentResult is the cause of an Error raised when the combined result of a run fails a final check that names no culprit.
+	ErrInconsistentResult = errors.New("the result of the protocol failed a consistency check")
+	// ErrInternal is the cause of an Error raised by a local failure, such as a misuse of the API, that no other party caused.
+	ErrInternal = errors.New("internal error")
 )
 
+// ProofError is the cause of an Error raised when a proof does not verify.
+// It matches ErrInvalidProof with errors.Is, as well as any ProofError for the same type of proof, e.g. &ProofError{Proof: ProofDLN}.
+type ProofError struct {
+	// the type of the proof, one of the Proof names such as ProofDLN
+	Proof string
+	// the reason given by the verifier, if any
+	Err error
+}
+
+func NewProofError(proof string, err error) *ProofError {
+	return &ProofError{Proof: proof, Err: err}
+}
+
+func (err *ProofError) Error() string {
+	if err.Err == nil {
+		return fmt.Sprintf("%s verification failed", err.Proof)
+	}
+	return fmt.Sprintf("%s verification failed: %s", err.Proof, err.Err.Error())
+}
+
+func (err *ProofError) Is(target error) bool {
+	if proofErr, ok := target.(*ProofError); ok {
+		return proofErr.Proof == err.Proof
+	}
+	return target == ErrInvalidProof
+}
+
+func (err *ProofError) Unwrap() error { return err.Err }
+
 // fundamental is an error that has a message and a stack, but no caller.
 type Error struct {
 	cause    error
@@ -17,6 +88,7 @@ type Error struct {
 	round    int
 	victim   *PartyID
 	culprits []*PartyID
+	evidence []*Evidence
 }
 
 func NewError(err error, task string, round int, victim *PartyID, culprits ...*PartyID) *Error {
@@ -25,6 +97,32 @@ func NewError(err error, task string, round int, victim *PartyID, culprits ...*P
 
 func (err *Error) Unwrap() error { return err.cause }
 
+// Is reports whether any of the causes of an Error that blames several parties at once matches `target`
+func (err *Error) Is(target error) bool {
+	var multi *multierror.Error
+	if errors.As(err.cause, &multi) {
+		for _, cause := range multi.Errors {
+			if errors.Is(cause, target) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// As finds the first of the causes of an Error that blames several parties at once that matches `target`
+func (err *Error) As(target interface{}) bool {
+	var multi *multierror.Error
+	if errors.As(err.cause, &multi) {
+		for _, cause := range multi.Errors {
+			if errors.As(cause, target) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (err *Error) Cause() error { return err.cause }
 
 func (err *Error) Task() string { return err.task }
@@ -35,6 +133,15 @@ func (err *Error) Victim() *PartyID { return err.victim }
 
 func (err *Error) Culprits() []*PartyID { return err.culprits }
 
+// Evidence returns the records of the failed checks that blame the culprits, when the round that raised the error kept them
+func (err *Error) Evidence() []*Evidence { return err.evidence }
+
+// WithEvidence attaches records of the failed checks that blame the culprits to the error and returns it
+func (err *Error) WithEvidence(evidence ...*Evidence) *Error {
+	err.evidence = append(err.evidence, evidence...)
+	return err
+}
+
 func (err *Error) Error() string {
 	if err == nil || err.cause == nil {
 		return "Error is nil"
@@ -0,0 +1,124 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// CheckDeCommitment names the check that a de-commitment opens the commitment received before it
+	CheckDeCommitment = "commitments.HashCommitDecommit"
+	// CheckVSSShare names the check that a secret share verifies against the VSS commitments of its dealer
+	CheckVSSShare = "vss.Share"
+)
+
+// ErrInvalidEvidence is returned when evidence is malformed or does not show that its culprit failed the check it names.
+var ErrInvalidEvidence = errors.New("invalid evidence")
+
+// Evidence is a transferable record of a check that a party failed. It holds the messages the check read,
+// signed by the identity keys of their senders, so that anyone who trusts those keys can re-run the check
+// with the VerifyEvidence function of the keygen package, without any secret state. Only the ECDSA and EdDSA keygen
+// protocols attach evidence to their errors; the culprits blamed by other protocols are not transferable.
+// A point-to-point message is kept in its decrypted form; its signature covers its sender and its recipients.
+type Evidence struct {
+	// the task and round in which the check failed
+	Task  string `json:"task"`
+	Round int    `json:"round"`
+	// the failed check: the name of a proof, such as ProofDLN, or a Check name such as CheckVSSShare
+	Check string `json:"check"`
+	// the session that the messages are bound to
+	SessionID []byte `json:"session_id"`
+	// the party that failed the check and the party that ran it
+	Culprit *PartyID `json:"culprit"`
+	Victim  *PartyID `json:"victim"`
+	// the serialized MessageWrappers of the messages of the culprit, and of the victim's own public parameters, that the check read
+	Messages [][]byte `json:"messages"`
+}
+
+// NewEvidence records that `culprit` failed `check` when `victim` ran it on `msgs` in a round of a task.
+// Each message must have been sent by the culprit or the victim.
+func NewEvidence(task string, round int, check string, sessionID []byte, victim, culprit *PartyID, msgs ...ParsedMessage) *Evidence {
+	ev := &Evidence{
+		Task:      task,
+		Round:     round,
+		Check:     check,
+		SessionID: sessionID,
+		Culprit:   culprit,
+		Victim:    victim,
+		Messages:  make([][]byte, 0, len(msgs)),
+	}
+	for _, msg := range msgs {
+		wire := msg.WireMsg()
+		bz, err := proto.Marshal(&MessageWrapper{
+			IsBroadcast:             wire.GetIsBroadcast(),
+			IsToOldCommittee:        wire.GetIsToOldCommittee(),
+			IsToOldAndNewCommittees: wire.GetIsToOldAndNewCommittees(),
+			From:                    wire.GetFrom(),
+			To:                      wire.GetTo(),
+			SessionId:               wire.GetSessionId(),
+			Signature:               wire.GetSignature(),
+			Message:                 wire.GetMessage(),
+		})
+		if err != nil {
+			continue
+		}
+		ev.Messages = append(ev.Messages, bz)
+	}
+	return ev
+}
+
+// ParseMessages checks that every message of the evidence is bound to its session and signed by the identity key
+// of its sender, which must be the culprit or the victim, and returns them parsed in order.
+// The caller is responsible for checking the identity keys of the culprit and the victim against those it trusts.
+func (ev *Evidence) ParseMessages() ([]ParsedMessage, error) {
+	if ev == nil || !ev.Culprit.ValidateBasic() || !ev.Victim.ValidateBasic() {
+		return nil, fmt.Errorf("%w: missing culprit or victim", ErrInvalidEvidence)
+	}
+	msgs := make([]ParsedMessage, 0, len(ev.Messages))
+	for i, bz := range ev.Messages {
+		wire := new(MessageWrapper)
+		if err := proto.Unmarshal(bz, wire); err != nil {
+			return nil, fmt.Errorf("%w: message %d: %v", ErrInvalidEvidence, i, err)
+		}
+		var from *PartyID
+		switch {
+		case bytes.Equal(wire.GetFrom().GetKey(), ev.Culprit.GetKey()):
+			from = ev.Culprit
+		case bytes.Equal(wire.GetFrom().GetKey(), ev.Victim.GetKey()):
+			from = ev.Victim
+		default:
+			return nil, fmt.Errorf("%w: message %d is not from the culprit or the victim", ErrInvalidEvidence, i)
+		}
+		if !bytes.Equal(wire.GetSessionId(), ev.SessionID) {
+			return nil, fmt.Errorf("%w: message %d: %v", ErrInvalidEvidence, i, ErrSessionMismatch)
+		}
+		if len(from.GetIdentityKey()) == 0 || !verifySignature(wire, from) {
+			return nil, fmt.Errorf("%w: message %d: %v", ErrInvalidEvidence, i, ErrInvalidSignature)
+		}
+		msg, err := parseWrappedMessage(wire, from)
+		if err != nil {
+			return nil, fmt.Errorf("%w: message %d: %v", ErrInvalidEvidence, i, err)
+		}
+		if !msg.ValidateBasic() {
+			return nil, fmt.Errorf("%w: message %d failed ValidateBasic", ErrInvalidEvidence, i)
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+// IsToVictim reports whether a point-to-point message of the evidence is signed for the victim as its only recipient.
+// A check that depends on who received a message, such as CheckVSSShare, must only be re-run on such messages.
+func (ev *Evidence) IsToVictim(msg ParsedMessage) bool {
+	to := msg.WireMsg().GetTo()
+	return !msg.IsBroadcast() && len(to) == 1 && bytes.Equal(to[0].GetKey(), ev.Victim.GetKey())
+}
@@ -0,0 +1,17 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss
+
+// Logger is the logging interface used by parties, set per party with Parameters.SetLogger.
+// The go-log logger in common.Logger implements it and is used when no logger is set.
+// Log statements never include the content of messages, which may carry secret shares.
+type Logger interface {
+	Debugf(format string, args ...interface{})
+	Infof(format string, args ...interface{})
+	Warningf(format string, args ...interface{})
+	Errorf(format string, args ...interface{})
+}
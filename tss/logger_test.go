@@ -0,0 +1,69 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// recordingLogger collects the lines logged by a party at every level
+type recordingLogger struct {
+	lines []string
+}
+
+var _ tss.Logger = (*recordingLogger)(nil)
+
+func (l *recordingLogger) Debugf(format string, args ...interface{}) {
+	l.lines = append(l.lines, fmt.Sprintf(format, args...))
+}
+
+func (l *recordingLogger) Infof(format string, args ...interface{}) {
+	l.lines = append(l.lines, fmt.Sprintf(format, args...))
+}
+
+func (l *recordingLogger) Warningf(format string, args ...interface{}) {
+	l.lines = append(l.lines, fmt.Sprintf(format, args...))
+}
+
+func (l *recordingLogger) Errorf(format string, args ...interface{}) {
+	l.lines = append(l.lines, fmt.Sprintf(format, args...))
+}
+
+func TestPerPartyLogger(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(3)
+	logger := new(recordingLogger)
+	var secrets []string
+	saves := runKeygen(t, pIDs, func(i int, params *tss.Parameters) {
+		if i == 0 {
+			params.SetLogger(logger)
+		}
+	}, func(msg tss.Message) {
+		if r2msg1, ok := msg.(tss.ParsedMessage).Content().(*keygen.KGRound2Message1); ok {
+			secrets = append(secrets, r2msg1.UnmarshalShare().String(), r2msg1.UnmarshalShare().Text(16))
+		}
+	})
+	for _, save := range saves {
+		secrets = append(secrets, save.Xi.String(), save.Xi.Text(16))
+	}
+
+	// the logs of the party carry no secret shares
+	finished := false
+	for _, line := range logger.lines {
+		for _, secret := range secrets {
+			assert.NotContains(t, line, secret)
+		}
+		finished = finished || strings.Contains(line, "finished!")
+	}
+	assert.True(t, finished, "the party should log that it finished")
+}
@@ -7,6 +7,7 @@
 package tss
 
 import (
+	"crypto/ed25519"
 	"fmt"
 
 	"google.golang.org/protobuf/proto"
@@ -33,6 +34,7 @@ type (
 		// Returns the protobuf message wrapper struct
 		// Only its inner content should be sent over the wire, not this struct itself
 		WireMsg() *MessageWrapper
+		// Describes the type and routing of the message for logs; never its content, which may carry secret shares
 		String() string
 	}
 
@@ -78,6 +80,30 @@ var (
 
 // ----- //
 
+// SendMessage binds an outbound message to the session of `params`, signs it when `params` has an identity key,
+// encrypts it for its recipient when it is a point-to-point message and encryption is enabled,
+// and hands it to the transport through `out`. Rounds use this rather than writing to their out channel directly.
+// A point-to-point message that cannot be encrypted is not sent, and the error is returned for the round to fail with.
+func SendMessage(params *Parameters, out chan<- Message, msg ParsedMessage) error {
+	wire := msg.WireMsg()
+	wire.SessionId = params.SessionID()
+	if key := params.IdentityKey(); key != nil {
+		wire.Signature = ed25519.Sign(key, signedBytes(wire))
+	}
+	if params.P2PEncryption() && !wire.GetIsBroadcast() {
+		// never fall back to sending secret material in the clear
+		if len(msg.GetTo()) != 1 {
+			return fmt.Errorf("point-to-point message %s not sent, it must have exactly one recipient to be encrypted", msg.Type())
+		}
+		if err := encryptWire(params, wire, msg.GetTo()[0]); err != nil {
+			return fmt.Errorf("point-to-point message %s not sent, encryption failed: %v", msg.Type(), err)
+		}
+	}
+	params.Metrics().messageSent(msg)
+	out <- msg
+	return nil
+}
+
 // NewMessageWrapper constructs a MessageWrapper from routing metadata and content
 func NewMessageWrapper(routing MessageRouting, content MessageContent) *MessageWrapper {
 	// marshal the content to the ProtoBuf Any type
@@ -137,7 +163,7 @@ func (mm *MessageImpl) IsToOldAndNewCommittees() bool {
 }
 
 func (mm *MessageImpl) WireBytes() ([]byte, *MessageRouting, error) {
-	bz, err := proto.Marshal(mm.wire.Message)
+	bz, err := proto.Marshal(wireEnvelope(mm.wire))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,228 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// The names of the proofs timed by the protocols
+const (
+	ProofDLN             = "dlnproof.Proof"
+	ProofPaillierMod     = "paillier.ModProof"
+	ProofPaillierFactor  = "paillier.FactorProof"
+	ProofPaillierKey     = "paillier.Proof"
+	ProofPaillierEnc     = "paillier.EncProof"
+	ProofPaillierAffG    = "paillier.AffGProof"
+	ProofPaillierLogStar = "paillier.LogStarProof"
+	ProofPaillierMul     = "paillier.MulProof"
+	ProofPaillierMulStar = "paillier.MulStarProof"
+	ProofPaillierDec     = "paillier.DecProof"
+	ProofMtARangeAlice   = "mta.RangeProofAlice"
+	ProofMtABob          = "mta.ProofBob"
+	ProofMtABobWC        = "mta.ProofBobWC"
+	ProofSchnorr         = "schnorr.ZKProof"
+	ProofSchnorrVariant  = "schnorr.ZKVProof"
+)
+
+const (
+	ProofGeneration ProofOperation = iota + 1
+	ProofVerification
+)
+
+type (
+	// ProofOperation is either the generation or the verification of a proof
+	ProofOperation int
+
+	// Metrics collects the timings and traffic of the parties it is registered with through Parameters.SetMetrics.
+	// A collector is safe for concurrent use and may be shared by many parties; use Snapshot to read it.
+	// All methods may be called on a nil *Metrics, which collects nothing.
+	Metrics struct {
+		mtx      sync.Mutex
+		started  map[runningParty]time.Time
+		rounds   map[RoundKey]Timing
+		proofs   map[ProofKey]Timing
+		messages map[string]Traffic
+	}
+
+	// MetricsSnapshot is a copy of what a Metrics has collected, e.g. to export to a monitoring system
+	MetricsSnapshot struct {
+		// wall-clock time from the start of a round until the messages of every party were received and verified
+		Rounds map[RoundKey]Timing
+		// time spent generating and verifying each type of proof
+		Proofs map[ProofKey]Timing
+		// messages sent and received by message type
+		Messages map[string]Traffic
+	}
+
+	// RoundKey identifies a round of a protocol
+	RoundKey struct {
+		Task  string
+		Round int
+	}
+
+	// ProofKey identifies the generation or verification of a type of proof
+	ProofKey struct {
+		Proof     string
+		Operation ProofOperation
+	}
+
+	// Timing accumulates the durations of an operation
+	Timing struct {
+		Count int
+		Total time.Duration
+		Max   time.Duration
+	}
+
+	// Traffic counts the messages of a type and their size on the wire. Received messages are counted once they pass
+	// ValidateMessage; rejected ones are reported to observers only.
+	Traffic struct {
+		Sent          int
+		SentBytes     int
+		Received      int
+		ReceivedBytes int
+	}
+
+	// runningParty identifies a party in a protocol run, which is in one round at a time
+	runningParty struct {
+		party, session, task string
+	}
+)
+
+// NewMetrics returns an empty collector to give to Parameters.SetMetrics
+func NewMetrics() *Metrics {
+	return &Metrics{
+		started:  make(map[runningParty]time.Time),
+		rounds:   make(map[RoundKey]Timing),
+		proofs:   make(map[ProofKey]Timing),
+		messages: make(map[string]Traffic),
+	}
+}
+
+// Snapshot returns a copy of the metrics collected so far
+func (m *Metrics) Snapshot() MetricsSnapshot {
+	snapshot := MetricsSnapshot{
+		Rounds:   make(map[RoundKey]Timing),
+		Proofs:   make(map[ProofKey]Timing),
+		Messages: make(map[string]Traffic),
+	}
+	if m == nil {
+		return snapshot
+	}
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	for k, v := range m.rounds {
+		snapshot.Rounds[k] = v
+	}
+	for k, v := range m.proofs {
+		snapshot.Proofs[k] = v
+	}
+	for k, v := range m.messages {
+		snapshot.Messages[k] = v
+	}
+	return snapshot
+}
+
+// RecordProof adds the time since `start` to the timing of a proof operation
+func (m *Metrics) RecordProof(proof string, op ProofOperation, start time.Time) {
+	if m == nil {
+		return
+	}
+	elapsed := time.Since(start)
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	key := ProofKey{Proof: proof, Operation: op}
+	m.proofs[key] = m.proofs[key].add(elapsed)
+}
+
+func (op ProofOperation) String() string {
+	switch op {
+	case ProofGeneration:
+		return "generation"
+	case ProofVerification:
+		return "verification"
+	default:
+		return "unknown"
+	}
+}
+
+// ----- //
+
+func (t Timing) add(elapsed time.Duration) Timing {
+	t.Count++
+	t.Total += elapsed
+	if t.Max < elapsed {
+		t.Max = elapsed
+	}
+	return t
+}
+
+// startRound notes the time at which party `p` starts its next round
+func (m *Metrics) startRound(p Party, task string) {
+	if m == nil {
+		return
+	}
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.started[runningPartyOf(p, task)] = time.Now()
+}
+
+// finishRound records the duration of the current round of party `p`, if its start was noted
+func (m *Metrics) finishRound(p Party, task string) {
+	if m == nil {
+		return
+	}
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	running := runningPartyOf(p, task)
+	start, ok := m.started[running]
+	if !ok {
+		return
+	}
+	delete(m.started, running)
+	key := RoundKey{Task: task, Round: p.round().RoundNumber()}
+	m.rounds[key] = m.rounds[key].add(time.Since(start))
+}
+
+// messageSent records an outbound message with its size on the wire
+func (m *Metrics) messageSent(msg ParsedMessage) {
+	if m == nil {
+		return
+	}
+	size := proto.Size(wireEnvelope(msg.WireMsg()))
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	traffic := m.messages[msg.Type()]
+	traffic.Sent++
+	traffic.SentBytes += size
+	m.messages[msg.Type()] = traffic
+}
+
+// messageReceived records a validated inbound message with its size on the wire
+func (m *Metrics) messageReceived(msg ParsedMessage) {
+	if m == nil {
+		return
+	}
+	size := proto.Size(wireEnvelope(msg.WireMsg()))
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	traffic := m.messages[msg.Type()]
+	traffic.Received++
+	traffic.ReceivedBytes += size
+	m.messages[msg.Type()] = traffic
+}
+
+func runningPartyOf(p Party, task string) runningParty {
+	return runningParty{
+		party:   string(p.PartyID().GetKey()),
+		session: string(p.parameters().SessionID()),
+		task:    task,
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func TestMetricsCountOnlyValidMessages(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(2)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	pub0, _, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	_, otherPriv, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	pIDs[0].SetIdentityKey(pub0)
+
+	metrics := tss.NewMetrics()
+	params1 := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[1], len(pIDs), 1)
+	params1.SetMetrics(metrics)
+	P1 := keygen.NewLocalParty(params1, make(chan tss.Message, len(pIDs)), nil)
+
+	// a nil message is rejected like without metrics
+	ok, err2 := P1.Update(nil)
+	assert.False(t, ok)
+	if assert.NotNil(t, err2) {
+		assert.True(t, errors.Is(err2, tss.ErrInvalidMessage))
+	}
+
+	// a message from P[0] signed with another key is not traffic from P[0]
+	params0 := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[0], len(pIDs), 1)
+	params0.SetIdentityKey(otherPriv)
+	outCh := make(chan tss.Message, len(pIDs))
+	assert.Nil(t, keygen.NewLocalParty(params0, outCh, nil).Start())
+	ok, err2 = P1.Update((<-outCh).(tss.ParsedMessage))
+	assert.False(t, ok)
+	assert.True(t, errors.Is(err2, tss.ErrInvalidSignature))
+
+	assert.Empty(t, metrics.Snapshot().Messages)
+}
+
+func TestMetrics(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(3)
+	metrics := tss.NewMetrics()
+	sentBytes := make(map[string]int)
+	runKeygen(t, pIDs, func(i int, params *tss.Parameters) {
+		params.SetMetrics(metrics)
+	}, func(msg tss.Message) {
+		bz, _, err := msg.WireBytes()
+		assert.NoError(t, err)
+		sentBytes[msg.Type()] += len(bz)
+	})
+
+	n := len(pIDs)
+	snapshot := metrics.Snapshot()
+	for round := 1; round <= 3; round++ {
+		assert.Equal(t, n, snapshot.Rounds[tss.RoundKey{Task: keygen.TaskName, Round: round}].Count, "round %d", round)
+	}
+	assert.Equal(t, n, snapshot.Proofs[tss.ProofKey{Proof: tss.ProofSchnorr, Operation: tss.ProofGeneration}].Count)
+	assert.Equal(t, n*(n-1), snapshot.Proofs[tss.ProofKey{Proof: tss.ProofSchnorr, Operation: tss.ProofVerification}].Count)
+	assert.Len(t, snapshot.Messages, 3)
+	for typ, traffic := range snapshot.Messages {
+		assert.Equal(t, sentBytes[typ], traffic.SentBytes, typ)
+		if typ == "binance.tsslib.eddsa.keygen.KGRound2Message1" {
+			assert.Equal(t, n*(n-1), traffic.Sent, typ)
+		} else {
+			assert.Equal(t, n, traffic.Sent, typ)
+		}
+		assert.Equal(t, n*(n-1), traffic.Received, typ)
+	}
+}
+
+func TestRecordProof(t *testing.T) {
+	metrics := tss.NewMetrics()
+	key := tss.ProofKey{Proof: tss.ProofDLN, Operation: tss.ProofVerification}
+	metrics.RecordProof(key.Proof, key.Operation, time.Now().Add(-2*time.Second))
+	metrics.RecordProof(key.Proof, key.Operation, time.Now().Add(-time.Second))
+
+	snapshot := metrics.Snapshot()
+	timing := snapshot.Proofs[key]
+	assert.Equal(t, 2, timing.Count)
+	assert.GreaterOrEqual(t, int64(timing.Total), int64(3*time.Second))
+	assert.GreaterOrEqual(t, int64(timing.Max), int64(2*time.Second))
+	assert.Less(t, int64(timing.Max), int64(timing.Total))
+	assert.Equal(t, "verification", key.Operation.String())
+
+	// a snapshot is a copy of what was collected
+	delete(snapshot.Proofs, key)
+	assert.Equal(t, 2, metrics.Snapshot().Proofs[key].Count)
+
+	// a nil collector collects nothing
+	var none *tss.Metrics
+	none.RecordProof(key.Proof, key.Operation, time.Now())
+	assert.Empty(t, none.Snapshot().Proofs)
+}
@@ -0,0 +1,115 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss
+
+import (
+	"context"
+	"errors"
+)
+
+type (
+	// EventType identifies what happened to a party
+	EventType int
+
+	// Event describes something that happened to a running party, for monitoring
+	Event struct {
+		Type  EventType
+		Task  string
+		Party *PartyID
+		// the round the event relates to; 0 before the party has started and after it has finished
+		Round int
+		// the type and sender of the message, for message events
+		MessageType string
+		From        *PartyID
+		// the error of a failure or rejection; Err.Culprits() lists the parties that were blamed
+		Err *Error
+	}
+
+	// Observer receives the events of the parties it is registered with through Parameters.SetObserver.
+	// Events are delivered synchronously while the party is locked, so Observe must return quickly and must not call into the party.
+	Observer interface {
+		Observe(event Event)
+	}
+
+	// ObserverFunc adapts a function to the Observer interface
+	ObserverFunc func(event Event)
+)
+
+const (
+	// EventRoundStarted is emitted once a round has sent its messages
+	EventRoundStarted EventType = iota + 1
+	// EventRoundFinished is emitted when a round has received and verified the messages of every party
+	EventRoundFinished
+	// EventMessageStored is emitted when a message is accepted by the party
+	EventMessageStored
+	// EventMessageRejected is emitted when a message is rejected before it reaches a round, e.g. for a session mismatch or a bad signature
+	EventMessageRejected
+	// EventVerificationFailed is emitted when a round fails because a message or proof from the culprits did not verify
+	EventVerificationFailed
+	// EventPartyFailed is emitted when a party fails for any other reason, including timeouts and cancellation
+	EventPartyFailed
+	// EventPartyFinished is emitted when a party has completed the protocol
+	EventPartyFinished
+)
+
+func (f ObserverFunc) Observe(event Event) {
+	f(event)
+}
+
+func (t EventType) String() string {
+	switch t {
+	case EventRoundStarted:
+		return "round started"
+	case EventRoundFinished:
+		return "round finished"
+	case EventMessageStored:
+		return "message stored"
+	case EventMessageRejected:
+		return "message rejected"
+	case EventVerificationFailed:
+		return "verification failed"
+	case EventPartyFailed:
+		return "party failed"
+	case EventPartyFinished:
+		return "party finished"
+	default:
+		return "unknown"
+	}
+}
+
+// ----- //
+
+// observe delivers an event about party `p` to the observer of its parameters, if any
+func observe(p Party, task string, event Event) {
+	params := p.parameters()
+	if params == nil || params.Observer() == nil {
+		return
+	}
+	event.Task, event.Party = task, p.PartyID()
+	if event.Round == 0 && p.round() != nil {
+		event.Round = p.round().RoundNumber()
+	}
+	params.Observer().Observe(event)
+}
+
+// observeMessage delivers a message event about party `p`
+func observeMessage(p Party, task string, typ EventType, msg ParsedMessage, err *Error) {
+	event := Event{Type: typ, Err: err}
+	if msg != nil && msg.Content() != nil {
+		event.MessageType, event.From = msg.Type(), msg.GetFrom()
+	}
+	observe(p, task, event)
+}
+
+// observeFailure delivers the failure of party `p`, as a verification failure when the error blames other parties
+func observeFailure(p Party, task string, err *Error) {
+	typ := EventPartyFailed
+	if 0 < len(err.Culprits()) && !errors.Is(err, ErrRoundTimeout) && !errors.Is(err, context.DeadlineExceeded) {
+		typ = EventVerificationFailed
+	}
+	observe(p, task, Event{Type: typ, Err: err})
+}
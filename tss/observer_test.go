@@ -0,0 +1,63 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func TestObserverEvents(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(3)
+	var events []tss.Event
+	observer := tss.ObserverFunc(func(event tss.Event) {
+		events = append(events, event)
+	})
+	runKeygen(t, pIDs, func(i int, params *tss.Parameters) {
+		params.SetSessionID([]byte("observer"))
+		if i == 0 {
+			params.SetObserver(observer)
+		}
+	}, nil)
+
+	counts := make(map[tss.EventType]int)
+	for _, event := range events {
+		assert.Equal(t, keygen.TaskName, event.Task)
+		assert.Equal(t, pIDs[0], event.Party)
+		counts[event.Type]++
+	}
+	assert.Equal(t, 3, counts[tss.EventRoundStarted])
+	assert.Equal(t, 3, counts[tss.EventRoundFinished])
+	assert.Equal(t, 2*(len(pIDs)-1)+(len(pIDs)-1), counts[tss.EventMessageStored])
+	assert.Equal(t, 1, counts[tss.EventPartyFinished])
+	assert.Equal(t, tss.EventPartyFinished, events[len(events)-1].Type)
+
+	// a message from another session is rejected
+	p2pCtx := tss.NewPeerContext(pIDs)
+	outCh := make(chan tss.Message, len(pIDs))
+	params0 := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[0], len(pIDs), 1)
+	params0.SetSessionID([]byte("observer"))
+	params0.SetObserver(observer)
+	P0 := keygen.NewLocalParty(params0, outCh, nil)
+	assert.Nil(t, P0.Start())
+	<-outCh
+	params1 := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[1], len(pIDs), 1)
+	params1.SetSessionID([]byte("another"))
+	assert.Nil(t, keygen.NewLocalParty(params1, outCh, nil).Start())
+	events = nil
+	_, _ = P0.Update((<-outCh).(tss.ParsedMessage))
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, tss.EventMessageRejected, events[0].Type)
+		assert.Equal(t, pIDs[1], events[0].From)
+		assert.True(t, errors.Is(events[0].Err, tss.ErrSessionMismatch))
+	}
+}
@@ -7,9 +7,17 @@
 package tss
 
 import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ed25519"
 	"crypto/elliptic"
+	"crypto/rand"
+	"io"
 	"runtime"
 	"time"
+
+	"github.com/bnb-chain/tss-lib/common"
 )
 
 type (
@@ -21,6 +29,16 @@ type (
 		threshold           int
 		concurrency         int
 		safePrimeGenTimeout time.Duration
+		sessionID           []byte
+		roundTimeout        time.Duration
+		ctx                 context.Context
+		identityKey         ed25519.PrivateKey
+		p2pEncryption       bool
+		observer            Observer
+		logger              Logger
+		metrics             *Metrics
+		rand                io.Reader
+		newCommittee        *PeerContext // the new parties of a re-sharing, which are sent messages too
 	}
 
 	ReSharingParameters struct {
@@ -45,6 +63,7 @@ func NewParameters(ec elliptic.Curve, ctx *PeerContext, partyID *PartyID, partyC
 		threshold:           threshold,
 		concurrency:         runtime.GOMAXPROCS(0),
 		safePrimeGenTimeout: defaultSafePrimeGenTimeout,
+		rand:                rand.Reader,
 	}
 }
 
@@ -76,6 +95,78 @@ func (params *Parameters) SafePrimeGenTimeout() time.Duration {
 	return params.safePrimeGenTimeout
 }
 
+// SessionID identifies the protocol run. It is carried with every outbound message and bound into every proof challenge.
+func (params *Parameters) SessionID() []byte {
+	return params.sessionID
+}
+
+// RoundTimeout is how long a party started with StartWithContext waits for the messages of a round; zero means no limit
+func (params *Parameters) RoundTimeout() time.Duration {
+	return params.roundTimeout
+}
+
+// Context is the context a party was started with, used to abandon in-flight work; never nil
+func (params *Parameters) Context() context.Context {
+	if params.ctx == nil {
+		return context.Background()
+	}
+	return params.ctx
+}
+
+// IdentityKey is the long-term key this party signs its outbound messages with; nil when messages are not signed
+func (params *Parameters) IdentityKey() ed25519.PrivateKey {
+	return params.identityKey
+}
+
+// P2PEncryption reports whether point-to-point messages are encrypted for their recipient
+func (params *Parameters) P2PEncryption() bool {
+	return params.p2pEncryption
+}
+
+// Observer receives the events of parties using these parameters; nil when none is set
+func (params *Parameters) Observer() Observer {
+	return params.observer
+}
+
+// Logger is where parties using these parameters write their logs; the shared common.Logger when none is set, or for nil parameters
+func (params *Parameters) Logger() Logger {
+	if params == nil || params.logger == nil {
+		return common.Logger
+	}
+	return params.logger
+}
+
+// Metrics collects the timings and traffic of parties using these parameters; nil when none is set, or for nil parameters
+func (params *Parameters) Metrics() *Metrics {
+	if params == nil {
+		return nil
+	}
+	return params.metrics
+}
+
+// Rand is the source of all the randomness drawn by parties using these parameters; crypto/rand by default
+func (params *Parameters) Rand() io.Reader {
+	return params.rand
+}
+
+// SubRands derives `n` independent sources of randomness from Rand, in order, for goroutines that draw randomness concurrently.
+// Each is an AES-256-CTR stream keyed with 32 bytes of Rand, so that a seeded Rand reproduces a run whatever order the goroutines run in.
+func (params *Parameters) SubRands(n int) ([]io.Reader, error) {
+	rands := make([]io.Reader, n)
+	for i := range rands {
+		key := make([]byte, 32)
+		if _, err := io.ReadFull(params.rand, key); err != nil {
+			return nil, err
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		rands[i] = cipher.StreamReader{S: cipher.NewCTR(block, make([]byte, aes.BlockSize)), R: zeroReader{}}
+	}
+	return rands, nil
+}
+
 // The concurrency level must be >= 1.
 func (params *Parameters) SetConcurrency(concurrency int) {
 	params.concurrency = concurrency
@@ -85,11 +176,63 @@ func (params *Parameters) SetSafePrimeGenTimeout(timeout time.Duration) {
 	params.safePrimeGenTimeout = timeout
 }
 
+// SetRoundTimeout sets the per-round deadline enforced by StartWithContext.
+// When a round does not complete in time the party stops and blames the parties it is still waiting for.
+func (params *Parameters) SetRoundTimeout(timeout time.Duration) {
+	params.roundTimeout = timeout
+}
+
+func (params *Parameters) setContext(ctx context.Context) {
+	params.ctx = ctx
+}
+
+// SetSessionID binds this party to a protocol run. All parties of the run must use the same session ID,
+// and it should be unique to the run (e.g. derived from a request ID agreed upon by the participants).
+// Messages and proofs produced under a different session ID are rejected.
+func (params *Parameters) SetSessionID(sessionID []byte) {
+	params.sessionID = sessionID
+}
+
+// SetIdentityKey makes this party sign every outbound message with `key`.
+// The matching public key should be set on this party's PartyID at every other party, which then rejects messages without a valid signature.
+func (params *Parameters) SetIdentityKey(key ed25519.PrivateKey) {
+	params.identityKey = key
+}
+
+// SetP2PEncryption turns on encryption of point-to-point messages, which carry secret shares.
+// It requires an identity key on this party's Parameters and on the PartyID of every other party.
+// When enabled, a party also rejects point-to-point messages that were not encrypted.
+func (params *Parameters) SetP2PEncryption(enabled bool) {
+	params.p2pEncryption = enabled
+}
+
+// SetObserver registers an observer for the round transitions, messages and failures of parties using these parameters
+func (params *Parameters) SetObserver(observer Observer) {
+	params.observer = observer
+}
+
+// SetLogger routes the logs of parties using these parameters to `logger`, e.g. to tag them with a session
+func (params *Parameters) SetLogger(logger Logger) {
+	params.logger = logger
+}
+
+// SetMetrics makes parties using these parameters record their round and proof timings and their traffic in `metrics`
+func (params *Parameters) SetMetrics(metrics *Metrics) {
+	params.metrics = metrics
+}
+
+// SetRand replaces the source of randomness of parties using these parameters. It is meant for tests only, e.g. to reproduce a run
+// with a seeded reader: outside of tests, leave the default crypto/rand, as the secrecy of the key shares depends on it.
+func (params *Parameters) SetRand(rand io.Reader) {
+	params.rand = rand
+}
+
 // ----- //
 
 // Exported, used in `tss` client
 func NewReSharingParameters(ec elliptic.Curve, ctx, newCtx *PeerContext, partyID *PartyID, partyCount, threshold, newPartyCount, newThreshold int) *ReSharingParameters {
 	params := NewParameters(ec, ctx, partyID, partyCount, threshold)
+	params.newCommittee = newCtx
 	return &ReSharingParameters{
 		Parameters:    params,
 		newParties:    newCtx,
@@ -145,3 +288,13 @@ func (rgParams *ReSharingParameters) IsNewCommittee() bool {
 	}
 	return false
 }
+
+// zeroReader reads zeros, which a cipher.StreamReader turns into its key stream
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
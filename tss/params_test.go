@@ -0,0 +1,67 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/test"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func TestSubRands(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(2)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	streams := func(seed string) [][]byte {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[0], len(pIDs), 1)
+		params.SetRand(test.NewSeededReader([]byte(seed)))
+		rands, err := params.SubRands(3)
+		if !assert.NoError(t, err) {
+			return nil
+		}
+		bzs := make([][]byte, len(rands))
+		for i, rand := range rands {
+			bzs[i] = make([]byte, 64)
+			_, err := io.ReadFull(rand, bzs[i])
+			assert.NoError(t, err)
+		}
+		return bzs
+	}
+
+	// the same seed gives the same streams, which differ from each other and from those of another seed
+	first, second, other := streams("seed"), streams("seed"), streams("other")
+	assert.Equal(t, first, second)
+	for i := range first {
+		assert.NotEqual(t, other[i], first[i])
+		for j := i + 1; j < len(first); j++ {
+			assert.NotEqual(t, first[j], first[i])
+		}
+	}
+
+	// by default the randomness is drawn from crypto/rand
+	params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[0], len(pIDs), 1)
+	assert.NotNil(t, params.Rand())
+	rands, err := params.SubRands(2)
+	assert.NoError(t, err)
+	assert.Len(t, rands, 2)
+}
+
+func TestSeededRandomReproducesRun(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(3)
+	seed := func(i int, params *tss.Parameters) {
+		params.SetRand(test.NewSeededReader([]byte(fmt.Sprintf("party-%d", i))))
+	}
+	first, second := runKeygen(t, pIDs, seed, nil), runKeygen(t, pIDs, seed, nil)
+	for i := range pIDs {
+		assert.Equal(t, 0, first[i].Xi.Cmp(second[i].Xi), "party %d should derive the same share", i)
+		assert.True(t, first[i].EDDSAPub.Equals(second[i].EDDSAPub), "party %d should derive the same public key", i)
+	}
+}
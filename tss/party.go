@@ -7,15 +7,22 @@
 package tss
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
-	"github.com/bnb-chain/tss-lib/common"
+	"google.golang.org/protobuf/proto"
 )
 
 type Party interface {
 	Start() *Error
+	// StartWithContext starts the party and blocks until it finishes, fails or is stopped.
+	// The party stops when ctx is done or when a round does not complete within the round timeout set on its Parameters,
+	// returning an Error that blames the parties it was still waiting for.
+	StartWithContext(ctx context.Context) *Error
 	// The main entry point when updating a party's state from the wire.
 	// isBroadcast should represent whether the message was received via a reliable broadcast
 	UpdateFromBytes(wireBytes []byte, from *PartyID, isBroadcast bool) (ok bool, err *Error)
@@ -31,19 +38,32 @@ type Party interface {
 	String() string
 
 	// Private lifecycle methods
+	parameters() *Parameters
 	setRound(Round) *Error
 	round() Round
 	advance()
 	lock()
 	unlock()
+	watch() <-chan struct{}
+	notify()
+	stop(err *Error) *Error
+	stopped() *Error
 }
 
 type BaseParty struct {
 	mtx        sync.Mutex
 	rnd        Round
+	params     *Parameters
+	progress   chan struct{} // signalled on round transitions and failures when started with a context
+	err        *Error        // the error this party stopped with when started with a context
 	FirstRound Round
 }
 
+// NewBaseParty constructs a BaseParty for a party using `params`, which is needed to check the session of incoming messages
+func NewBaseParty(params *Parameters) *BaseParty {
+	return &BaseParty{params: params}
+}
+
 func (p *BaseParty) Running() bool {
 	return p.rnd != nil
 }
@@ -67,14 +87,65 @@ func (p *BaseParty) WrapError(err error, culprits ...*PartyID) *Error {
 // an implementation of ValidateMessage that is shared across the different types of parties (keygen, signing, dynamic groups)
 func (p *BaseParty) ValidateMessage(msg ParsedMessage) (bool, *Error) {
 	if msg == nil || msg.Content() == nil {
-		return false, p.WrapError(fmt.Errorf("received nil msg: %s", msg))
+		return false, p.WrapError(fmt.Errorf("%w: received nil msg: %s", ErrInvalidMessage, msg))
 	}
 	if msg.GetFrom() == nil || !msg.GetFrom().ValidateBasic() {
-		return false, p.WrapError(fmt.Errorf("received msg with an invalid sender: %s", msg))
+		return false, p.WrapError(fmt.Errorf("%w: received msg with an invalid sender: %s", ErrInvalidMessage, msg))
+	}
+	// the sender is only a claim of the transport until the signature is checked, so a bad signature names no culprit
+	if !verifySignature(msg.WireMsg(), msg.GetFrom()) {
+		return false, p.WrapError(fmt.Errorf("%w: %s", ErrInvalidSignature, msg))
 	}
 	if !msg.ValidateBasic() {
-		return false, p.WrapError(fmt.Errorf("message failed ValidateBasic: %s", msg), msg.GetFrom())
+		return false, p.WrapError(fmt.Errorf("%w: message failed ValidateBasic: %s", ErrInvalidMessage, msg), msg.GetFrom())
+	}
+	if p.params != nil && !bytes.Equal(msg.WireMsg().GetSessionId(), p.params.SessionID()) {
+		return false, p.WrapError(fmt.Errorf("%w: %s", ErrSessionMismatch, msg), msg.GetFrom())
+	}
+	// the recipients are covered by the signature, so a signed message redirected by a relay is not the sender's fault
+	if p.params != nil && len(msg.GetFrom().GetIdentityKey()) > 0 && !signedFor(msg.WireMsg(), p.params.PartyID()) {
+		return false, p.WrapError(fmt.Errorf("%w: %s", ErrMisroutedMessage, msg))
+	}
+	if p.params != nil && p.params.P2PEncryption() && !msg.IsBroadcast() && len(msg.WireMsg().GetCiphertext()) == 0 {
+		return false, p.WrapError(fmt.Errorf("%w: %s", ErrUnencryptedMessage, msg), msg.GetFrom())
+	}
+	return true, nil
+}
+
+// ParseWireMessage parses wire bytes received from `from` like the package-level ParseWireMessage,
+// first decrypting point-to-point messages that were encrypted for this party.
+// A message that cannot be decrypted names no culprit, as nothing shows that its claimed sender encrypted it.
+func (p *BaseParty) ParseWireMessage(wireBytes []byte, from *PartyID, isBroadcast bool) (ParsedMessage, *Error) {
+	wire, err := parseWire(wireBytes, from, isBroadcast)
+	if err != nil {
+		return nil, p.WrapError(fmt.Errorf("%w: %v", ErrInvalidMessage, err))
+	}
+	if len(wire.GetCiphertext()) > 0 {
+		if p.params == nil || p.params.IdentityKey() == nil {
+			return nil, p.WrapError(fmt.Errorf("%w: this party has no identity key", ErrDecryptionFailed))
+		}
+		if err := decryptWire(p.params, wire, from); err != nil {
+			return nil, p.WrapError(fmt.Errorf("%w: %v", ErrDecryptionFailed, err))
+		}
+	}
+	msg, err := parseWrappedMessage(wire, from)
+	if err != nil {
+		return nil, p.WrapError(fmt.Errorf("%w: %v", ErrInvalidMessage, err))
+	}
+	return msg, nil
+}
+
+// StoreMessageOnce puts msg at its sender's index in a round's message store.
+// Re-delivering the stored message is a no-op; a different message of the same type from the same sender is rejected, blaming the sender.
+func StoreMessageOnce(p Party, store []ParsedMessage, msg ParsedMessage) (bool, *Error) {
+	fromPIdx := msg.GetFrom().Index
+	if prev := store[fromPIdx]; prev != nil && prev != msg {
+		if prev.IsBroadcast() != msg.IsBroadcast() || !proto.Equal(prev.Content(), msg.Content()) {
+			return false, p.WrapError(fmt.Errorf("%w: %s", ErrDuplicateMessage, msg), msg.GetFrom())
+		}
+		return true, nil
 	}
+	store[fromPIdx] = msg
 	return true, nil
 }
 
@@ -85,9 +156,13 @@ func (p *BaseParty) String() string {
 // -----
 // Private lifecycle methods
 
+func (p *BaseParty) parameters() *Parameters {
+	return p.params
+}
+
 func (p *BaseParty) setRound(round Round) *Error {
 	if p.rnd != nil {
-		return p.WrapError(errors.New("a round is already set on this party"))
+		return p.WrapError(fmt.Errorf("%w: a round is already set on this party", ErrInternal))
 	}
 	p.rnd = round
 	return nil
@@ -109,75 +184,208 @@ func (p *BaseParty) unlock() {
 	p.mtx.Unlock()
 }
 
+func (p *BaseParty) watch() <-chan struct{} {
+	p.progress = make(chan struct{}, 1)
+	return p.progress
+}
+
+// notify wakes up StartWithContext, if it is waiting; it never blocks
+func (p *BaseParty) notify() {
+	if p.progress == nil {
+		return
+	}
+	select {
+	case p.progress <- struct{}{}:
+	default:
+	}
+}
+
+// stop records the first error a party started with a context fails with; other parties are unaffected
+func (p *BaseParty) stop(err *Error) *Error {
+	if p.progress != nil && p.err == nil {
+		p.err = err
+		p.notify()
+	}
+	return err
+}
+
+func (p *BaseParty) stopped() *Error {
+	return p.err
+}
+
 // ----- //
 
 func BaseStart(p Party, task string, prepare ...func(Round) *Error) *Error {
+	return baseStart(nil, p, task, prepare...)
+}
+
+// BaseStartWithContext is an implementation of StartWithContext that is shared across the different types of parties
+func BaseStartWithContext(ctx context.Context, p Party, task string, prepare ...func(Round) *Error) *Error {
+	// the derived context is cancelled on return so that in-flight proof work is abandoned
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	progress := p.watch()
+	if err := baseStart(ctx, p, task, prepare...); err != nil {
+		return err
+	}
+	var (
+		current Round
+		timer   *time.Timer
+		timeout <-chan time.Time
+	)
+	defer func() {
+		if timer != nil {
+			timer.Stop()
+		}
+	}()
+	for {
+		p.lock()
+		if err := p.stopped(); err != nil {
+			p.unlock()
+			return err
+		}
+		if p.round() == nil { // finished
+			p.unlock()
+			return nil
+		}
+		if rnd := p.round(); rnd != current {
+			current = rnd
+			if d := rnd.Params().RoundTimeout(); 0 < d {
+				if timer != nil {
+					timer.Stop()
+				}
+				timer = time.NewTimer(d)
+				timeout = timer.C
+			}
+		}
+		p.unlock()
+
+		select {
+		case <-progress:
+		case <-ctx.Done():
+			p.lock()
+			var culprits []*PartyID
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) && p.round() != nil {
+				culprits = nonResponders(p)
+			}
+			err := p.stop(p.WrapError(ctx.Err(), culprits...))
+			observeFailure(p, task, err)
+			p.unlock()
+			return err
+		case <-timeout:
+			p.lock()
+			if rnd := p.round(); rnd != nil && rnd == current && p.stopped() == nil {
+				observeFailure(p, task, p.stop(rnd.WrapError(ErrRoundTimeout, nonResponders(p)...)))
+			}
+			p.unlock()
+		}
+	}
+}
+
+// nonResponders lists the parties the current round is waiting for; a round may also list this party before it updates
+func nonResponders(p Party) []*PartyID {
+	waiting := p.round().WaitingFor()
+	culprits := make([]*PartyID, 0, len(waiting))
+	for _, Pj := range waiting {
+		if Pj.KeyInt().Cmp(p.PartyID().KeyInt()) != 0 {
+			culprits = append(culprits, Pj)
+		}
+	}
+	return culprits
+}
+
+func baseStart(ctx context.Context, p Party, task string, prepare ...func(Round) *Error) *Error {
 	p.lock()
 	defer p.unlock()
 	if p.PartyID() == nil || !p.PartyID().ValidateBasic() {
-		return p.WrapError(fmt.Errorf("could not start. this party has an invalid PartyID: %+v", p.PartyID()))
+		return p.WrapError(fmt.Errorf("%w: could not start. this party has an invalid PartyID: %+v", ErrInternal, p.PartyID()))
 	}
 	if p.round() != nil {
-		return p.WrapError(errors.New("could not start. this party is in an unexpected state. use the constructor and Start()"))
+		return p.WrapError(fmt.Errorf("%w: could not start. this party is in an unexpected state. use the constructor and Start()", ErrInternal))
 	}
 	round := p.FirstRound()
+	if err := round.Params().checkEncryption(); err != nil {
+		return p.WrapError(fmt.Errorf("%w: %v", ErrInternal, err))
+	}
+	if ctx != nil {
+		round.Params().setContext(ctx)
+	}
 	if err := p.setRound(round); err != nil {
 		return err
 	}
 	if 1 < len(prepare) {
-		return p.WrapError(errors.New("too many prepare functions given to Start(); 1 allowed"))
+		return p.WrapError(fmt.Errorf("%w: too many prepare functions given to Start(); 1 allowed", ErrInternal))
 	}
 	if len(prepare) == 1 {
 		if err := prepare[0](round); err != nil {
+			observeFailure(p, task, err)
 			return err
 		}
 	}
-	common.Logger.Infof("party %s: %s round %d starting", p.round().Params().PartyID(), task, 1)
+	p.round().Params().Logger().Infof("party %s: %s round %d starting", p.round().Params().PartyID(), task, 1)
 	defer func() {
-		common.Logger.Debugf("party %s: %s round %d finished", p.round().Params().PartyID(), task, 1)
+		p.round().Params().Logger().Debugf("party %s: %s round %d finished", p.round().Params().PartyID(), task, 1)
 	}()
-	return p.round().Start()
+	p.parameters().Metrics().startRound(p, task)
+	if err := p.round().Start(); err != nil {
+		observeFailure(p, task, err)
+		return err
+	}
+	observe(p, task, Event{Type: EventRoundStarted})
+	return nil
 }
 
 // an implementation of Update that is shared across the different types of parties (keygen, signing, dynamic groups)
 func BaseUpdate(p Party, msg ParsedMessage, task string) (ok bool, err *Error) {
 	// fast-fail on an invalid message; do not lock the mutex yet
 	if _, err := p.ValidateMessage(msg); err != nil {
-		return false, err
-	}
-	// lock the mutex. need this mtx unlock hook; L108 is recursive so cannot use defer
-	r := func(ok bool, err *Error) (bool, *Error) {
+		p.lock()
+		observeMessage(p, task, EventMessageRejected, msg, err)
 		p.unlock()
-		return ok, err
+		return false, err
 	}
+	// only valid messages are counted as traffic, so that forged or malformed ones cannot skew the metrics
+	p.parameters().Metrics().messageReceived(msg)
 	p.lock() // data is written to P state below
-	common.Logger.Debugf("party %s received message: %s", p.PartyID(), msg.String())
-	if p.round() != nil {
-		common.Logger.Debugf("party %s round %d update: %s", p.PartyID(), p.round().RoundNumber(), msg.String())
+	defer p.unlock()
+	if p.stopped() != nil {
+		return false, p.WrapError(ErrPartyStopped)
 	}
+	// log only the routing of the message; its content may carry secret shares
+	logger := p.parameters().Logger()
+	logger.Debugf("party %s received message: %s from %s", p.PartyID(), msg.Type(), msg.GetFrom())
 	if ok, err := p.StoreMessage(msg); err != nil || !ok {
-		return r(false, err)
+		observeMessage(p, task, EventMessageRejected, msg, err)
+		return false, err
 	}
-	if p.round() != nil {
-		common.Logger.Debugf("party %s: %s round %d update", p.round().Params().PartyID(), task, p.round().RoundNumber())
+	observeMessage(p, task, EventMessageStored, msg, nil)
+	// re-run the round update after each transition as the messages of the next round may already be stored
+	for p.round() != nil {
+		logger.Debugf("party %s: %s round %d update", p.round().Params().PartyID(), task, p.round().RoundNumber())
 		if _, err := p.round().Update(); err != nil {
-			return r(false, err)
+			observeFailure(p, task, err)
+			return false, p.stop(err)
 		}
-		if p.round().CanProceed() {
-			if p.advance(); p.round() != nil {
-				if err := p.round().Start(); err != nil {
-					return r(false, err)
-				}
-				rndNum := p.round().RoundNumber()
-				common.Logger.Infof("party %s: %s round %d started", p.round().Params().PartyID(), task, rndNum)
-			} else {
-				// finished! the round implementation will have sent the data through the `end` channel.
-				common.Logger.Infof("party %s: %s finished!", p.PartyID(), task)
+		if !p.round().CanProceed() {
+			break
+		}
+		p.notify()
+		p.parameters().Metrics().finishRound(p, task)
+		observe(p, task, Event{Type: EventRoundFinished})
+		if p.advance(); p.round() != nil {
+			p.parameters().Metrics().startRound(p, task)
+			if err := p.round().Start(); err != nil {
+				observeFailure(p, task, err)
+				return false, p.stop(err)
 			}
-			p.unlock()                      // recursive so can't defer after return
-			return BaseUpdate(p, msg, task) // re-run round update or finish)
+			rndNum := p.round().RoundNumber()
+			logger.Infof("party %s: %s round %d started", p.round().Params().PartyID(), task, rndNum)
+			observe(p, task, Event{Type: EventRoundStarted})
+		} else {
+			// finished! the round implementation will have sent the data through the `end` channel.
+			logger.Infof("party %s: %s finished!", p.PartyID(), task)
+			observe(p, task, Event{Type: EventPartyFinished})
 		}
-		return r(true, nil)
 	}
-	return r(true, nil)
+	return true, nil
 }
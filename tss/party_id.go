@@ -7,6 +7,7 @@
 package tss
 
 import (
+	"crypto/ed25519"
 	"fmt"
 	"math/big"
 	"sort"
@@ -52,6 +53,11 @@ func NewPartyID(id, moniker string, key *big.Int) *PartyID {
 	}
 }
 
+// SetIdentityKey sets the public key that messages from this party are authenticated with
+func (pid *PartyID) SetIdentityKey(key ed25519.PublicKey) {
+	pid.IdentityKey = key
+}
+
 func (pid PartyID) String() string {
 	return fmt.Sprintf("{%d,P[%s]}", pid.Index, pid.Moniker)
 }
@@ -0,0 +1,254 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/test"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// runKeygen runs an EdDSA keygen between `pIDs` with a threshold of 1 and returns the save data of every party by index.
+// `configure` is called with the parameters of each party before it is constructed, and `onMessage` with every message sent.
+func runKeygen(t *testing.T, pIDs tss.SortedPartyIDs, configure func(i int, params *tss.Parameters), onMessage func(msg tss.Message)) []keygen.LocalPartySaveData {
+	p2pCtx := tss.NewPeerContext(pIDs)
+	parties := make([]tss.Party, 0, len(pIDs))
+
+	outCh := make(chan tss.Message, len(pIDs)*len(pIDs))
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+	errCh := make(chan *tss.Error, len(pIDs)*len(pIDs))
+
+	for i := range pIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[i], len(pIDs), 1)
+		if configure != nil {
+			configure(i, params)
+		}
+		parties = append(parties, keygen.NewLocalParty(params, outCh, endCh))
+	}
+	for _, P := range parties {
+		if err := P.Start(); err != nil {
+			assert.FailNow(t, err.Error())
+		}
+	}
+	saves := make([]keygen.LocalPartySaveData, len(pIDs))
+	for ended := 0; ended < len(pIDs); {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case msg := <-outCh:
+			if onMessage != nil {
+				onMessage(msg)
+			}
+			for _, P := range parties {
+				if dest := msg.GetTo(); P.PartyID().Index != msg.GetFrom().Index && (dest == nil || dest[0].Index == P.PartyID().Index) {
+					test.SharedPartyUpdater(P, msg, errCh)
+				}
+			}
+		case save := <-endCh:
+			index, err := save.OriginalIndex()
+			assert.NoError(t, err)
+			saves[index] = save
+			ended++
+		}
+	}
+	return saves
+}
+
+func TestRoundTimeoutBlamesNonResponders(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(3)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[0], len(pIDs), 1)
+	params.SetRoundTimeout(100 * time.Millisecond)
+
+	outCh := make(chan tss.Message, len(pIDs))
+	P := keygen.NewLocalParty(params, outCh, nil)
+
+	// only one of the two other parties responds in round 1
+	errCh := make(chan *tss.Error, 1)
+	go func() {
+		errCh <- P.StartWithContext(context.Background())
+	}()
+	<-outCh
+	P2 := keygen.NewLocalParty(tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[1], len(pIDs), 1), outCh, nil)
+	assert.Nil(t, P2.Start())
+	msg := (<-outCh).(tss.ParsedMessage)
+	ok, err := P.Update(msg)
+	assert.True(t, ok)
+	assert.Nil(t, err)
+
+	err = <-errCh
+	if !assert.NotNil(t, err) {
+		return
+	}
+	assert.True(t, errors.Is(err, tss.ErrRoundTimeout))
+	assert.Equal(t, 1, err.Round())
+	assert.Equal(t, []*tss.PartyID{pIDs[2]}, err.Culprits())
+
+	// a stopped party accepts no further messages
+	_, err = P.Update(msg)
+	assert.True(t, errors.Is(err, tss.ErrPartyStopped))
+}
+
+func TestStartWithContextCancelled(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(3)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[0], len(pIDs), 1)
+
+	outCh := make(chan tss.Message, len(pIDs))
+	P := keygen.NewLocalParty(params, outCh, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	err := P.StartWithContext(ctx)
+	if !assert.NotNil(t, err) {
+		return
+	}
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Equal(t, []*tss.PartyID{pIDs[1], pIDs[2]}, err.Culprits())
+}
+
+// the last round expects no messages, so it must mark every party as done for StartWithContext to return
+func TestStartWithContextFinishes(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(3)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	parties := make([]tss.Party, 0, len(pIDs))
+
+	outCh := make(chan tss.Message, len(pIDs)*len(pIDs))
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+	errCh := make(chan *tss.Error, len(pIDs))
+	for i := range pIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[i], len(pIDs), 1)
+		params.SetRoundTimeout(5 * time.Second)
+		parties = append(parties, keygen.NewLocalParty(params, outCh, endCh))
+	}
+	for _, P := range parties {
+		go func(P tss.Party) {
+			errCh <- P.StartWithContext(context.Background())
+		}(P)
+	}
+	// route the messages only once every party has started and sent its round 1 broadcast
+	msgs := make([]tss.Message, 0, len(pIDs))
+	for range pIDs {
+		msgs = append(msgs, <-outCh)
+	}
+	route := func(msg tss.Message) {
+		for _, P := range parties {
+			if dest := msg.GetTo(); P.PartyID().Index != msg.GetFrom().Index && (dest == nil || dest[0].Index == P.PartyID().Index) {
+				if _, err := P.Update(msg.(tss.ParsedMessage)); err != nil {
+					assert.FailNow(t, err.Error())
+				}
+			}
+		}
+	}
+	for _, msg := range msgs {
+		route(msg)
+	}
+	for ended := 0; ended < len(pIDs); {
+		select {
+		case msg := <-outCh:
+			route(msg)
+		case <-endCh:
+			ended++
+		}
+	}
+	for range pIDs {
+		select {
+		case err := <-errCh:
+			assert.Nil(t, err)
+		case <-time.After(time.Second):
+			assert.FailNow(t, "StartWithContext should return once the party has finished")
+		}
+	}
+}
+
+func TestSessionMismatchCulprit(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(2)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	params0 := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[0], len(pIDs), 1)
+	params0.SetSessionID([]byte("session-1"))
+
+	out := make(chan tss.Message, len(pIDs))
+	if err := keygen.NewLocalParty(params0, out, nil).Start(); err != nil {
+		assert.FailNow(t, err.Error())
+	}
+	bz, _, err := (<-out).WireBytes()
+	assert.NoError(t, err)
+
+	// a party in the same session accepts the message
+	params1 := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[1], len(pIDs), 1)
+	params1.SetSessionID([]byte("session-1"))
+	ok, err2 := keygen.NewLocalParty(params1, out, nil).UpdateFromBytes(bz, pIDs[0], true)
+	assert.True(t, ok)
+	assert.Nil(t, err2)
+
+	// a party in another session, or in none, rejects it and blames the sender
+	for _, session := range [][]byte{[]byte("session-2"), nil} {
+		params2 := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[1], len(pIDs), 1)
+		params2.SetSessionID(session)
+		ok, err2 = keygen.NewLocalParty(params2, out, nil).UpdateFromBytes(bz, pIDs[0], true)
+		assert.False(t, ok)
+		if !assert.NotNil(t, err2) {
+			return
+		}
+		assert.True(t, errors.Is(err2, tss.ErrSessionMismatch))
+		assert.Equal(t, []*tss.PartyID{pIDs[0]}, err2.Culprits())
+	}
+}
+
+func TestStoreMessageOnce(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(2)
+	params := tss.NewParameters(tss.Edwards(), tss.NewPeerContext(pIDs), pIDs[0], len(pIDs), 1)
+	party := keygen.NewLocalParty(params, nil, nil)
+	newMsg := func(commitment byte, isBroadcast bool) tss.ParsedMessage {
+		meta := tss.MessageRouting{From: pIDs[1], IsBroadcast: isBroadcast}
+		if !isBroadcast {
+			meta.To = []*tss.PartyID{pIDs[0]}
+		}
+		content := &keygen.KGRound1Message{Commitment: []byte{commitment}}
+		return tss.NewMessage(meta, content, tss.NewMessageWrapper(meta, content))
+	}
+
+	stored := newMsg(1, true)
+	tests := []struct {
+		name      string
+		msg       tss.ParsedMessage
+		wantError bool
+	}{
+		{"the stored message again", stored, false},
+		{"an identical re-delivery", newMsg(1, true), false},
+		{"different content", newMsg(2, true), true},
+		{"the same content sent P2P", newMsg(1, false), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := make([]tss.ParsedMessage, len(pIDs))
+			ok, err := tss.StoreMessageOnce(party, store, stored)
+			assert.True(t, ok)
+			assert.Nil(t, err)
+
+			ok, err = tss.StoreMessageOnce(party, store, tt.msg)
+			if !tt.wantError {
+				assert.True(t, ok)
+				assert.Nil(t, err)
+			} else {
+				assert.False(t, ok)
+				if assert.NotNil(t, err) {
+					assert.True(t, errors.Is(err, tss.ErrDuplicateMessage))
+					assert.Equal(t, []*tss.PartyID{pIDs[1]}, err.Culprits())
+				}
+			}
+			assert.Same(t, stored, store[pIDs[1].Index], "the first message should stay in the store")
+		})
+	}
+}
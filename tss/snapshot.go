@@ -0,0 +1,162 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// SnapshotVersion is the version of the snapshot format written by BaseSnapshot. Snapshots of other versions are rejected.
+const SnapshotVersion = 1
+
+// snapshotEnvelope is the encoding of a snapshot; the protocol state is sealed with AES-256-GCM in `ciphertext`
+type snapshotEnvelope struct {
+	Version    int    `json:"version"`
+	Task       string `json:"task"`
+	Round      int    `json:"round"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// BaseSnapshot is an implementation of a party snapshot that is shared across the different types of parties.
+// `export` is called with the party locked and the round it is in, and returns the JSON-serializable protocol state to save.
+// The snapshot is encrypted with `key`, which must be 32 bytes.
+func BaseSnapshot(p Party, task string, key []byte, export func(Round) (interface{}, error)) ([]byte, *Error) {
+	p.lock()
+	defer p.unlock()
+	if err := p.stopped(); err != nil {
+		return nil, p.WrapError(fmt.Errorf("%w: the party has stopped", ErrInvalidSnapshot))
+	}
+	if p.round() == nil {
+		return nil, p.WrapError(fmt.Errorf("%w: the party is not running", ErrInvalidSnapshot))
+	}
+	state, err := export(p.round())
+	if err != nil {
+		return nil, p.WrapError(err)
+	}
+	plaintext, err := json.Marshal(state)
+	if err != nil {
+		return nil, p.WrapError(err)
+	}
+	env := &snapshotEnvelope{Version: SnapshotVersion, Task: task, Round: p.round().RoundNumber()}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, p.WrapError(err)
+	}
+	env.Nonce = make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, env.Nonce); err != nil {
+		return nil, p.WrapError(err)
+	}
+	env.Ciphertext = aead.Seal(nil, env.Nonce, plaintext, env.additionalData())
+	bz, err := json.Marshal(env)
+	if err != nil {
+		return nil, p.WrapError(err)
+	}
+	return bz, nil
+}
+
+// BaseResume is an implementation of resuming a party from a snapshot that is shared across the different types of parties.
+// The party must be freshly constructed with the same parameters as the one the snapshot was taken from, and not started.
+// `restore` is called with the party locked and the saved protocol state, and returns the round to continue from.
+func BaseResume(p Party, task string, key, snapshot []byte, restore func(state []byte) (Round, error)) *Error {
+	p.lock()
+	defer p.unlock()
+	if p.round() != nil {
+		return p.WrapError(fmt.Errorf("%w: could not resume. this party has already started", ErrInternal))
+	}
+	env := new(snapshotEnvelope)
+	if err := json.Unmarshal(snapshot, env); err != nil {
+		return p.WrapError(fmt.Errorf("%w: %v", ErrInvalidSnapshot, err))
+	}
+	if env.Version != SnapshotVersion {
+		return p.WrapError(fmt.Errorf("%w: unsupported version %d", ErrInvalidSnapshot, env.Version))
+	}
+	if env.Task != task {
+		return p.WrapError(fmt.Errorf("%w: the snapshot is of a %s party", ErrInvalidSnapshot, env.Task))
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return p.WrapError(err)
+	}
+	if len(env.Nonce) != aead.NonceSize() {
+		return p.WrapError(fmt.Errorf("%w: bad nonce", ErrInvalidSnapshot))
+	}
+	plaintext, err := aead.Open(nil, env.Nonce, env.Ciphertext, env.additionalData())
+	if err != nil {
+		return p.WrapError(fmt.Errorf("%w: %v", ErrInvalidSnapshot, err))
+	}
+	round, err := restore(plaintext)
+	if err != nil {
+		return p.WrapError(fmt.Errorf("%w: %v", ErrInvalidSnapshot, err))
+	}
+	if round.RoundNumber() != env.Round {
+		return p.WrapError(fmt.Errorf("%w: restored round %d, expected %d", ErrInvalidSnapshot, round.RoundNumber(), env.Round))
+	}
+	return p.setRound(round)
+}
+
+func (env *snapshotEnvelope) additionalData() []byte {
+	return []byte("tss-lib snapshot/" + strconv.Itoa(env.Version) + "/" + env.Task + "/" + strconv.Itoa(env.Round))
+}
+
+// ----- //
+
+// MarshalMessageStore encodes a round's message store for a snapshot; empty slots stay empty
+func MarshalMessageStore(store []ParsedMessage) ([][]byte, error) {
+	bzs := make([][]byte, len(store))
+	for i, msg := range store {
+		if msg == nil {
+			continue
+		}
+		bz, err := proto.Marshal(msg.WireMsg())
+		if err != nil {
+			return nil, err
+		}
+		bzs[i] = bz
+	}
+	return bzs, nil
+}
+
+// UnmarshalMessageStore decodes a message store encoded by MarshalMessageStore.
+// The sender of each message is looked up by key in `parties` and must have the index of the slot the message was stored in.
+func UnmarshalMessageStore(bzs [][]byte, parties []*PartyID) ([]ParsedMessage, error) {
+	store := make([]ParsedMessage, len(bzs))
+	for i, bz := range bzs {
+		if bz == nil {
+			continue
+		}
+		wire := new(MessageWrapper)
+		if err := proto.Unmarshal(bz, wire); err != nil {
+			return nil, err
+		}
+		if wire.GetFrom() == nil {
+			return nil, fmt.Errorf("stored message %d has no sender", i)
+		}
+		var from *PartyID
+		for _, Pj := range parties {
+			if Pj.KeyInt().Cmp(wire.GetFrom().KeyInt()) == 0 && Pj.Index == i {
+				from = Pj
+				break
+			}
+		}
+		if from == nil {
+			return nil, fmt.Errorf("stored message %d is from an unknown party", i)
+		}
+		msg, err := parseWrappedMessage(wire, from)
+		if err != nil {
+			return nil, err
+		}
+		store[i] = msg
+	}
+	return store, nil
+}
@@ -0,0 +1,101 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+func TestSnapshotEnvelope(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(2)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	key := make([]byte, 32)
+	newParty := func() *keygen.LocalParty {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[0], len(pIDs), 1)
+		return keygen.NewLocalParty(params, make(chan tss.Message, len(pIDs)), nil).(*keygen.LocalParty)
+	}
+
+	// a party that has not started has nothing to save
+	P := newParty()
+	_, err := P.Snapshot(key)
+	if assert.NotNil(t, err) {
+		assert.True(t, errors.Is(err, tss.ErrInvalidSnapshot))
+	}
+	assert.Nil(t, P.Start())
+	snapshot, err := P.Snapshot(key)
+	assert.Nil(t, err)
+
+	// the snapshot is rejected when its envelope is altered or it is opened with another key
+	alter := func(field string, value interface{}) []byte {
+		env := make(map[string]interface{})
+		assert.NoError(t, json.Unmarshal(snapshot, &env))
+		env[field] = value
+		bz, err := json.Marshal(env)
+		assert.NoError(t, err)
+		return bz
+	}
+	otherKey := make([]byte, 32)
+	otherKey[0] = 1
+	for name, resume := range map[string]func(P *keygen.LocalParty) *tss.Error{
+		"other key":     func(P *keygen.LocalParty) *tss.Error { return P.Resume(otherKey, snapshot) },
+		"old version":   func(P *keygen.LocalParty) *tss.Error { return P.Resume(key, alter("version", tss.SnapshotVersion-1)) },
+		"other task":    func(P *keygen.LocalParty) *tss.Error { return P.Resume(key, alter("task", "eddsa-signing")) },
+		"other round":   func(P *keygen.LocalParty) *tss.Error { return P.Resume(key, alter("round", 2)) },
+		"not json":      func(P *keygen.LocalParty) *tss.Error { return P.Resume(key, []byte("snapshot")) },
+		"short key":     func(P *keygen.LocalParty) *tss.Error { return P.Resume(key[:16], snapshot) },
+		"missing nonce": func(P *keygen.LocalParty) *tss.Error { return P.Resume(key, alter("nonce", nil)) },
+	} {
+		err := resume(newParty())
+		if assert.NotNil(t, err, name) {
+			assert.True(t, errors.Is(err, tss.ErrInvalidSnapshot), name)
+		}
+	}
+
+	// it restores a fresh party into the round it was taken in, but not one that has started
+	assert.Nil(t, newParty().Resume(key, snapshot))
+	err = P.Resume(key, snapshot)
+	if assert.NotNil(t, err) {
+		assert.True(t, errors.Is(err, tss.ErrInternal))
+	}
+}
+
+func TestMarshalMessageStore(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(3)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[1], len(pIDs), 1)
+	params.SetSessionID([]byte("session"))
+
+	store := make([]tss.ParsedMessage, len(pIDs))
+	store[1] = sendShare(t, params, pIDs[1], pIDs[0])
+	bzs, err := tss.MarshalMessageStore(store)
+	assert.NoError(t, err)
+
+	// empty slots stay empty and the stored message keeps its sender and session
+	restored, err := tss.UnmarshalMessageStore(bzs, pIDs)
+	if assert.NoError(t, err) {
+		assert.Nil(t, restored[0])
+		assert.Nil(t, restored[2])
+		assert.Equal(t, pIDs[1], restored[1].GetFrom())
+		assert.Equal(t, []byte("session"), restored[1].WireMsg().GetSessionId())
+		assert.True(t, proto.Equal(store[1].Content(), restored[1].Content()))
+	}
+
+	// a message stored in the slot of another party, or from an unknown party, is rejected
+	bzs[0], bzs[1] = bzs[1], nil
+	_, err = tss.UnmarshalMessageStore(bzs, pIDs)
+	assert.Error(t, err)
+	_, err = tss.UnmarshalMessageStore(bzs, tss.GenerateTestPartyIDs(3, 3))
+	assert.Error(t, err)
+}
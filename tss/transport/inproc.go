@@ -0,0 +1,153 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package transport
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+type (
+	// InProcRouter routes messages between parties running in the same process
+	InProcRouter struct {
+		mtx     sync.RWMutex
+		parties map[string]registered
+		errCh   chan<- *tss.Error
+		wg      sync.WaitGroup
+		done    chan struct{}
+		closed  bool
+	}
+
+	registered struct {
+		party     tss.Party
+		committee Committee
+	}
+)
+
+var _ Router = (*InProcRouter)(nil)
+
+// NewInProcRouter returns a router for parties of the same process.
+// The errors returned by the parties when they process a message are sent to `errCh`.
+func NewInProcRouter(errCh chan<- *tss.Error) *InProcRouter {
+	return &InProcRouter{
+		parties: make(map[string]registered),
+		errCh:   errCh,
+		done:    make(chan struct{}),
+	}
+}
+
+func (r *InProcRouter) Register(party tss.Party) error {
+	return r.register(party, NewCommittee)
+}
+
+func (r *InProcRouter) RegisterOldCommittee(party tss.Party) error {
+	return r.register(party, OldCommittee)
+}
+
+func (r *InProcRouter) Route(msg tss.Message) error {
+	if r.isClosed() {
+		return ErrClosed
+	}
+	bz, routing, err := msg.WireBytes()
+	if err != nil {
+		return err
+	}
+	delivered := r.deliver(bz, routing)
+	if routing.To == nil {
+		return nil
+	}
+	for _, to := range routing.To {
+		if _, ok := delivered[partyKey(to)]; !ok && isAddressedTo(routing, partyKey(to)) {
+			return ErrUnknownParty
+		}
+	}
+	return nil
+}
+
+// Close waits for the messages being delivered; the errors they cause are dropped once closed
+func (r *InProcRouter) Close() error {
+	r.mtx.Lock()
+	if r.closed {
+		r.mtx.Unlock()
+		return ErrClosed
+	}
+	r.closed = true
+	close(r.done)
+	r.mtx.Unlock()
+	r.wg.Wait()
+	return nil
+}
+
+// ----- //
+
+func (r *InProcRouter) register(party tss.Party, committee Committee) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if r.closed {
+		return ErrClosed
+	}
+	key := partyKey(party.PartyID())
+	if _, ok := r.parties[key]; ok {
+		return errors.New("transport: a party with the same key is already registered")
+	}
+	r.parties[key] = registered{party, committee}
+	return nil
+}
+
+func (r *InProcRouter) isClosed() bool {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	return r.closed
+}
+
+// lookup returns the local party with `key`, if any
+func (r *InProcRouter) lookup(key string) (registered, bool) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	reg, ok := r.parties[key]
+	return reg, ok
+}
+
+// deliver hands the wire bytes of a message to each local recipient asynchronously and returns the keys of the local parties
+// addressed, whether or not their committee receives the message
+func (r *InProcRouter) deliver(bz []byte, routing *tss.MessageRouting) map[string]struct{} {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	addressed := make(map[string]struct{})
+	if r.closed {
+		return addressed
+	}
+	for key, reg := range r.parties {
+		if !isAddressedTo(routing, key) {
+			continue
+		}
+		addressed[key] = struct{}{}
+		if !isRecipient(routing, key, reg.committee) {
+			continue
+		}
+		r.wg.Add(1)
+		go func(party tss.Party) {
+			defer r.wg.Done()
+			if _, err := party.UpdateFromBytes(bz, routing.From, routing.IsBroadcast); err != nil {
+				r.report(err)
+			}
+		}(reg.party)
+	}
+	return addressed
+}
+
+func (r *InProcRouter) report(err *tss.Error) {
+	if r.errCh == nil {
+		return
+	}
+	select {
+	case r.errCh <- err:
+	case <-r.done:
+	}
+}
@@ -0,0 +1,377 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package transport
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+const (
+	// TaskName is the task of the errors raised by the transport itself rather than by a party
+	TaskName = "transport"
+
+	// the largest frame accepted from a peer; the biggest messages of the protocols are a few hundred KiB
+	maxFrameLen = 16 << 20
+)
+
+const (
+	flagBroadcast byte = 1 << iota
+	flagToOldCommittee
+	flagToOldAndNewCommittees
+)
+
+type (
+	// SocketRouter routes messages between parties hosted by different processes over stream sockets, e.g. TCP or Unix domain sockets.
+	// Messages between the parties registered with the same router are delivered in-process.
+	SocketRouter struct {
+		local    *InProcRouter
+		listener net.Listener
+
+		mtx    sync.Mutex
+		peers  map[string]peer
+		conns  map[string]*socketConn
+		accept map[net.Conn]struct{}
+		closed bool
+		wg     sync.WaitGroup
+	}
+
+	// peer is a party hosted by another router
+	peer struct {
+		pID              *tss.PartyID
+		network, address string
+	}
+
+	socketConn struct {
+		mtx  sync.Mutex
+		conn net.Conn
+		w    *bufio.Writer
+	}
+)
+
+var _ Router = (*SocketRouter)(nil)
+
+// NewSocketRouter returns a router that receives messages for its parties on `listener` and starts accepting connections.
+// The errors returned by the parties when they process a message, and those met reading from a connection, are sent to `errCh`.
+//
+// The sender named in a frame is not authenticated: anyone able to connect to `listener` may send a message in the name
+// of any party. The parties tell a forged sender apart by the signatures of their identity keys, which they check against
+// the key of the claimed sender, so give every party an identity key unless the connections are authenticated by other means.
+func NewSocketRouter(listener net.Listener, errCh chan<- *tss.Error) *SocketRouter {
+	r := &SocketRouter{
+		local:    NewInProcRouter(errCh),
+		listener: listener,
+		peers:    make(map[string]peer),
+		conns:    make(map[string]*socketConn),
+		accept:   make(map[net.Conn]struct{}),
+	}
+	r.wg.Add(1)
+	go r.acceptLoop()
+	return r
+}
+
+// Addr is the address that other routers should use to reach the parties of this router
+func (r *SocketRouter) Addr() net.Addr {
+	return r.listener.Addr()
+}
+
+// AddPeer declares a party hosted by the router listening at `address` on `network`, e.g. "tcp" or "unix".
+// Every party that a local party sends messages to or receives messages from must be either registered or added as a peer.
+func (r *SocketRouter) AddPeer(pID *tss.PartyID, network, address string) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.peers[partyKey(pID)] = peer{pID, network, address}
+}
+
+func (r *SocketRouter) Register(party tss.Party) error {
+	return r.local.Register(party)
+}
+
+func (r *SocketRouter) RegisterOldCommittee(party tss.Party) error {
+	return r.local.RegisterOldCommittee(party)
+}
+
+func (r *SocketRouter) Route(msg tss.Message) error {
+	if r.isClosed() {
+		return ErrClosed
+	}
+	bz, routing, err := msg.WireBytes()
+	if err != nil {
+		return err
+	}
+	delivered := r.local.deliver(bz, routing)
+
+	r.mtx.Lock()
+	if r.closed {
+		r.mtx.Unlock()
+		return ErrClosed
+	}
+	remote := make([]peer, 0, len(r.peers))
+	for key, p := range r.peers {
+		if _, ok := delivered[key]; !ok && isAddressedTo(routing, key) {
+			remote = append(remote, p)
+			delivered[key] = struct{}{}
+		}
+	}
+	r.mtx.Unlock()
+	for _, to := range routing.To {
+		if _, ok := delivered[partyKey(to)]; !ok && isAddressedTo(routing, partyKey(to)) {
+			return ErrUnknownParty
+		}
+	}
+
+	for _, p := range remote {
+		conn, err := r.dial(p)
+		if err != nil {
+			return err
+		}
+		if err = conn.writeFrame(routing, p.pID, bz); err != nil {
+			r.dropConn(p, conn)
+			return err
+		}
+	}
+	return nil
+}
+
+// Close stops accepting connections, closes those open and waits for the messages being delivered
+func (r *SocketRouter) Close() error {
+	r.mtx.Lock()
+	if r.closed {
+		r.mtx.Unlock()
+		return ErrClosed
+	}
+	r.closed = true
+	err := r.listener.Close()
+	for _, conn := range r.conns {
+		_ = conn.conn.Close()
+	}
+	for conn := range r.accept {
+		_ = conn.Close()
+	}
+	r.mtx.Unlock()
+	r.wg.Wait()
+	_ = r.local.Close()
+	return err
+}
+
+// ----- //
+
+func (r *SocketRouter) dial(p peer) (*socketConn, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if r.closed {
+		return nil, ErrClosed
+	}
+	addr := p.network + "://" + p.address
+	if conn, ok := r.conns[addr]; ok {
+		return conn, nil
+	}
+	conn, err := net.Dial(p.network, p.address)
+	if err != nil {
+		return nil, err
+	}
+	sc := &socketConn{conn: conn, w: bufio.NewWriter(conn)}
+	r.conns[addr] = sc
+	return sc, nil
+}
+
+// dropConn forgets a broken connection so that the next message to the peer dials again
+func (r *SocketRouter) dropConn(p peer, conn *socketConn) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	addr := p.network + "://" + p.address
+	if r.conns[addr] == conn {
+		delete(r.conns, addr)
+	}
+	_ = conn.conn.Close()
+}
+
+func (r *SocketRouter) acceptLoop() {
+	defer r.wg.Done()
+	for {
+		conn, err := r.listener.Accept()
+		if err != nil {
+			return
+		}
+		r.mtx.Lock()
+		if r.closed {
+			r.mtx.Unlock()
+			_ = conn.Close()
+			return
+		}
+		r.accept[conn] = struct{}{}
+		r.wg.Add(1)
+		r.mtx.Unlock()
+		go r.readLoop(conn)
+	}
+}
+
+func (r *SocketRouter) readLoop(conn net.Conn) {
+	defer r.wg.Done()
+	defer func() {
+		r.mtx.Lock()
+		delete(r.accept, conn)
+		r.mtx.Unlock()
+		_ = conn.Close()
+	}()
+	rd := bufio.NewReader(conn)
+	for {
+		f, err := readFrame(rd)
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !r.isClosed() {
+				r.report(tss.NewError(err, TaskName, 0, nil))
+			}
+			return
+		}
+		r.receive(f)
+	}
+}
+
+// receive delivers a frame read from a connection to the local party it is addressed to.
+// The sender is taken from the frame as is; see NewSocketRouter.
+func (r *SocketRouter) receive(f *frame) {
+	to, ok := r.local.lookup(f.to)
+	if !ok {
+		r.report(tss.NewError(fmt.Errorf("%w: message for party %x", ErrUnknownParty, f.to), TaskName, 0, nil))
+		return
+	}
+	from := r.partyID(f.from)
+	if from == nil {
+		r.report(to.party.WrapError(fmt.Errorf("%w: message from party %x", ErrUnknownParty, f.from)))
+		return
+	}
+	routing := &tss.MessageRouting{
+		From:                    from,
+		To:                      []*tss.PartyID{to.party.PartyID()},
+		IsBroadcast:             f.flags&flagBroadcast != 0,
+		IsToOldCommittee:        f.flags&flagToOldCommittee != 0,
+		IsToOldAndNewCommittees: f.flags&flagToOldAndNewCommittees != 0,
+	}
+	r.local.deliver(f.payload, routing)
+}
+
+// partyID resolves the key of a sender to the PartyID known for it, whether it is a peer or a local party
+func (r *SocketRouter) partyID(key string) *tss.PartyID {
+	r.mtx.Lock()
+	p, ok := r.peers[key]
+	r.mtx.Unlock()
+	if ok {
+		return p.pID
+	}
+	if reg, ok := r.local.lookup(key); ok {
+		return reg.party.PartyID()
+	}
+	return nil
+}
+
+func (r *SocketRouter) isClosed() bool {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return r.closed
+}
+
+func (r *SocketRouter) report(err *tss.Error) {
+	if !r.isClosed() {
+		r.local.report(err)
+	}
+}
+
+// ----- //
+
+// frame is a message addressed to a single party; on the wire it is laid out as
+//
+//	length (4) | flags (1) | len(from) (2) | from | len(to) (2) | to | payload
+//
+// where the length counts the bytes that follow it and all integers are big-endian
+type frame struct {
+	flags    byte
+	from, to string
+	payload  []byte
+}
+
+func (c *socketConn) writeFrame(routing *tss.MessageRouting, to *tss.PartyID, payload []byte) error {
+	var flags byte
+	if routing.IsBroadcast {
+		flags |= flagBroadcast
+	}
+	if routing.IsToOldCommittee {
+		flags |= flagToOldCommittee
+	}
+	if routing.IsToOldAndNewCommittees {
+		flags |= flagToOldAndNewCommittees
+	}
+	from, dest := routing.From.GetKey(), to.GetKey()
+	length := 1 + 2 + len(from) + 2 + len(dest) + len(payload)
+	if length > maxFrameLen || len(from) > 0xffff || len(dest) > 0xffff {
+		return errors.New("transport: message too large")
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	var hdr [4]byte
+	binary.BigEndian.PutUint32(hdr[:], uint32(length))
+	_, _ = c.w.Write(hdr[:])
+	_ = c.w.WriteByte(flags)
+	writeField(c.w, from)
+	writeField(c.w, dest)
+	_, _ = c.w.Write(payload)
+	return c.w.Flush()
+}
+
+func writeField(w *bufio.Writer, field []byte) {
+	var l [2]byte
+	binary.BigEndian.PutUint16(l[:], uint16(len(field)))
+	_, _ = w.Write(l[:])
+	_, _ = w.Write(field)
+}
+
+func readFrame(rd io.Reader) (*frame, error) {
+	var hdr [4]byte
+	if _, err := io.ReadFull(rd, hdr[:]); err != nil {
+		return nil, err
+	}
+	length := binary.BigEndian.Uint32(hdr[:])
+	if length > maxFrameLen {
+		return nil, errors.New("transport: frame too large")
+	}
+	buf := make([]byte, length)
+	if _, err := io.ReadFull(rd, buf); err != nil {
+		return nil, err
+	}
+	f := &frame{}
+	var ok bool
+	if len(buf) < 1 {
+		return nil, errors.New("transport: malformed frame")
+	}
+	f.flags, buf = buf[0], buf[1:]
+	if f.from, buf, ok = readField(buf); !ok {
+		return nil, errors.New("transport: malformed frame")
+	}
+	if f.to, buf, ok = readField(buf); !ok {
+		return nil, errors.New("transport: malformed frame")
+	}
+	f.payload = buf
+	return f, nil
+}
+
+func readField(buf []byte) (string, []byte, bool) {
+	if len(buf) < 2 {
+		return "", nil, false
+	}
+	l := int(binary.BigEndian.Uint16(buf))
+	if len(buf) < 2+l {
+		return "", nil, false
+	}
+	return string(buf[2 : 2+l]), buf[2+l:], true
+}
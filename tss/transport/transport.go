@@ -0,0 +1,106 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+// Package transport wires parties together so that the messages sent by each party reach the parties they are addressed to.
+package transport
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+const (
+	// NewCommittee is the committee of keygen and signing parties, and of the parties receiving new shares during re-sharing
+	NewCommittee Committee = iota
+	// OldCommittee is the committee of the parties handing over their shares during re-sharing
+	OldCommittee
+)
+
+var (
+	// ErrClosed is returned when routing a message through a router that has been closed
+	ErrClosed = errors.New("transport: router is closed")
+	// ErrUnknownParty is returned when a message is addressed to a party that the router does not know
+	ErrUnknownParty = errors.New("transport: unknown party")
+)
+
+type (
+	// Committee is the committee a party belongs to, which decides the messages of re-sharing that it receives
+	Committee int
+
+	// Router delivers the messages sent by parties to the parties they are addressed to.
+	// Parties are told apart by their keys, which must be unique across the old and new committees of a re-sharing.
+	Router interface {
+		// Register attaches a party of the new committee, or a keygen or signing party, to receive the messages addressed to it
+		Register(party tss.Party) error
+		// RegisterOldCommittee attaches a party of the old committee of a re-sharing to receive the messages addressed to it
+		RegisterOldCommittee(party tss.Party) error
+		// Route delivers a message sent by a party to every recipient of the message.
+		// Failures of the recipients to process the message are reported on the error channel of the router.
+		Route(msg tss.Message) error
+		// Close stops routing messages and releases the resources held by the router
+		Close() error
+	}
+)
+
+// Forward routes the messages that parties send to `out` through `router` until `out` is closed or `ctx` is done.
+// It returns the first error met while routing a message.
+func Forward(ctx context.Context, router Router, out <-chan tss.Message) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg, ok := <-out:
+			if !ok {
+				return nil
+			}
+			if err := router.Route(msg); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// IsRecipient reports whether the party `pID` of `committee` should receive a message with `routing`.
+// It lets other implementations of Router, e.g. test harnesses, route the messages of re-sharing like the routers of this package.
+func IsRecipient(routing *tss.MessageRouting, pID *tss.PartyID, committee Committee) bool {
+	return isRecipient(routing, partyKey(pID), committee)
+}
+
+// ----- //
+
+func partyKey(pID *tss.PartyID) string {
+	return string(pID.GetKey())
+}
+
+// isRecipient reports whether the party with `key` in `committee` should receive a message with `routing`
+func isRecipient(routing *tss.MessageRouting, key string, committee Committee) bool {
+	if routing.From != nil && partyKey(routing.From) == key {
+		return false
+	}
+	toOld, toBoth := routing.IsToOldCommittee, routing.IsToOldAndNewCommittees
+	if committee == OldCommittee && !(toOld || toBoth) || committee == NewCommittee && toOld && !toBoth {
+		return false
+	}
+	return isAddressedTo(routing, key)
+}
+
+// isAddressedTo reports whether the party with `key` is among the recipients of a message with `routing`, whatever its committee
+func isAddressedTo(routing *tss.MessageRouting, key string) bool {
+	if routing.From != nil && partyKey(routing.From) == key {
+		return false
+	}
+	if routing.To == nil {
+		return true
+	}
+	for _, to := range routing.To {
+		if partyKey(to) == key {
+			return true
+		}
+	}
+	return false
+}
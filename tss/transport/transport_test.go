@@ -0,0 +1,281 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package transport_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/eddsa/resharing"
+	"github.com/bnb-chain/tss-lib/test"
+	"github.com/bnb-chain/tss-lib/tss"
+	"github.com/bnb-chain/tss-lib/tss/transport"
+)
+
+func setUp(level string) {
+	if err := log.SetLogLevel("tss-lib", level); err != nil {
+		panic(err)
+	}
+
+	// only for test
+	tss.SetCurve(tss.Edwards())
+}
+
+func listen(t *testing.T) net.Listener {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return listener
+}
+
+// startAll starts every party before any message is routed, as a party ignores the messages delivered before it starts
+func startAll(t *testing.T, parties []tss.Party) {
+	for _, P := range parties {
+		if err := P.Start(); err != nil {
+			assert.FailNow(t, err.Error())
+		}
+	}
+}
+
+func forward(ctx context.Context, router transport.Router, out <-chan tss.Message, errCh chan<- *tss.Error) {
+	if err := transport.Forward(ctx, router, out); err != nil && ctx.Err() == nil {
+		errCh <- tss.NewError(err, transport.TaskName, 0, nil)
+	}
+}
+
+// waitForKeys collects the save data of `count` parties, failing on the first error
+func waitForKeys(t *testing.T, count int, endCh <-chan keygen.LocalPartySaveData, errCh <-chan *tss.Error) []keygen.LocalPartySaveData {
+	saves := make([]keygen.LocalPartySaveData, 0, count)
+	for len(saves) < count {
+		select {
+		case err := <-errCh:
+			assert.FailNow(t, err.Error())
+		case save := <-endCh:
+			saves = append(saves, save)
+		}
+	}
+	return saves
+}
+
+func TestInProcRouterKeygen(t *testing.T) {
+	setUp("info")
+
+	pIDs := tss.GenerateTestPartyIDs(3)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	outCh := make(chan tss.Message, len(pIDs))
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+	errCh := make(chan *tss.Error, len(pIDs))
+
+	router := transport.NewInProcRouter(errCh)
+	defer router.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	parties := make([]tss.Party, 0, len(pIDs))
+	for _, pID := range pIDs {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pID, len(pIDs), 1)
+		P := keygen.NewLocalParty(params, outCh, endCh)
+		assert.NoError(t, router.Register(P))
+		parties = append(parties, P)
+	}
+	startAll(t, parties)
+	go forward(ctx, router, outCh, errCh)
+
+	saves := waitForKeys(t, len(pIDs), endCh, errCh)
+	for _, save := range saves[1:] {
+		assert.True(t, save.EDDSAPub.Equals(saves[0].EDDSAPub), "all parties should derive the same public key")
+	}
+}
+
+func TestSocketRouterKeygen(t *testing.T) {
+	setUp("info")
+
+	pIDs := tss.GenerateTestPartyIDs(3)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	endCh := make(chan keygen.LocalPartySaveData, len(pIDs))
+	errCh := make(chan *tss.Error, len(pIDs))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// one router per party, as if each party ran in its own process
+	routers := make([]*transport.SocketRouter, len(pIDs))
+	for i := range pIDs {
+		routers[i] = transport.NewSocketRouter(listen(t), errCh)
+		defer routers[i].Close()
+	}
+	parties := make([]tss.Party, 0, len(pIDs))
+	outChs := make([]chan tss.Message, len(pIDs))
+	for i, pID := range pIDs {
+		for j, peer := range pIDs {
+			if j != i {
+				routers[i].AddPeer(peer, "tcp", routers[j].Addr().String())
+			}
+		}
+		outChs[i] = make(chan tss.Message, len(pIDs))
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pID, len(pIDs), 1)
+		P := keygen.NewLocalParty(params, outChs[i], endCh)
+		assert.NoError(t, routers[i].Register(P))
+		parties = append(parties, P)
+	}
+	startAll(t, parties)
+	for i, router := range routers {
+		go forward(ctx, router, outChs[i], errCh)
+	}
+
+	saves := waitForKeys(t, len(pIDs), endCh, errCh)
+	for _, save := range saves[1:] {
+		assert.True(t, save.EDDSAPub.Equals(saves[0].EDDSAPub), "all parties should derive the same public key")
+	}
+}
+
+func TestSocketRouterResharing(t *testing.T) {
+	setUp("info")
+
+	threshold, newThreshold := keygen.TestThreshold, 1
+	oldKeys, oldPIDs, err := keygen.LoadKeygenTestFixtures(threshold + 1)
+	assert.NoError(t, err, "should load keygen fixtures")
+	oldP2PCtx := tss.NewPeerContext(oldPIDs)
+	newPIDs := tss.GenerateTestPartyIDs(3)
+	newP2PCtx := tss.NewPeerContext(newPIDs)
+
+	total := len(oldPIDs) + len(newPIDs)
+	endCh := make(chan keygen.LocalPartySaveData, total)
+	errCh := make(chan *tss.Error, total)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the old committee is hosted by one router and the new committee by another
+	oldRouter, newRouter := transport.NewSocketRouter(listen(t), errCh), transport.NewSocketRouter(listen(t), errCh)
+	defer oldRouter.Close()
+	defer newRouter.Close()
+	for _, pID := range oldPIDs {
+		newRouter.AddPeer(pID, "tcp", oldRouter.Addr().String())
+	}
+	for _, pID := range newPIDs {
+		oldRouter.AddPeer(pID, "tcp", newRouter.Addr().String())
+	}
+	oldOutCh, newOutCh := make(chan tss.Message, total), make(chan tss.Message, total)
+
+	parties := make([]tss.Party, 0, total)
+	for j, pID := range oldPIDs {
+		params := tss.NewReSharingParameters(tss.Edwards(), oldP2PCtx, newP2PCtx, pID, test.TestParticipants, threshold, len(newPIDs), newThreshold)
+		P := resharing.NewLocalParty(params, oldKeys[j], oldOutCh, endCh)
+		assert.NoError(t, oldRouter.RegisterOldCommittee(P))
+		parties = append(parties, P)
+	}
+	for _, pID := range newPIDs {
+		params := tss.NewReSharingParameters(tss.Edwards(), oldP2PCtx, newP2PCtx, pID, test.TestParticipants, threshold, len(newPIDs), newThreshold)
+		P := resharing.NewLocalParty(params, keygen.NewLocalPartySaveData(len(newPIDs)), newOutCh, endCh)
+		assert.NoError(t, newRouter.Register(P))
+		parties = append(parties, P)
+	}
+	startAll(t, parties)
+	go forward(ctx, oldRouter, oldOutCh, errCh)
+	go forward(ctx, newRouter, newOutCh, errCh)
+
+	saves := waitForKeys(t, total, endCh, errCh)
+	newKeys := 0
+	for _, save := range saves {
+		if save.Xi == nil {
+			continue
+		}
+		newKeys++
+		assert.True(t, save.EDDSAPub.Equals(oldKeys[0].EDDSAPub), "the new committee should share the old public key")
+	}
+	assert.Equal(t, len(newPIDs), newKeys)
+}
+
+func TestRouteAfterClose(t *testing.T) {
+	setUp("info")
+
+	pIDs := tss.GenerateTestPartyIDs(2)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	outCh := make(chan tss.Message, len(pIDs))
+	params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[0], len(pIDs), 1)
+	assert.Nil(t, keygen.NewLocalParty(params, outCh, nil).Start())
+	msg := <-outCh
+
+	for _, router := range []transport.Router{transport.NewInProcRouter(nil), transport.NewSocketRouter(listen(t), nil)} {
+		assert.NoError(t, router.Close())
+		assert.True(t, errors.Is(router.Route(msg), transport.ErrClosed))
+		assert.True(t, errors.Is(router.Close(), transport.ErrClosed))
+	}
+}
+
+// writeFrame writes a frame of the socket router to `conn`, naming `from` as its sender
+func writeFrame(t *testing.T, conn net.Conn, flags byte, from, to *tss.PartyID, payload []byte) {
+	var buf bytes.Buffer
+	field := func(bz []byte) {
+		_ = binary.Write(&buf, binary.BigEndian, uint16(len(bz)))
+		buf.Write(bz)
+	}
+	buf.WriteByte(flags)
+	field(from.GetKey())
+	field(to.GetKey())
+	buf.Write(payload)
+	assert.NoError(t, binary.Write(conn, binary.BigEndian, uint32(buf.Len())))
+	_, err := conn.Write(buf.Bytes())
+	assert.NoError(t, err)
+}
+
+func TestSocketRouterForgedSender(t *testing.T) {
+	setUp("info")
+
+	pIDs := tss.GenerateTestPartyIDs(3)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	privs := make([]ed25519.PrivateKey, len(pIDs))
+	for i, pID := range pIDs {
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		assert.NoError(t, err)
+		pID.SetIdentityKey(pub)
+		privs[i] = priv
+	}
+	newParams := func(i int) *tss.Parameters {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[i], len(pIDs), 1)
+		params.SetIdentityKey(privs[i])
+		return params
+	}
+	errCh := make(chan *tss.Error, len(pIDs))
+	router := transport.NewSocketRouter(listen(t), errCh)
+	defer router.Close()
+	for _, pID := range pIDs[1:] {
+		router.AddPeer(pID, "tcp", "127.0.0.1:0") // never dialed, as P[0] does not send anything here
+	}
+	P0 := keygen.NewLocalParty(newParams(0), make(chan tss.Message, len(pIDs)), nil)
+	assert.NoError(t, router.Register(P0))
+	assert.Nil(t, P0.Start())
+
+	// P[2] signs its broadcast, which is then sent to P[0] in the name of P[1]
+	outCh := make(chan tss.Message, len(pIDs))
+	assert.Nil(t, keygen.NewLocalParty(newParams(2), outCh, nil).Start())
+	bz, _, err := (<-outCh).WireBytes()
+	assert.NoError(t, err)
+	conn, err := net.Dial("tcp", router.Addr().String())
+	assert.NoError(t, err)
+	defer conn.Close()
+	const flagBroadcast = 1
+	writeFrame(t, conn, flagBroadcast, pIDs[1], pIDs[0], bz)
+
+	select {
+	case err := <-errCh:
+		assert.True(t, errors.Is(err, tss.ErrInvalidSignature), err.Error())
+		assert.Empty(t, err.Culprits())
+	case <-time.After(10 * time.Second):
+		assert.FailNow(t, "the message from a forged sender should be rejected")
+	}
+}
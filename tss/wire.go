@@ -7,21 +7,120 @@
 package tss
 
 import (
+	"bytes"
+	"crypto/ed25519"
 	"errors"
+
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/bnb-chain/tss-lib/common"
 )
 
 // Used externally to update a LocalParty with a valid ParsedMessage
+// Encrypted point-to-point messages can only be parsed by their recipient, see BaseParty.ParseWireMessage
 func ParseWireMessage(wireBytes []byte, from *PartyID, isBroadcast bool) (ParsedMessage, error) {
+	wire, err := parseWire(wireBytes, from, isBroadcast)
+	if err != nil {
+		return nil, err
+	}
+	if wire.Message == nil {
+		return nil, errors.New("ParseWireMessage: the message is encrypted and must be parsed by its recipient")
+	}
+	return parseWrappedMessage(wire, from)
+}
+
+// parseWire rebuilds the MessageWrapper of wire bytes received from `from`; the Any is left nil when the message is encrypted
+func parseWire(wireBytes []byte, from *PartyID, isBroadcast bool) (*MessageWrapper, error) {
 	wire := new(MessageWrapper)
-	wire.Message = new(anypb.Any)
 	wire.From = from.MessageWrapper_PartyID
 	wire.IsBroadcast = isBroadcast
-	if err := proto.Unmarshal(wireBytes, wire.Message); err != nil {
-		return nil, err
+	if env := new(MessageWrapper); proto.Unmarshal(wireBytes, env) == nil && (env.Message != nil || len(env.Ciphertext) > 0) {
+		wire.SessionId, wire.Signature, wire.Ciphertext, wire.Message = env.SessionId, env.Signature, env.Ciphertext, env.Message
+		wire.To, wire.IsToOldCommittee, wire.IsToOldAndNewCommittees = env.To, env.IsToOldCommittee, env.IsToOldAndNewCommittees
+	} else {
+		// not an envelope; the wire bytes hold only the inner Any, as sent by parties without a session
+		wire.Message = new(anypb.Any)
+		if err := proto.Unmarshal(wireBytes, wire.Message); err != nil {
+			return nil, err
+		}
 	}
-	return parseWrappedMessage(wire, from)
+	return wire, nil
+}
+
+// wireEnvelope returns what is actually sent through the wire for a wrapped message.
+// Without a session or signature only the inner Any is sent; otherwise a MessageWrapper carrying just those and the Any.
+// An encrypted message carries its ciphertext in place of the Any.
+// The routing metadata is taken by the receiver from the transport, except that a signed message also carries the keys of
+// its recipients and its committee flags, which the signature covers.
+func wireEnvelope(wire *MessageWrapper) proto.Message {
+	env := &MessageWrapper{
+		SessionId: wire.SessionId,
+		Signature: wire.Signature,
+	}
+	if len(wire.GetSignature()) > 0 {
+		env.To = make([]*MessageWrapper_PartyID, len(wire.GetTo()))
+		for i, to := range wire.GetTo() {
+			env.To[i] = &MessageWrapper_PartyID{Key: to.GetKey()}
+		}
+		env.IsToOldCommittee, env.IsToOldAndNewCommittees = wire.IsToOldCommittee, wire.IsToOldAndNewCommittees
+	}
+	if len(wire.GetCiphertext()) > 0 {
+		env.Ciphertext = wire.Ciphertext
+		return env
+	}
+	if len(wire.GetSessionId()) == 0 && len(wire.GetSignature()) == 0 {
+		return wire.Message
+	}
+	env.Message = wire.Message
+	return env
+}
+
+// signedBytes returns the digest that a sender's identity key signs for a wrapped message.
+// It covers the session, the sender, the recipients, whether the message is a broadcast, the committee flags
+// and the content with its type.
+func signedBytes(wire *MessageWrapper) []byte {
+	flags := []byte{0, 0, 0}
+	for i, flag := range []bool{wire.GetIsBroadcast(), wire.GetIsToOldCommittee(), wire.GetIsToOldAndNewCommittees()} {
+		if flag {
+			flags[i] = 1
+		}
+	}
+	recipients := make([][]byte, 0, len(wire.GetTo()))
+	for _, to := range wire.GetTo() {
+		recipients = append(recipients, to.GetKey())
+	}
+	return common.SHA512_256(
+		[]byte("tss-lib message signature"),
+		wire.GetSessionId(),
+		wire.GetFrom().GetKey(),
+		common.SHA512_256(recipients...),
+		flags,
+		[]byte(wire.GetMessage().GetTypeUrl()),
+		wire.GetMessage().GetValue(),
+	)
+}
+
+// signedFor reports whether `pID` is among the recipients of a wrapped message; a message without recipients is for everyone
+func signedFor(wire *MessageWrapper, pID *PartyID) bool {
+	if len(wire.GetTo()) == 0 {
+		return true
+	}
+	for _, to := range wire.GetTo() {
+		if bytes.Equal(to.GetKey(), pID.GetKey()) {
+			return true
+		}
+	}
+	return false
+}
+
+// verifySignature checks that a wrapped message from `from` is signed by its identity key, if it has one
+func verifySignature(wire *MessageWrapper, from *PartyID) bool {
+	key := from.GetIdentityKey()
+	if len(key) == 0 {
+		return true
+	}
+	return len(key) == ed25519.PublicKeySize && ed25519.Verify(key, signedBytes(wire), wire.GetSignature())
 }
 
 func parseWrappedMessage(wire *MessageWrapper, from *PartyID) (ParsedMessage, error) {
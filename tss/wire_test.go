@@ -0,0 +1,171 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package tss_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bnb-chain/tss-lib/crypto/vss"
+	"github.com/bnb-chain/tss-lib/eddsa/keygen"
+	"github.com/bnb-chain/tss-lib/tss"
+)
+
+// sendShare sends a share from `from` to `to` with `params` and returns the message that was handed to the transport
+func sendShare(t *testing.T, params *tss.Parameters, from, to *tss.PartyID) tss.ParsedMessage {
+	outCh := make(chan tss.Message, 1)
+	share := &vss.Share{Threshold: 1, ID: to.KeyInt(), Share: big.NewInt(42)}
+	assert.NoError(t, tss.SendMessage(params, outCh, keygen.NewKGRound2Message1(to, from, share)))
+	return (<-outCh).(tss.ParsedMessage)
+}
+
+func TestParseWireMessage(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(2)
+	p2pCtx := tss.NewPeerContext(pIDs)
+
+	for _, session := range [][]byte{nil, []byte("session")} {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[0], len(pIDs), 1)
+		params.SetSessionID(session)
+		msg := sendShare(t, params, pIDs[0], pIDs[1])
+		bz, routing, err := msg.WireBytes()
+		assert.NoError(t, err)
+		assert.Equal(t, pIDs[0], routing.From)
+		if session == nil {
+			// without a session or a signature only the content is sent
+			assert.Equal(t, bz, mustMarshal(t, msg.WireMsg().GetMessage()))
+		}
+
+		parsed, err := tss.ParseWireMessage(bz, pIDs[0], false)
+		if !assert.NoError(t, err) {
+			continue
+		}
+		assert.Equal(t, pIDs[0], parsed.GetFrom())
+		assert.False(t, parsed.IsBroadcast())
+		assert.Equal(t, session, parsed.WireMsg().GetSessionId())
+		assert.True(t, proto.Equal(msg.Content(), parsed.Content()))
+	}
+
+	_, err := tss.ParseWireMessage([]byte("not a message"), pIDs[0], false)
+	assert.Error(t, err)
+}
+
+func TestIdentityKeySignedMessages(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(2)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	pub0, priv0, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	_, otherPriv, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	pIDs[0].SetIdentityKey(pub0)
+
+	// round 1 messages from P[0] signed with its identity key, with another key and not signed at all
+	round1Bytes := func(key ed25519.PrivateKey) []byte {
+		params := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[0], len(pIDs), 1)
+		params.SetIdentityKey(key)
+		outCh := make(chan tss.Message, len(pIDs))
+		if err := keygen.NewLocalParty(params, outCh, nil).Start(); err != nil {
+			assert.FailNow(t, err.Error())
+		}
+		bz, _, err := (<-outCh).WireBytes()
+		assert.NoError(t, err)
+		return bz
+	}
+	signed, forged, unsigned := round1Bytes(priv0), round1Bytes(otherPriv), round1Bytes(nil)
+
+	params1 := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[1], len(pIDs), 1)
+	P1 := keygen.NewLocalParty(params1, make(chan tss.Message, len(pIDs)), nil)
+	for _, bz := range [][]byte{forged, unsigned} {
+		ok, err := P1.UpdateFromBytes(bz, pIDs[0], true)
+		assert.False(t, ok)
+		if !assert.NotNil(t, err) {
+			return
+		}
+		assert.True(t, errors.Is(err, tss.ErrInvalidSignature))
+		// anyone can send a frame claiming to be from P[0], so it must not get P[0] blamed
+		assert.Empty(t, err.Culprits())
+	}
+	// a signed message must not be accepted as a broadcast when it was sent point-to-point, or vice versa
+	ok, err2 := P1.UpdateFromBytes(signed, pIDs[0], false)
+	assert.False(t, ok)
+	assert.True(t, errors.Is(err2, tss.ErrInvalidSignature))
+
+	ok, err2 = P1.UpdateFromBytes(signed, pIDs[0], true)
+	assert.True(t, ok)
+	assert.Nil(t, err2)
+}
+
+func TestIdentityKeyBindsRecipients(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(3)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	pub0, priv0, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	pIDs[0].SetIdentityKey(pub0)
+
+	// a share signed by P[0] for P[1]
+	params0 := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[0], len(pIDs), 1)
+	params0.SetIdentityKey(priv0)
+	msg := sendShare(t, params0, pIDs[0], pIDs[1])
+	bz, _, err := msg.WireBytes()
+	assert.NoError(t, err)
+
+	// a relay that hands it to P[2] instead must not get P[0] blamed
+	P2 := keygen.NewLocalParty(tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[2], len(pIDs), 1), make(chan tss.Message, len(pIDs)), nil)
+	ok, err2 := P2.UpdateFromBytes(bz, pIDs[0], false)
+	assert.False(t, ok)
+	if assert.NotNil(t, err2) {
+		assert.True(t, errors.Is(err2, tss.ErrMisroutedMessage))
+		assert.Empty(t, err2.Culprits())
+	}
+	_, err2 = P2.Update(msg)
+	assert.True(t, errors.Is(err2, tss.ErrMisroutedMessage))
+
+	P1 := keygen.NewLocalParty(tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[1], len(pIDs), 1), make(chan tss.Message, len(pIDs)), nil)
+	ok, err2 = P1.UpdateFromBytes(bz, pIDs[0], false)
+	assert.True(t, ok)
+	assert.Nil(t, err2)
+}
+
+func TestIdentityKeyCoversContent(t *testing.T) {
+	pIDs := tss.GenerateTestPartyIDs(2)
+	p2pCtx := tss.NewPeerContext(pIDs)
+	pub0, priv0, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	pIDs[0].SetIdentityKey(pub0)
+
+	params0 := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[0], len(pIDs), 1)
+	params0.SetIdentityKey(priv0)
+	params0.SetSessionID([]byte("session"))
+	msg := sendShare(t, params0, pIDs[0], pIDs[1])
+
+	// the signature no longer verifies once the content is replaced
+	other := keygen.NewKGRound2Message1(pIDs[1], pIDs[0], &vss.Share{Threshold: 1, ID: pIDs[1].KeyInt(), Share: big.NewInt(43)})
+	wire := msg.WireMsg()
+	env := &tss.MessageWrapper{SessionId: wire.SessionId, Signature: wire.Signature, To: wire.To, Message: other.WireMsg().Message}
+
+	params1 := tss.NewParameters(tss.Edwards(), p2pCtx, pIDs[1], len(pIDs), 1)
+	params1.SetSessionID([]byte("session"))
+	P1 := keygen.NewLocalParty(params1, make(chan tss.Message, len(pIDs)), nil)
+	ok, err2 := P1.UpdateFromBytes(mustMarshal(t, env), pIDs[0], false)
+	assert.False(t, ok)
+	if assert.NotNil(t, err2) {
+		assert.True(t, errors.Is(err2, tss.ErrInvalidSignature))
+	}
+}
+
+func mustMarshal(t *testing.T, m proto.Message) []byte {
+	bz, err := proto.Marshal(m)
+	if err != nil {
+		assert.FailNow(t, err.Error())
+	}
+	return bz
+}